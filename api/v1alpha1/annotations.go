@@ -0,0 +1,78 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+const (
+	// DeletionPolicyAnnotation selects what happens to the Azure resource when the object is deleted.
+	// It may be set on an object or on its namespace, in which case it applies to every object in the namespace
+	// which does not set its own value.
+	DeletionPolicyAnnotation = "azure.alexeldeib.xyz/deletion-policy"
+)
+
+// DeletionPolicy decides whether deleting an object also deletes the Azure resource.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the Azure resource along with the object. This is the default.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan leaves the Azure resource untouched when the object is deleted.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+const (
+	// Finalizer is added by the controller to every object it reconciles, so the Azure resource is handled before the object goes away.
+	Finalizer = "azure.alexeldeib.xyz/finalizer"
+)
+
+const (
+	// AdoptAnnotation allows the controller to take over an Azure resource which exists but is not owned by the object.
+	// Its value must be "true".
+	AdoptAnnotation = "azure.alexeldeib.xyz/adopt"
+)
+
+const (
+	// PausedAnnotation stops the controller from calling Azure for an object while its value is "true".
+	// Deleting a paused object is blocked until it is unpaused or ForceDeleteAnnotation is set.
+	PausedAnnotation = "azure.alexeldeib.xyz/paused"
+	// ForceDeleteAnnotation lets the deletion of a paused object proceed when its value is "true".
+	ForceDeleteAnnotation = "azure.alexeldeib.xyz/force-delete"
+)
+
+const (
+	// DefaultLocationAnnotation on a namespace sets the location of objects in the namespace which leave it empty,
+	// unless their resource group object or provider config sets one.
+	DefaultLocationAnnotation = "azure.alexeldeib.xyz/default-location"
+	// DefaultSubscriptionIDAnnotation on a namespace sets the subscription of objects in the namespace which leave it empty,
+	// unless their resource group object or provider config sets one.
+	DefaultSubscriptionIDAnnotation = "azure.alexeldeib.xyz/default-subscription-id"
+	// LocationSourceAnnotation is set by admission on objects whose location was defaulted.
+	// Its value names the object the location was copied from, e.g. "ResourceGroup prod/group", "ProviderConfig prod/team" or "Namespace prod".
+	LocationSourceAnnotation = "azure.alexeldeib.xyz/location-source"
+	// SubscriptionIDSourceAnnotation is set by admission on objects whose subscription was defaulted.
+	// Its value names the object the subscription was copied from, e.g. "ResourceGroup prod/group", "ProviderConfig prod/team" or "Namespace prod".
+	SubscriptionIDSourceAnnotation = "azure.alexeldeib.xyz/subscription-id-source"
+)
+
+const (
+	// ProviderConfigAnnotation names the ProviderConfig in the namespace of an object whose credentials and defaults it uses.
+	// It may be set on an object or on its namespace, in which case it applies to every object in the namespace
+	// which does not select its own provider config. Objects selecting none use the credentials of the manager.
+	ProviderConfigAnnotation = "azure.alexeldeib.xyz/provider-config"
+	// ClusterProviderConfigAnnotation names the ClusterProviderConfig whose credentials and defaults an object uses.
+	// It is set on an object or its namespace like ProviderConfigAnnotation, which takes precedence when both are set.
+	ClusterProviderConfigAnnotation = "azure.alexeldeib.xyz/cluster-provider-config"
+)
+
+const (
+	// ControllerClassAnnotation assigns an object to the manager started with the same --controller-class.
+	// Objects without it are reconciled only by managers started without a class.
+	ControllerClassAnnotation = "azure.alexeldeib.xyz/controller-class"
+)
+
+const (
+	// AppliedPasswordAnnotation is set by the controller on the admin secret of a SQL server.
+	// Its value is a hash of the password last applied to the server, since Azure never returns the password itself.
+	AppliedPasswordAnnotation = "azure.alexeldeib.xyz/applied-password"
+)
@@ -0,0 +1,80 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+// v1alpha1 is the hub of the conversion webhook. Every other version converts to and from it,
+// so the controllers only ever handle v1alpha1 objects.
+
+// Hub marks DockerConfig as a conversion hub.
+func (*DockerConfig) Hub() {}
+
+// Hub marks Identity as a conversion hub.
+func (*Identity) Hub() {}
+
+// Hub marks Keyvault as a conversion hub.
+func (*Keyvault) Hub() {}
+
+// Hub marks LoadBalancer as a conversion hub.
+func (*LoadBalancer) Hub() {}
+
+// Hub marks NetworkInterface as a conversion hub.
+func (*NetworkInterface) Hub() {}
+
+// Hub marks PublicIP as a conversion hub.
+func (*PublicIP) Hub() {}
+
+// Hub marks Redis as a conversion hub.
+func (*Redis) Hub() {}
+
+// Hub marks RedisKey as a conversion hub.
+func (*RedisKey) Hub() {}
+
+// Hub marks ResourceGroup as a conversion hub.
+func (*ResourceGroup) Hub() {}
+
+// Hub marks Secret as a conversion hub.
+func (*Secret) Hub() {}
+
+// Hub marks SecretBundle as a conversion hub.
+func (*SecretBundle) Hub() {}
+
+// Hub marks SecurityGroup as a conversion hub.
+func (*SecurityGroup) Hub() {}
+
+// Hub marks ServiceBusKey as a conversion hub.
+func (*ServiceBusKey) Hub() {}
+
+// Hub marks ServiceBusNamespace as a conversion hub.
+func (*ServiceBusNamespace) Hub() {}
+
+// Hub marks SQLFirewallRule as a conversion hub.
+func (*SQLFirewallRule) Hub() {}
+
+// Hub marks SQLServer as a conversion hub.
+func (*SQLServer) Hub() {}
+
+// Hub marks StorageAccount as a conversion hub.
+func (*StorageAccount) Hub() {}
+
+// Hub marks StorageKey as a conversion hub.
+func (*StorageKey) Hub() {}
+
+// Hub marks Subnet as a conversion hub.
+func (*Subnet) Hub() {}
+
+// Hub marks TLSSecret as a conversion hub.
+func (*TLSSecret) Hub() {}
+
+// Hub marks TrafficManager as a conversion hub.
+func (*TrafficManager) Hub() {}
+
+// Hub marks VirtualNetwork as a conversion hub.
+func (*VirtualNetwork) Hub() {}
+
+// Hub marks VM as a conversion hub.
+func (*VM) Hub() {}
+
+// Hub marks VMScaleSet as a conversion hub.
+func (*VMScaleSet) Hub() {}
@@ -8,7 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-//  DockerConfigSpec defines the desired state of DockerConfig
+// DockerConfigSpec defines the desired state of DockerConfig
 type DockerConfigSpec struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -17,15 +17,16 @@ type DockerConfigSpec struct {
 	Server   string `json:"server"`
 }
 
-//  DockerConfigStatus defines the observed state of DockerConfig
+// DockerConfigStatus defines the observed state of DockerConfig
 type DockerConfigStatus struct {
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:singular=dockerconfig,path=dockerconfigs,shortName=dockercfg,categories=all
 
-//  DockerConfig is the Schema for the docker config API
+// DockerConfig is the Schema for the docker config API
 type DockerConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -34,9 +35,14 @@ type DockerConfig struct {
 	Status DockerConfigStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *DockerConfig) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 
-//  DockerConfigList contains a list of  DockerConfig
+// DockerConfigList contains a list of  DockerConfig
 type DockerConfigList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
@@ -25,10 +25,8 @@ type IdentityStatus struct {
 	// ProvisioningState sync the provisioning status of the resource from Azure.
 	ProvisioningState *string `json:"provisioningState,omitempty"`
 	// ID is the fully qualified Azure resource ID.
-	ID *string `json:"id,omitempty"`
-	// ObservedGeneration is the iteration of user-provided spec which has already been reconciled.
-	// This is used to decide when to re-reconcile changes.
-	ObservedGeneration int64 `json:"observedGeneration"`
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -44,6 +42,11 @@ type Identity struct {
 	Status IdentityStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *Identity) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 
 // IdentityList contains a list of Identity
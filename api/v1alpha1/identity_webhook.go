@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-identity,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=identities,verbs=create;update,versions=v1alpha1,name=videntity.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &Identity{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *Identity) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the managed identity in Azure.
+func (r *Identity) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*Identity)
+	if !ok {
+		return unexpectedType("Identity", old)
+	}
+	return invalid("Identity", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *Identity) ValidateDelete() error {
+	return nil
+}
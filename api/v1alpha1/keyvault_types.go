@@ -25,7 +25,8 @@ type KeyvaultSpec struct {
 // KeyvaultStatus defines the observed state of Keyvault
 type KeyvaultStatus struct {
 	// ID is the fully qualified Azure resource ID.
-	ID *string `json:"id,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -41,6 +42,11 @@ type Keyvault struct {
 	Status KeyvaultStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *Keyvault) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 
 // KeyvaultList contains a list of Keyvault
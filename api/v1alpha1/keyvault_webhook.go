@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-keyvault,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=keyvaults,verbs=create;update,versions=v1alpha1,name=vkeyvault.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &Keyvault{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *Keyvault) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the key vault in Azure.
+func (r *Keyvault) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*Keyvault)
+	if !ok {
+		return unexpectedType("Keyvault", old)
+	}
+	return invalid("Keyvault", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *Keyvault) ValidateDelete() error {
+	return nil
+}
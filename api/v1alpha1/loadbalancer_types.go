@@ -20,9 +20,13 @@ type LoadBalancerSpec struct {
 	SubscriptionID string `json:"subscriptionId"`
 	// SKU is either basic or standard.
 	SKU *string `json:"sku,omitempty"`
-	// +kubebuilder:validation:MinItems=1
 	// Frontends is a list of fully qualified resource IDs to Azure public IPs.
-	Frontends []string `json:"frontends"`
+	// At least one of Frontends or FrontendRefs must be set.
+	// +optional
+	Frontends []string `json:"frontends,omitempty"`
+	// FrontendRefs optionally references PublicIP objects to use as frontends in addition to Frontends.
+	// +optional
+	FrontendRefs []ObjectReference `json:"frontendRefs,omitempty"`
 	// +kubebuilder:validation:MinItems=1
 	// BackendPools is a list names of backend pools to create for this Load Balancers.
 	BackendPools []string `json:"backendPools"`
@@ -61,10 +65,8 @@ type LoadBalancerStatus struct {
 	// ProvisioningState sync the provisioning status of the resource from Azure.
 	ProvisioningState *string `json:"provisioningState,omitempty"`
 	// ID is the fully qualified Azure resource ID.
-	ID *string `json:"id,omitempty"`
-	// ObservedGeneration is the iteration of user-provided spec which has already been reconciled.
-	// This is used to decide when to re-reconcile changes.
-	ObservedGeneration int64 `json:"observedGeneration"`
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -80,6 +82,27 @@ type LoadBalancer struct {
 	Status LoadBalancerStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *LoadBalancer) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
+// Dependencies returns the objects this load balancer references.
+func (r *LoadBalancer) Dependencies() []Dependency {
+	var deps []Dependency
+	for _, ref := range r.Spec.FrontendRefs {
+		deps = append(deps, Dependency{
+			Ref:    ref,
+			Object: &PublicIP{},
+			Resolve: func(id string) error {
+				r.Spec.Frontends = appendUnique(r.Spec.Frontends, id)
+				return nil
+			},
+		})
+	}
+	return deps
+}
+
 // +kubebuilder:object:root=true
 
 // LoadBalancerList contains a list of Load Balancer
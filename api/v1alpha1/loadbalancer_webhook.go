@@ -0,0 +1,100 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-loadbalancer,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=loadbalancers,verbs=create;update,versions=v1alpha1,name=vloadbalancer.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &LoadBalancer{}
+
+// ValidateCreate checks that every rule references a frontend, backend pool and probe of this load balancer.
+func (r *LoadBalancer) ValidateCreate() error {
+	return invalid("LoadBalancer", r.Name, r.validateRules())
+}
+
+// ValidateUpdate rejects changes to the fields identifying the load balancer in Azure, and checks the rules of a changed spec
+// unless the load balancer is being deleted.
+func (r *LoadBalancer) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*LoadBalancer)
+	if !ok {
+		return unexpectedType("LoadBalancer", old)
+	}
+	if specUnchanged(r.Spec, previous.Spec) {
+		return nil
+	}
+	errs := validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	)
+	if !deleting(r) {
+		errs = append(errs, r.validateRules()...)
+	}
+	return invalid("LoadBalancer", r.Name, errs)
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *LoadBalancer) ValidateDelete() error {
+	return nil
+}
+
+// validateRules resolves the sub resource IDs of each rule against the names the load balancer client
+// gives its frontends, backend pools and probes. Frontends resolved from FrontendRefs are only known
+// once the referenced public IPs exist, so frontends are not checked while FrontendRefs is set.
+func (r *LoadBalancer) validateRules() field.ErrorList {
+	if r.Spec.Rules == nil {
+		return nil
+	}
+
+	frontends := map[string]bool{}
+	for _, frontend := range r.Spec.Frontends {
+		parts := strings.Split(frontend, "/")
+		frontends[strings.ToLower(parts[len(parts)-1])] = true
+	}
+	pools := map[string]bool{}
+	for _, pool := range r.Spec.BackendPools {
+		pools[strings.ToLower(pool)] = true
+	}
+	probes := map[string]bool{}
+	if r.Spec.Probes != nil {
+		for _, port := range *r.Spec.Probes {
+			probes[fmt.Sprintf("probe_%d", port)] = true
+		}
+	}
+
+	var errs field.ErrorList
+	for i, rule := range *r.Spec.Rules {
+		path := specPath.Child("rules").Index(i)
+		if len(r.Spec.FrontendRefs) == 0 {
+			errs = append(errs, r.validateSubResource(path.Child("frontendIPConfiguration"), rule.Frontend, "frontendIPConfigurations", frontends)...)
+		}
+		errs = append(errs, r.validateSubResource(path.Child("backendPool"), rule.BackendPool, "backendAddressPools", pools)...)
+		errs = append(errs, r.validateSubResource(path.Child("probe"), rule.Probe, "probes", probes)...)
+	}
+	return errs
+}
+
+// validateSubResource requires id to name an existing child of type segment on this load balancer.
+func (r *LoadBalancer) validateSubResource(path *field.Path, id, segment string, names map[string]bool) field.ErrorList {
+	if id == "" {
+		return field.ErrorList{field.Required(path, "")}
+	}
+	if lb := resourceSegment(id, "loadBalancers"); !strings.EqualFold(lb, r.Spec.Name) {
+		return field.ErrorList{field.Invalid(path, id, fmt.Sprintf("must reference load balancer %q", r.Spec.Name))}
+	}
+	if name := resourceSegment(id, segment); !names[strings.ToLower(name)] {
+		return field.ErrorList{field.NotFound(path, id)}
+	}
+	return nil
+}
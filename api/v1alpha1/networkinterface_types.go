@@ -19,9 +19,15 @@ type NetworkInterfaceSpec struct {
 	// SubscriptionID contains the Resource group. Is a GUID.
 	SubscriptionID string `json:"subscriptionId"`
 	// Network is the name of the VNet containing this subnet
-	Network string `json:"network"`
+	// +optional
+	Network string `json:"network,omitempty"`
 	// Subnet contains the name to an Azure subnet which this NIC should belong to.
-	Subnet string `json:"subnet"`
+	// +optional
+	Subnet string `json:"subnet,omitempty"`
+	// SubnetRef optionally references a Subnet object which this NIC should belong to. It takes precedence over Network and Subnet.
+	// The subnet must be in the resource group and subscription of the NIC.
+	// +optional
+	SubnetRef *ObjectReference `json:"subnetRef,omitempty"`
 	// IPConfigurations is an array of IP configurations belonging to this interface.
 	IPConfigurations *[]InterfaceIPConfig `json:"ipConfigurations,omitempty"`
 }
@@ -30,6 +36,9 @@ type NetworkInterfaceSpec struct {
 type InterfaceIPConfig struct {
 	// PublicIP contains an optional reference to an existing IP address to bind to this NIC.
 	PublicIP *ResourceReference `json:"publicIP,omitempty"`
+	// PublicIPRef optionally references a PublicIP object to bind to this NIC. It takes precedence over PublicIP.
+	// +optional
+	PublicIPRef *ObjectReference `json:"publicIPRef,omitempty"`
 	// PrivateIP contains an optional private IP address to bind to this NIC.
 	PrivateIP *string `json:"privateIP,omitempty"`
 	// BackendPoolReferences contains an optional reference to a Load Balancer backend pool for this configuration.
@@ -63,10 +72,8 @@ type NetworkInterfaceStatus struct {
 	// ProvisioningState sync the provisioning status of the resource from Azure.
 	ProvisioningState *string `json:"provisioningState,omitempty"`
 	// ID is the fully qualified Azure resource ID.
-	ID *string `json:"id,omitempty"`
-	// ObservedGeneration is the iteration of user-provided spec which has already been reconciled.
-	// This is used to decide when to re-reconcile changes.
-	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -82,6 +89,52 @@ type NetworkInterface struct {
 	Status NetworkInterfaceStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *NetworkInterface) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
+// Dependencies returns the objects this network interface references.
+func (r *NetworkInterface) Dependencies() []Dependency {
+	var deps []Dependency
+	if r.Spec.SubnetRef != nil {
+		deps = append(deps, Dependency{
+			Ref:    *r.Spec.SubnetRef,
+			Object: &Subnet{},
+			Resolve: func(id string) error {
+				if err := inGroup(id, r.Spec.SubscriptionID, r.Spec.ResourceGroup); err != nil {
+					return err
+				}
+				r.Spec.Network = resourceSegment(id, "virtualNetworks")
+				r.Spec.Subnet = resourceSegment(id, "subnets")
+				return nil
+			},
+		})
+	}
+	if r.Spec.IPConfigurations != nil {
+		configs := *r.Spec.IPConfigurations
+		for i := range configs {
+			config := &configs[i]
+			if config.PublicIPRef == nil {
+				continue
+			}
+			deps = append(deps, Dependency{
+				Ref:    *config.PublicIPRef,
+				Object: &PublicIP{},
+				Resolve: func(id string) error {
+					config.PublicIP = &ResourceReference{
+						Name:           resourceSegment(id, "publicIPAddresses"),
+						ResourceGroup:  resourceSegment(id, "resourceGroups"),
+						SubscriptionID: resourceSegment(id, "subscriptions"),
+					}
+					return nil
+				},
+			})
+		}
+	}
+	return deps
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
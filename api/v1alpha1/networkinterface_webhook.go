@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-networkinterface,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=networkinterfaces,verbs=create;update,versions=v1alpha1,name=vnetworkinterface.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &NetworkInterface{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *NetworkInterface) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the network interface in Azure.
+func (r *NetworkInterface) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*NetworkInterface)
+	if !ok {
+		return unexpectedType("NetworkInterface", old)
+	}
+	return invalid("NetworkInterface", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *NetworkInterface) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Keys of the Secret referenced by a provider config.
+const (
+	// CredentialsClientIDKey holds the application (client) ID of the service principal.
+	CredentialsClientIDKey = "clientId"
+	// CredentialsClientSecretKey holds the client secret of the service principal.
+	CredentialsClientSecretKey = "clientSecret"
+	// CredentialsClientCertificateKey holds a PKCS#12 client certificate, used instead of the client secret.
+	CredentialsClientCertificateKey = "clientCertificate"
+	// CredentialsClientCertificatePasswordKey holds the password of the client certificate, if any.
+	CredentialsClientCertificatePasswordKey = "clientCertificatePassword"
+	// CredentialsTenantIDKey holds the ID of the Azure Active Directory tenant of the service principal.
+	CredentialsTenantIDKey = "tenantId"
+)
+
+// ProviderConfigSpec defines the Azure identity and defaults of the objects selecting a provider config.
+type ProviderConfigSpec struct {
+	// CredentialsSecretRef names the Secret holding the service principal credentials.
+	// It must contain clientId and tenantId, and either clientSecret or clientCertificate.
+	// The namespace defaults to the namespace of a ProviderConfig and is required for a ClusterProviderConfig.
+	CredentialsSecretRef corev1.SecretReference `json:"credentialsSecretRef"`
+	// SubscriptionID is the default subscription of objects which leave it empty.
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+	// Location is the default location of objects which leave it empty.
+	Location string `json:"location,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=providerconfigs,shortName=pc,categories=all
+
+// ProviderConfig is the Schema for the providerconfigs API.
+// Objects in the same namespace select it with the provider config annotation.
+type ProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProviderConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigList contains a list of ProviderConfig
+type ProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfig `json:"items"`
+}
+
+// AllNamespaces allows objects in every namespace to select a ClusterProviderConfig.
+const AllNamespaces = "*"
+
+// ClusterProviderConfigSpec defines the Azure identity and defaults of a ClusterProviderConfig and who may use them.
+type ClusterProviderConfigSpec struct {
+	ProviderConfigSpec `json:",inline"`
+	// AllowedNamespaces lists the namespaces whose objects may select this provider config.
+	// Objects in any other namespace are refused, so a tenant cannot borrow the identity of another.
+	// A single "*" allows every namespace.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// Allows returns true if objects in namespace may select the provider config.
+func (s ClusterProviderConfigSpec) Allows(namespace string) bool {
+	for _, allowed := range s.AllowedNamespaces {
+		if allowed == AllNamespaces || allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clusterproviderconfigs,scope=Cluster,shortName=cpc,categories=all
+
+// ClusterProviderConfig is the Schema for the clusterproviderconfigs API.
+// Objects in its allowed namespaces select it with the cluster provider config annotation.
+type ClusterProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterProviderConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProviderConfigList contains a list of ClusterProviderConfig
+type ClusterProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterProviderConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{}, &ClusterProviderConfig{}, &ClusterProviderConfigList{})
+}
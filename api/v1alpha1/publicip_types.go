@@ -27,10 +27,8 @@ type PublicIPStatus struct {
 	// ProvisioningState sync the provisioning status of the resource from Azure.
 	ProvisioningState *string `json:"provisioningState,omitempty"`
 	// ID is the fully qualified Azure resource ID.
-	ID *string `json:"id,omitempty"`
-	// ObservedGeneration is the iteration of user-provided spec which has already been reconciled.
-	// This is used to decide when to re-reconcile changes.
-	ObservedGeneration int64 `json:"observedGeneration"`
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -46,6 +44,11 @@ type PublicIP struct {
 	Status PublicIPStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *PublicIP) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-publicip,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=publicips,verbs=create;update,versions=v1alpha1,name=vpublicip.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &PublicIP{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *PublicIP) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the public IP in Azure.
+func (r *PublicIP) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*PublicIP)
+	if !ok {
+		return unexpectedType("PublicIP", old)
+	}
+	return invalid("PublicIP", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *PublicIP) ValidateDelete() error {
+	return nil
+}
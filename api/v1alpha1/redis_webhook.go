@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-redis,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=redis,verbs=create;update,versions=v1alpha1,name=vredis.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &Redis{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *Redis) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the Redis cache in Azure.
+func (r *Redis) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*Redis)
+	if !ok {
+		return unexpectedType("Redis", old)
+	}
+	return invalid("Redis", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *Redis) ValidateDelete() error {
+	return nil
+}
@@ -28,6 +28,7 @@ type RedisKeySpec struct {
 type RedisKeyStatus struct {
 	// ProvisioningState sync the provisioning status of the resource from Azure.
 	ProvisioningState *string `json:"provisioningState,omitempty"`
+	ResourceStatus    `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -43,6 +44,11 @@ type RedisKey struct {
 	Status RedisKeyStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *RedisKey) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
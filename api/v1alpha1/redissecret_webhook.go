@@ -0,0 +1,37 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-rediskey,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=rediskeys,verbs=create;update,versions=v1alpha1,name=vrediskey.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &RedisKey{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *RedisKey) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the Redis cache in Azure.
+func (r *RedisKey) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*RedisKey)
+	if !ok {
+		return unexpectedType("RedisKey", old)
+	}
+	return invalid("RedisKey", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *RedisKey) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ObjectReference points at another custom resource managed by this operator in the same cluster.
+type ObjectReference struct {
+	// Name of the referenced object.
+	Name string `json:"name"`
+	// Namespace of the referenced object. Defaults to the namespace of the referencing object, which is the only namespace allowed.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Dependency describes a reference from one object to another which must be ready before the first can be reconciled.
+// +kubebuilder:object:generate=false
+type Dependency struct {
+	// Ref identifies the referenced object.
+	Ref ObjectReference
+	// Object is an empty instance of the referenced kind, used to fetch it.
+	Object runtime.Object
+	// Resolve receives the Azure ID from the status of the referenced object once it is ready.
+	// It returns an error if the referencing object cannot use the resource with that ID.
+	Resolve func(id string) error
+}
+
+// Dependent is implemented by kinds which may reference other objects.
+// +kubebuilder:object:generate=false
+type Dependent interface {
+	Dependencies() []Dependency
+}
+
+// resourceSegment returns the path segment following key in a fully qualified Azure resource ID.
+// Keys are compared case insensitively, e.g. resourceSegment(id, "virtualNetworks") returns the network name.
+func resourceSegment(id, key string) string {
+	parts := strings.Split(strings.Trim(id, "/"), "/")
+	for i := 0; i+1 < len(parts); i += 2 {
+		if strings.EqualFold(parts[i], key) {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// inGroup returns an error unless the resource with the provided ID is in resourceGroup of subscriptionID.
+// Kinds which only store the name of a referenced resource address it within their own resource group.
+func inGroup(id, subscriptionID, resourceGroup string) error {
+	if !strings.EqualFold(resourceSegment(id, "subscriptions"), subscriptionID) || !strings.EqualFold(resourceSegment(id, "resourceGroups"), resourceGroup) {
+		return fmt.Errorf("%s is not in resource group %s of subscription %s", id, resourceGroup, subscriptionID)
+	}
+	return nil
+}
+
+// appendUnique appends value to values unless it is already present.
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if strings.EqualFold(existing, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
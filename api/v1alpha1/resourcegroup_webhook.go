@@ -0,0 +1,37 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-resourcegroup,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=resourcegroups,verbs=create;update,versions=v1alpha1,name=vresourcegroup.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &ResourceGroup{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *ResourceGroup) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the resource group in Azure.
+func (r *ResourceGroup) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*ResourceGroup)
+	if !ok {
+		return unexpectedType("ResourceGroup", old)
+	}
+	return invalid("ResourceGroup", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *ResourceGroup) ValidateDelete() error {
+	return nil
+}
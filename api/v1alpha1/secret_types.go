@@ -41,7 +41,8 @@ type SecretIdentifier struct {
 
 // SecretStatus defines the observed state of Secret
 type SecretStatus struct {
-	State *string `json:"state,omitempty"`
+	State          *string `json:"state,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -56,6 +57,11 @@ type Secret struct {
 	Status SecretStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *Secret) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 
 // SecretList contains a list of Secret
@@ -22,8 +22,9 @@ type SecretBundleSpec struct {
 // SecretBundleStatus defines the observed state of SecretBundle
 type SecretBundleStatus struct {
 	// Secrets is map of named statuses for individual secrets.
-	Secrets map[string]string `json:"secrets,omitempty"`
-	State   *string           `json:"state,omitempty"`
+	Secrets        map[string]string `json:"secrets,omitempty"`
+	State          *string           `json:"state,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -38,6 +39,11 @@ type SecretBundle struct {
 	Status SecretBundleStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *SecretBundle) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:object:generate=true
 
@@ -0,0 +1,87 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const (
+	// minRulePriority and maxRulePriority bound the priority of a security rule, as enforced by Azure.
+	minRulePriority = 100
+	maxRulePriority = 4096
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-securitygroup,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=securitygroups,verbs=create;update,versions=v1alpha1,name=vsecuritygroup.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &SecurityGroup{}
+
+// ValidateCreate checks the direction and priority of every rule.
+func (r *SecurityGroup) ValidateCreate() error {
+	return invalid("SecurityGroup", r.Name, r.validateRules())
+}
+
+// ValidateUpdate rejects changes to the fields identifying the security group in Azure, and checks the rules of a changed spec
+// unless the security group is being deleted.
+func (r *SecurityGroup) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*SecurityGroup)
+	if !ok {
+		return unexpectedType("SecurityGroup", old)
+	}
+	if specUnchanged(r.Spec, previous.Spec) {
+		return nil
+	}
+	errs := validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	)
+	if !deleting(r) {
+		errs = append(errs, r.validateRules()...)
+	}
+	return invalid("SecurityGroup", r.Name, errs)
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *SecurityGroup) ValidateDelete() error {
+	return nil
+}
+
+// validateRules requires each rule to have a known direction and a priority in range which no other rule in the same direction uses.
+func (r *SecurityGroup) validateRules() field.ErrorList {
+	var errs field.ErrorList
+	seen := map[network.SecurityRuleDirection]map[int32]bool{
+		network.SecurityRuleDirectionInbound:  {},
+		network.SecurityRuleDirectionOutbound: {},
+	}
+	for i, rule := range r.Spec.Rules {
+		path := specPath.Child("rules").Index(i)
+		priorities, ok := seen[rule.Direction]
+		if !ok {
+			errs = append(errs, field.NotSupported(path.Child("direction"), rule.Direction, []string{
+				string(network.SecurityRuleDirectionInbound),
+				string(network.SecurityRuleDirectionOutbound),
+			}))
+		}
+		if rule.Priority == nil {
+			errs = append(errs, field.Required(path.Child("priority"), ""))
+			continue
+		}
+		priority := *rule.Priority
+		switch {
+		case priority < minRulePriority || priority > maxRulePriority:
+			errs = append(errs, field.Invalid(path.Child("priority"), priority, "must be between 100 and 4096"))
+		case ok && priorities[priority]:
+			errs = append(errs, field.Duplicate(path.Child("priority"), priority))
+		case ok:
+			priorities[priority] = true
+		}
+	}
+	return errs
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-servicebusnamespace,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=servicebus,verbs=create;update,versions=v1alpha1,name=vservicebusnamespace.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &ServiceBusNamespace{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *ServiceBusNamespace) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the Service Bus namespace in Azure.
+func (r *ServiceBusNamespace) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*ServiceBusNamespace)
+	if !ok {
+		return unexpectedType("ServiceBusNamespace", old)
+	}
+	return invalid("ServiceBusNamespace", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *ServiceBusNamespace) ValidateDelete() error {
+	return nil
+}
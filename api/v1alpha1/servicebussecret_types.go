@@ -32,6 +32,7 @@ type ServiceBusKeySpec struct {
 type ServiceBusKeyStatus struct {
 	// ProvisioningState sync the provisioning status of the resource from Azure.
 	ProvisioningState *string `json:"provisioningState,omitempty"`
+	ResourceStatus    `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -47,6 +48,11 @@ type ServiceBusKey struct {
 	Status ServiceBusKeyStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *ServiceBusKey) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
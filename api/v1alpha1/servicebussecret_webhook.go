@@ -0,0 +1,37 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-servicebuskey,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=servicebuskeys,verbs=create;update,versions=v1alpha1,name=vservicebuskey.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &ServiceBusKey{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *ServiceBusKey) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the Service Bus namespace in Azure.
+func (r *ServiceBusKey) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*ServiceBusKey)
+	if !ok {
+		return unexpectedType("ServiceBusKey", old)
+	}
+	return invalid("ServiceBusKey", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *ServiceBusKey) ValidateDelete() error {
+	return nil
+}
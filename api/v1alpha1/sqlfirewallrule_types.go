@@ -29,7 +29,8 @@ type SQLFirewallRuleStatus struct {
 	// State sync the status of the resource from Azure.
 	State *string `json:"state,omitempty"`
 	// ID is the fully qualified Azure resource ID.
-	ID *string `json:"id,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -45,6 +46,11 @@ type SQLFirewallRule struct {
 	Status SQLFirewallRuleStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *SQLFirewallRule) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-sqlfirewallrule,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=sqlfirewallrules,verbs=create;update,versions=v1alpha1,name=vsqlfirewallrule.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &SQLFirewallRule{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *SQLFirewallRule) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the firewall rule in Azure.
+func (r *SQLFirewallRule) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*SQLFirewallRule)
+	if !ok {
+		return unexpectedType("SQLFirewallRule", old)
+	}
+	return invalid("SQLFirewallRule", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("server", r.Spec.Server, previous.Spec.Server),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *SQLFirewallRule) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-sqlserver,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=sqlservers,verbs=create;update,versions=v1alpha1,name=vsqlserver.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &SQLServer{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *SQLServer) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the SQL server in Azure.
+func (r *SQLServer) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*SQLServer)
+	if !ok {
+		return unexpectedType("SQLServer", old)
+	}
+	return invalid("SQLServer", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *SQLServer) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceStatus contains the status fields shared by every kind in this group.
+// It is embedded inline into the status of each kind.
+type ResourceStatus struct {
+	// Conditions describe the readiness of the resource, e.g. Ready, Reconciling and Failed.
+	Conditions Conditions `json:"conditions,omitempty"`
+	// ObservedGeneration is the iteration of user-provided spec which has already been reconciled.
+	// This is used to decide when to re-reconcile changes.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// SpecHash is a hash of the spec which was last applied to Azure.
+	// Together with ObservedGeneration it lets the controller skip mutating calls for unchanged objects.
+	SpecHash string `json:"specHash,omitempty"`
+	// FailedSpecHash is the hash of a spec which Azure rejected with an error retrying cannot fix, e.g. an invalid parameter.
+	// The controller does not apply that spec again until it changes.
+	FailedSpecHash string `json:"failedSpecHash,omitempty"`
+	// Operation is the long running Azure operation the controller is waiting on, if any.
+	// It is recorded so polling resumes after a restart instead of starting the operation again.
+	Operation *Operation `json:"operation,omitempty"`
+	// LastError is the last error returned by Azure. It is cleared once the resource is ready.
+	LastError *AzureError `json:"lastError,omitempty"`
+	// RecentOperations are the outcomes of the most recent calls to Azure, oldest first.
+	RecentOperations []OperationRecord `json:"recentOperations,omitempty"`
+}
+
+// Operation records a long running Azure operation.
+type Operation struct {
+	// Method is the HTTP method which started the operation, PUT or DELETE.
+	Method string `json:"method"`
+	// PollingURL is the URL Azure returned to poll the status of the operation.
+	PollingURL string `json:"pollingURL"`
+	// PollingMethod is the header PollingURL was read from, AsyncOperation or Location.
+	PollingMethod string `json:"pollingMethod,omitempty"`
+	// ResultURL is the URL returning the resource once the operation succeeds, if it differs from the resource URL.
+	ResultURL string `json:"resultURL,omitempty"`
+	// State is the last state of the operation reported by Azure, e.g. InProgress.
+	State string `json:"state,omitempty"`
+	// StartTime is when the operation was started.
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// StatusAccessor is implemented by every kind in this group so reconcilers can manage the shared status generically.
+// +kubebuilder:object:generate=false
+type StatusAccessor interface {
+	GetResourceStatus() *ResourceStatus
+}
+
+// AzureError describes an error returned by Azure, with the identifiers Azure support asks for.
+type AzureError struct {
+	// Code is the error code returned by Azure, e.g. QuotaExceeded.
+	Code string `json:"code,omitempty"`
+	// Category is how the controller classified the error, e.g. Throttled or InvalidParameter.
+	Category string `json:"category,omitempty"`
+	// Message is the error message returned by Azure.
+	Message string `json:"message"`
+	// Target is the part of the request the error refers to, if Azure reported one.
+	Target string `json:"target,omitempty"`
+	// RequestID is the x-ms-request-id of the failed request.
+	RequestID string `json:"requestID,omitempty"`
+	// CorrelationID is the x-ms-correlation-request-id of the failed request.
+	CorrelationID string `json:"correlationID,omitempty"`
+	// Time is when the error occurred.
+	Time metav1.Time `json:"time"`
+}
+
+// OperationRecord is the outcome of a call to Azure.
+type OperationRecord struct {
+	// Action is what the controller asked of Azure, one of Ensure, Delete or Observe.
+	Action string `json:"action"`
+	// Result is the outcome of the call, one of Succeeded, InProgress or Failed.
+	Result string `json:"result"`
+	// Code is the Azure error code of failed calls.
+	Code string `json:"code,omitempty"`
+	// RequestID is the x-ms-request-id of failed calls.
+	RequestID string `json:"requestID,omitempty"`
+	// Time is when the call first had this outcome. Identical consecutive outcomes are recorded once.
+	Time metav1.Time `json:"time"`
+}
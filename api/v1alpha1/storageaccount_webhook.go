@@ -0,0 +1,38 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-storageaccount,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=storageaccounts,verbs=create;update,versions=v1alpha1,name=vstorageaccount.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &StorageAccount{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *StorageAccount) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the storage account in Azure.
+func (r *StorageAccount) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*StorageAccount)
+	if !ok {
+		return unexpectedType("StorageAccount", old)
+	}
+	return invalid("StorageAccount", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *StorageAccount) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-storagekey,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=storagekeys,verbs=create;update,versions=v1alpha1,name=vstoragekey.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &StorageKey{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *StorageKey) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the storage account in Azure.
+func (r *StorageKey) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*StorageKey)
+	if !ok {
+		return unexpectedType("StorageKey", old)
+	}
+	return invalid("StorageKey", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *StorageKey) ValidateDelete() error {
+	return nil
+}
@@ -17,7 +17,12 @@ type SubnetSpec struct {
 	// SubscriptionID contains the Resource group. Is a GUID.
 	SubscriptionID string `json:"subscriptionId"`
 	// Network is the name of the VNet containing this subnet
-	Network string `json:"network"`
+	// +optional
+	Network string `json:"network,omitempty"`
+	// NetworkRef optionally references a VirtualNetwork object containing this subnet. It takes precedence over Network.
+	// The network must be in the resource group and subscription of the subnet.
+	// +optional
+	NetworkRef *ObjectReference `json:"networkRef,omitempty"`
 	// Subnet is the desired CIDR block of this subnet. Must be defined in addresses of the network.
 	Subnet string `json:"subnet"`
 }
@@ -27,10 +32,8 @@ type SubnetStatus struct {
 	// ProvisioningState sync the provisioning status of the resource from Azure.
 	ProvisioningState *string `json:"provisioningState,omitempty"`
 	// ID is the fully qualified Azure resource ID.
-	ID *string `json:"id,omitempty"`
-	// ObservedGeneration is the iteration of user-provided spec which has already been reconciled.
-	// This is used to decide when to re-reconcile changes.
-	ObservedGeneration int64 `json:"observedGeneration"`
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -46,6 +49,31 @@ type Subnet struct {
 	Status SubnetStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *Subnet) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
+// Dependencies returns the objects this subnet references.
+func (r *Subnet) Dependencies() []Dependency {
+	if r.Spec.NetworkRef == nil {
+		return nil
+	}
+	return []Dependency{
+		{
+			Ref:    *r.Spec.NetworkRef,
+			Object: &VirtualNetwork{},
+			Resolve: func(id string) error {
+				if err := inGroup(id, r.Spec.SubscriptionID, r.Spec.ResourceGroup); err != nil {
+					return err
+				}
+				r.Spec.Network = resourceSegment(id, "virtualNetworks")
+				return nil
+			},
+		},
+	}
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
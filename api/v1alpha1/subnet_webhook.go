@@ -0,0 +1,52 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-subnet,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=subnets,verbs=create;update,versions=v1alpha1,name=vsubnet.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &Subnet{}
+
+// ValidateCreate checks that the subnet prefix is a valid CIDR.
+func (r *Subnet) ValidateCreate() error {
+	return invalid("Subnet", r.Name, validateCIDR(specPath.Child("subnet"), r.Spec.Subnet))
+}
+
+// ValidateUpdate rejects changes to the fields identifying the subnet in Azure, and checks the prefix of a changed spec
+// unless the subnet is being deleted. Network and NetworkRef are each only compared when set on both objects,
+// so a subnet may switch between naming its network and referencing it.
+func (r *Subnet) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*Subnet)
+	if !ok {
+		return unexpectedType("Subnet", old)
+	}
+	if specUnchanged(r.Spec, previous.Spec) {
+		return nil
+	}
+	errs := validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	)
+	if !deleting(r) {
+		errs = append(errs, validateCIDR(specPath.Child("subnet"), r.Spec.Subnet)...)
+	}
+	if r.Spec.Network != "" && previous.Spec.Network != "" {
+		errs = append(errs, validateImmutable(immutable("network", r.Spec.Network, previous.Spec.Network))...)
+	}
+	if r.Spec.NetworkRef != nil && previous.Spec.NetworkRef != nil {
+		errs = append(errs, validateImmutable(immutable("networkRef", r.Spec.NetworkRef, previous.Spec.NetworkRef))...)
+	}
+	return invalid("Subnet", r.Name, errs)
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *Subnet) ValidateDelete() error {
+	return nil
+}
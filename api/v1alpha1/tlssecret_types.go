@@ -19,7 +19,8 @@ type TLSSecretSpec struct {
 
 // TLSSecretStatus defines the observed state of TLSSecret
 type TLSSecretStatus struct {
-	State *string `json:"state,omitempty"`
+	State          *string `json:"state,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -34,6 +35,11 @@ type TLSSecret struct {
 	Status TLSSecretStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *TLSSecret) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 
 // TLSSecretList contains a list of TLSSecret
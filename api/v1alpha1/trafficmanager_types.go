@@ -93,6 +93,7 @@ type TrafficManagerStatus struct {
 	ProfileStatus        string            `json:"profileStatus"`
 	ProfileMonitorStatus string            `json:"profileMonitorStatus"`
 	EndpointStatus       *[]EndpointStatus `json:"endpointStatus,omitempty"`
+	ResourceStatus       `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -108,6 +109,11 @@ type TrafficManager struct {
 	Status TrafficManagerStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *TrafficManager) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
 // +kubebuilder:object:root=true
 
 // TrafficManagerList contains a list of TrafficManager
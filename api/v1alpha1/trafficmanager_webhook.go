@@ -0,0 +1,37 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-trafficmanager,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=trafficmanagers,verbs=create;update,versions=v1alpha1,name=vtrafficmanager.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &TrafficManager{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *TrafficManager) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the traffic manager profile in Azure.
+func (r *TrafficManager) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*TrafficManager)
+	if !ok {
+		return unexpectedType("TrafficManager", old)
+	}
+	return invalid("TrafficManager", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionID", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *TrafficManager) ValidateDelete() error {
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var specPath = field.NewPath("spec")
+
+// immutableField pairs the new and old value of a spec field which cannot change once the Azure resource exists.
+type immutableField struct {
+	name     string
+	new, old interface{}
+}
+
+// immutable describes a spec field, by its json name, which must keep its old value on update.
+func immutable(name string, new, old interface{}) immutableField {
+	return immutableField{name: name, new: new, old: old}
+}
+
+// validateImmutable returns an error for each immutable field whose value changed.
+func validateImmutable(fields ...immutableField) field.ErrorList {
+	var errs field.ErrorList
+	for _, f := range fields {
+		errs = append(errs, apivalidation.ValidateImmutableField(f.new, f.old, specPath.Child(f.name))...)
+	}
+	return errs
+}
+
+// specUnchanged returns true for updates which leave the spec untouched, e.g. to labels, annotations or finalizers.
+// Admission lets them through without validating the spec again, so objects admitted under older rules stay editable.
+func specUnchanged(spec, previous interface{}) bool {
+	return equality.Semantic.DeepEqual(spec, previous)
+}
+
+// deleting returns true once the deletion of obj has started.
+// Updates to it, e.g. removing finalizers, are only checked for changes to the fields identifying the Azure resource.
+func deleting(obj metav1.Object) bool {
+	return obj.GetDeletionTimestamp() != nil
+}
+
+// validateCIDR returns an error if value is not an IPv4 or IPv6 prefix in CIDR notation, e.g. 10.0.0.0/16.
+func validateCIDR(path *field.Path, value string) field.ErrorList {
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		return field.ErrorList{field.Invalid(path, value, "must be a valid CIDR, e.g. 10.0.0.0/16")}
+	}
+	return nil
+}
+
+// invalid wraps field errors into the Invalid API error returned by admission, or returns nil when there are none.
+func invalid(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
+
+// unexpectedType is returned when admission hands a webhook the old object of another kind.
+func unexpectedType(kind string, obj runtime.Object) error {
+	return fmt.Errorf("expected old object of kind %s but got %T", kind, obj)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-virtualnetwork,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=virtualnetworks,verbs=create;update,versions=v1alpha1,name=vvirtualnetwork.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &VirtualNetwork{}
+
+// ValidateCreate checks that every address space is a valid CIDR.
+func (r *VirtualNetwork) ValidateCreate() error {
+	return invalid("VirtualNetwork", r.Name, r.validateSpec())
+}
+
+// ValidateUpdate rejects changes to the fields identifying the virtual network in Azure, and checks the address spaces of a changed spec
+// unless the virtual network is being deleted.
+func (r *VirtualNetwork) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*VirtualNetwork)
+	if !ok {
+		return unexpectedType("VirtualNetwork", old)
+	}
+	if specUnchanged(r.Spec, previous.Spec) {
+		return nil
+	}
+	errs := validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+	)
+	if !deleting(r) {
+		errs = append(errs, r.validateSpec()...)
+	}
+	return invalid("VirtualNetwork", r.Name, errs)
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *VirtualNetwork) ValidateDelete() error {
+	return nil
+}
+
+func (r *VirtualNetwork) validateSpec() field.ErrorList {
+	var errs field.ErrorList
+	addresses := specPath.Child("addresses")
+	if len(r.Spec.Addresses) == 0 {
+		errs = append(errs, field.Required(addresses, "at least one address space is required"))
+	}
+	for i, address := range r.Spec.Addresses {
+		errs = append(errs, validateCIDR(addresses.Index(i), address)...)
+	}
+	return errs
+}
@@ -27,9 +27,16 @@ type VMSpec struct {
 	// SSHPublicKey is the key of the of the provisioned user on the VM.
 	SSHPublicKey string `json:"sshPublicKey"`
 	// PrimaryNIC is the Azure ID of the primary NIC on this machine.
-	PrimaryNIC string `json:"primaryNic"`
+	// +optional
+	PrimaryNIC string `json:"primaryNic,omitempty"`
+	// PrimaryNICRef optionally references the NetworkInterface object to use as the primary NIC. It takes precedence over PrimaryNIC.
+	// +optional
+	PrimaryNICRef *ObjectReference `json:"primaryNicRef,omitempty"`
 	// SecondaryNICs is the list of IDs of non-primary NICs on this machine. +optional
 	SecondaryNICs *[]string `json:"secondaryNics,omitempty"`
+	// SecondaryNICRefs optionally references NetworkInterface objects to attach in addition to SecondaryNICs.
+	// +optional
+	SecondaryNICRefs []ObjectReference `json:"secondaryNicRefs,omitempty"`
 	// DiskSize is the size of the OS disk in GB
 	DiskSize int32 `json:"diskSize"`
 }
@@ -41,7 +48,8 @@ type VMStatus struct {
 	// ID is the fully qualified Azure resource ID.
 	ID *string `json:"id,omitempty"`
 	// Zone indicates the Availability Zone for this machine. Usually either "1", "2", or "3".
-	Zone *string `json:"zone,omitempty"`
+	Zone           *string `json:"zone,omitempty"`
+	ResourceStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -57,6 +65,42 @@ type VM struct {
 	Status VMStatus `json:"status,omitempty"`
 }
 
+// GetResourceStatus returns the status fields shared by every kind.
+func (r *VM) GetResourceStatus() *ResourceStatus {
+	return &r.Status.ResourceStatus
+}
+
+// Dependencies returns the objects this virtual machine references.
+func (r *VM) Dependencies() []Dependency {
+	var deps []Dependency
+	if r.Spec.PrimaryNICRef != nil {
+		deps = append(deps, Dependency{
+			Ref:    *r.Spec.PrimaryNICRef,
+			Object: &NetworkInterface{},
+			Resolve: func(id string) error {
+				r.Spec.PrimaryNIC = id
+				return nil
+			},
+		})
+	}
+	for _, ref := range r.Spec.SecondaryNICRefs {
+		deps = append(deps, Dependency{
+			Ref:    ref,
+			Object: &NetworkInterface{},
+			Resolve: func(id string) error {
+				var nics []string
+				if r.Spec.SecondaryNICs != nil {
+					nics = *r.Spec.SecondaryNICs
+				}
+				nics = appendUnique(nics, id)
+				r.Spec.SecondaryNICs = &nics
+				return nil
+			},
+		})
+	}
+	return deps
+}
+
 // +kubebuilder:object:root=true
 
 // VMList contains a list of VM
@@ -0,0 +1,39 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-azure-alexeldeib-xyz-v1alpha1-vm,mutating=false,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=vms,verbs=create;update,versions=v1alpha1,name=vvm.azure.alexeldeib.xyz
+
+var _ webhook.Validator = &VM{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *VM) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects changes to the fields identifying the virtual machine in Azure.
+func (r *VM) ValidateUpdate(old runtime.Object) error {
+	previous, ok := old.(*VM)
+	if !ok {
+		return unexpectedType("VM", old)
+	}
+	return invalid("VM", r.Name, validateImmutable(
+		immutable("name", r.Spec.Name, previous.Spec.Name),
+		immutable("location", r.Spec.Location, previous.Spec.Location),
+		immutable("resourceGroup", r.Spec.ResourceGroup, previous.Spec.ResourceGroup),
+		immutable("subscriptionId", r.Spec.SubscriptionID, previous.Spec.SubscriptionID),
+		immutable("zone", r.Spec.Zone, previous.Spec.Zone),
+	))
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *VM) ValidateDelete() error {
+	return nil
+}
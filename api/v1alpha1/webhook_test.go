@@ -0,0 +1,169 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1alpha1_test
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+const lbID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/lb"
+
+func securityRule(name string, direction network.SecurityRuleDirection, priority int32) azurev1alpha1.SecurityRule {
+	return azurev1alpha1.SecurityRule{Name: name, Direction: direction, Priority: to.Int32Ptr(priority)}
+}
+
+func loadBalancer(rules ...azurev1alpha1.RuleSpec) *azurev1alpha1.LoadBalancer {
+	return &azurev1alpha1.LoadBalancer{
+		Spec: azurev1alpha1.LoadBalancerSpec{
+			Name:         "lb",
+			Frontends:    []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/ip"},
+			BackendPools: []string{"pool"},
+			Probes:       &[]int{443},
+			Rules:        &rules,
+		},
+	}
+}
+
+func lbRule(frontend, pool, probe string) azurev1alpha1.RuleSpec {
+	return azurev1alpha1.RuleSpec{
+		Name:        "rule",
+		Frontend:    lbID + "/frontendIPConfigurations/" + frontend,
+		BackendPool: lbID + "/backendAddressPools/" + pool,
+		Probe:       lbID + "/probes/" + probe,
+	}
+}
+
+var _ = Describe("validating webhooks", func() {
+
+	DescribeTable("should check virtual network address spaces",
+		func(addresses []string, valid bool) {
+			vnet := &azurev1alpha1.VirtualNetwork{Spec: azurev1alpha1.VirtualNetworkSpec{Addresses: addresses}}
+			Expect(vnet.ValidateCreate() == nil).To(Equal(valid))
+		},
+		Entry("ipv4", []string{"10.0.0.0/16"}, true),
+		Entry("ipv4 and ipv6", []string{"10.0.0.0/16", "fd00::/48"}, true),
+		Entry("missing prefix length", []string{"10.0.0.0"}, false),
+		Entry("one bad address", []string{"10.0.0.0/16", "10.1.0.0/33"}, false),
+		Entry("empty", nil, false),
+	)
+
+	DescribeTable("should check subnet prefixes",
+		func(prefix string, valid bool) {
+			subnet := &azurev1alpha1.Subnet{Spec: azurev1alpha1.SubnetSpec{Subnet: prefix}}
+			Expect(subnet.ValidateCreate() == nil).To(Equal(valid))
+		},
+		Entry("ipv4", "10.0.1.0/24", true),
+		Entry("hostname", "subnet", false),
+		Entry("empty", "", false),
+	)
+
+	DescribeTable("should check security rule priorities and directions",
+		func(rules []azurev1alpha1.SecurityRule, valid bool) {
+			sg := &azurev1alpha1.SecurityGroup{Spec: azurev1alpha1.SecurityGroupSpec{Rules: rules}}
+			Expect(sg.ValidateCreate() == nil).To(Equal(valid))
+		},
+		Entry("distinct priorities", []azurev1alpha1.SecurityRule{
+			securityRule("a", network.SecurityRuleDirectionInbound, 100),
+			securityRule("b", network.SecurityRuleDirectionInbound, 4096),
+		}, true),
+		Entry("same priority in both directions", []azurev1alpha1.SecurityRule{
+			securityRule("a", network.SecurityRuleDirectionInbound, 200),
+			securityRule("b", network.SecurityRuleDirectionOutbound, 200),
+		}, true),
+		Entry("duplicate priority", []azurev1alpha1.SecurityRule{
+			securityRule("a", network.SecurityRuleDirectionInbound, 200),
+			securityRule("b", network.SecurityRuleDirectionInbound, 200),
+		}, false),
+		Entry("priority too low", []azurev1alpha1.SecurityRule{securityRule("a", network.SecurityRuleDirectionInbound, 99)}, false),
+		Entry("priority too high", []azurev1alpha1.SecurityRule{securityRule("a", network.SecurityRuleDirectionOutbound, 4097)}, false),
+		Entry("missing priority", []azurev1alpha1.SecurityRule{{Name: "a", Direction: network.SecurityRuleDirectionInbound}}, false),
+		Entry("unknown direction", []azurev1alpha1.SecurityRule{securityRule("a", "Sideways", 100)}, false),
+	)
+
+	DescribeTable("should check load balancer rule references",
+		func(lb *azurev1alpha1.LoadBalancer, valid bool) {
+			Expect(lb.ValidateCreate() == nil).To(Equal(valid))
+		},
+		Entry("existing references", loadBalancer(lbRule("ip", "pool", "probe_443")), true),
+		Entry("case insensitive names", loadBalancer(lbRule("IP", "Pool", "probe_443")), true),
+		Entry("unknown frontend", loadBalancer(lbRule("other", "pool", "probe_443")), false),
+		Entry("unknown pool", loadBalancer(lbRule("ip", "other", "probe_443")), false),
+		Entry("unknown probe", loadBalancer(lbRule("ip", "pool", "probe_80")), false),
+		Entry("no rules", loadBalancer(), true),
+	)
+
+	It("should not check frontends resolved from references", func() {
+		lb := loadBalancer(lbRule("from-ref", "pool", "probe_443"))
+		lb.Spec.FrontendRefs = []azurev1alpha1.ObjectReference{{Name: "ip"}}
+		Expect(lb.ValidateCreate()).To(Succeed())
+	})
+
+	It("should reject references to another load balancer", func() {
+		rule := lbRule("ip", "pool", "probe_443")
+		rule.Probe = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/other/probes/probe_443"
+		Expect(loadBalancer(rule).ValidateCreate()).ToNot(Succeed())
+	})
+
+	It("should reject changes to immutable fields", func() {
+		old := &azurev1alpha1.ResourceGroup{Spec: azurev1alpha1.ResourceGroupSpec{Name: "rg", Location: "westus2", SubscriptionID: "sub"}}
+		updated := old.DeepCopy()
+		Expect(updated.ValidateUpdate(old)).To(Succeed())
+
+		updated.Spec.Location = "eastus"
+		err := updated.ValidateUpdate(old)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.location"))
+	})
+
+	It("should allow a subnet to switch between naming and referencing its network", func() {
+		old := &azurev1alpha1.Subnet{Spec: azurev1alpha1.SubnetSpec{Name: "subnet", Subnet: "10.0.0.0/24", NetworkRef: &azurev1alpha1.ObjectReference{Name: "vnet"}}}
+		updated := old.DeepCopy()
+		updated.Spec.Network = "vnet"
+		Expect(updated.ValidateUpdate(old)).To(Succeed())
+
+		changed := updated.DeepCopy()
+		changed.Spec.Network = "other"
+		Expect(changed.ValidateUpdate(updated)).ToNot(Succeed())
+
+		changed = updated.DeepCopy()
+		changed.Spec.NetworkRef.Name = "other"
+		Expect(changed.ValidateUpdate(updated)).ToNot(Succeed())
+	})
+
+	It("should not validate the spec again on updates leaving it unchanged", func() {
+		old := &azurev1alpha1.Subnet{Spec: azurev1alpha1.SubnetSpec{Name: "subnet", Subnet: "not a cidr"}}
+		updated := old.DeepCopy()
+		updated.Labels = map[string]string{"team": "infra"}
+		Expect(updated.ValidateUpdate(old)).To(Succeed())
+
+		updated.Spec.Subnet = "still not a cidr"
+		Expect(updated.ValidateUpdate(old)).ToNot(Succeed())
+	})
+
+	It("should only check immutable fields of objects being deleted", func() {
+		now := metav1.Now()
+		old := &azurev1alpha1.VirtualNetwork{Spec: azurev1alpha1.VirtualNetworkSpec{Name: "vnet", Addresses: []string{"10.0.0.0/16"}}}
+		old.DeletionTimestamp = &now
+		updated := old.DeepCopy()
+		updated.Spec.Addresses = []string{"not a cidr"}
+		Expect(updated.ValidateUpdate(old)).To(Succeed())
+
+		updated.Spec.Name = "other"
+		Expect(updated.ValidateUpdate(old)).ToNot(Succeed())
+	})
+
+	It("should reject an old object of another kind", func() {
+		vm := &azurev1alpha1.VM{}
+		Expect(vm.ValidateUpdate(&azurev1alpha1.Redis{})).ToNot(Succeed())
+	})
+})
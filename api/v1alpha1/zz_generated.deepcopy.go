@@ -9,9 +9,25 @@ Copyright 2019 Alexander Eldeib.
 package v1alpha1
 
 import (
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureError) DeepCopyInto(out *AzureError) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureError.
+func (in *AzureError) DeepCopy() *AzureError {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureRef) DeepCopyInto(out *AzureRef) {
 	*out = *in
@@ -42,6 +58,122 @@ func (in *BackendPoolReference) DeepCopy() *BackendPoolReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProviderConfig) DeepCopyInto(out *ClusterProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProviderConfig.
+func (in *ClusterProviderConfig) DeepCopy() *ClusterProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProviderConfigList) DeepCopyInto(out *ClusterProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProviderConfigList.
+func (in *ClusterProviderConfigList) DeepCopy() *ClusterProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProviderConfigSpec) DeepCopyInto(out *ClusterProviderConfigSpec) {
+	*out = *in
+	out.ProviderConfigSpec = in.ProviderConfigSpec
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProviderConfigSpec.
+func (in *ClusterProviderConfigSpec) DeepCopy() *ClusterProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Conditions) DeepCopyInto(out *Conditions) {
+	{
+		in := &in
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Conditions.
+func (in Conditions) DeepCopy() Conditions {
+	if in == nil {
+		return nil
+	}
+	out := new(Conditions)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSConfig) DeepCopyInto(out *DNSConfig) {
 	*out = *in
@@ -73,7 +205,7 @@ func (in *DockerConfig) DeepCopyInto(out *DockerConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfig.
@@ -144,6 +276,7 @@ func (in *DockerConfigSpec) DeepCopy() *DockerConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerConfigStatus) DeepCopyInto(out *DockerConfigStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfigStatus.
@@ -325,6 +458,7 @@ func (in *IdentityStatus) DeepCopyInto(out *IdentityStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityStatus.
@@ -345,6 +479,11 @@ func (in *InterfaceIPConfig) DeepCopyInto(out *InterfaceIPConfig) {
 		*out = new(ResourceReference)
 		**out = **in
 	}
+	if in.PublicIPRef != nil {
+		in, out := &in.PublicIPRef, &out.PublicIPRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
 	if in.PrivateIP != nil {
 		in, out := &in.PrivateIP, &out.PrivateIP
 		*out = new(string)
@@ -453,6 +592,7 @@ func (in *KeyvaultStatus) DeepCopyInto(out *KeyvaultStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyvaultStatus.
@@ -537,6 +677,11 @@ func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FrontendRefs != nil {
+		in, out := &in.FrontendRefs, &out.FrontendRefs
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.BackendPools != nil {
 		in, out := &in.BackendPools, &out.BackendPools
 		*out = make([]string, len(*in))
@@ -585,6 +730,7 @@ func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStatus.
@@ -771,6 +917,11 @@ func (in *NetworkInterfaceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
 	*out = *in
+	if in.SubnetRef != nil {
+		in, out := &in.SubnetRef, &out.SubnetRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
 	if in.IPConfigurations != nil {
 		in, out := &in.IPConfigurations, &out.IPConfigurations
 		*out = new([]InterfaceIPConfig)
@@ -807,11 +958,7 @@ func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ObservedGeneration != nil {
-		in, out := &in.ObservedGeneration, &out.ObservedGeneration
-		*out = new(int64)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceStatus.
@@ -824,6 +971,127 @@ func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
+func (in *ObjectReference) DeepCopy() *ObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Operation) DeepCopyInto(out *Operation) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Operation.
+func (in *Operation) DeepCopy() *Operation {
+	if in == nil {
+		return nil
+	}
+	out := new(Operation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationRecord) DeepCopyInto(out *OperationRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationRecord.
+func (in *OperationRecord) DeepCopy() *OperationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
+func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
+func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
+func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicIP) DeepCopyInto(out *PublicIP) {
 	*out = *in
@@ -916,6 +1184,7 @@ func (in *PublicIPStatus) DeepCopyInto(out *PublicIPStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPStatus.
@@ -1047,6 +1316,7 @@ func (in *RedisKeyStatus) DeepCopyInto(out *RedisKeyStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisKeyStatus.
@@ -1145,6 +1415,7 @@ func (in *RedisStatus) DeepCopyInto(out *RedisStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStatus.
@@ -1244,6 +1515,7 @@ func (in *ResourceGroupStatus) DeepCopyInto(out *ResourceGroupStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroupStatus.
@@ -1271,6 +1543,45 @@ func (in *ResourceReference) DeepCopy() *ResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(Operation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(AzureError)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecentOperations != nil {
+		in, out := &in.RecentOperations, &out.RecentOperations
+		*out = make([]OperationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus.
+func (in *ResourceStatus) DeepCopy() *ResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleSpec) DeepCopyInto(out *RuleSpec) {
 	*out = *in
@@ -1373,6 +1684,7 @@ func (in *SQLFirewallRuleStatus) DeepCopyInto(out *SQLFirewallRuleStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLFirewallRuleStatus.
@@ -1477,6 +1789,7 @@ func (in *SQLServerStatus) DeepCopyInto(out *SQLServerStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLServerStatus.
@@ -1612,6 +1925,7 @@ func (in *SecretBundleStatus) DeepCopyInto(out *SecretBundleStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBundleStatus.
@@ -1720,6 +2034,7 @@ func (in *SecretStatus) DeepCopyInto(out *SecretStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStatus.
@@ -1826,6 +2141,7 @@ func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
@@ -1980,6 +2296,7 @@ func (in *ServiceBusKeyStatus) DeepCopyInto(out *ServiceBusKeyStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBusKeyStatus.
@@ -2120,6 +2437,7 @@ func (in *ServiceBusNamespaceStatus) DeepCopyInto(out *ServiceBusNamespaceStatus
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBusNamespaceStatus.
@@ -2229,6 +2547,7 @@ func (in *StorageAccountStatus) DeepCopyInto(out *StorageAccountStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAccountStatus.
@@ -2343,6 +2662,7 @@ func (in *StorageKeyStatus) DeepCopyInto(out *StorageKeyStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageKeyStatus.
@@ -2360,7 +2680,7 @@ func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -2417,6 +2737,11 @@ func (in *SubnetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -2442,6 +2767,7 @@ func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
@@ -2536,6 +2862,7 @@ func (in *TLSSecretStatus) DeepCopyInto(out *TLSSecretStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecretStatus.
@@ -2657,6 +2984,7 @@ func (in *TrafficManagerStatus) DeepCopyInto(out *TrafficManagerStatus) {
 			copy(*out, *in)
 		}
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficManagerStatus.
@@ -2815,6 +3143,7 @@ func (in *VMScaleSetStatus) DeepCopyInto(out *VMScaleSetStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMScaleSetStatus.
@@ -2840,6 +3169,11 @@ func (in *VMSpec) DeepCopyInto(out *VMSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrimaryNICRef != nil {
+		in, out := &in.PrimaryNICRef, &out.PrimaryNICRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
 	if in.SecondaryNICs != nil {
 		in, out := &in.SecondaryNICs, &out.SecondaryNICs
 		*out = new([]string)
@@ -2849,6 +3183,11 @@ func (in *VMSpec) DeepCopyInto(out *VMSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.SecondaryNICRefs != nil {
+		in, out := &in.SecondaryNICRefs, &out.SecondaryNICRefs
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMSpec.
@@ -2879,6 +3218,7 @@ func (in *VMStatus) DeepCopyInto(out *VMStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStatus.
@@ -2983,6 +3323,7 @@ func (in *VirtualNetworkStatus) DeepCopyInto(out *VirtualNetworkStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkStatus.
@@ -0,0 +1,62 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType is the name of a condition reported on the status of every kind.
+type ConditionType string
+
+const (
+	// ConditionReady is true when the Azure resource matches the desired spec.
+	ConditionReady ConditionType = "Ready"
+	// ConditionReconciling is true while the controller is still driving the resource towards the desired spec.
+	ConditionReconciling ConditionType = "Reconciling"
+	// ConditionFailed is true when the last reconcile attempt returned an error.
+	ConditionFailed ConditionType = "Failed"
+	// ConditionDrifted is true when the Azure resource was changed outside of the controller and no longer matches the spec.
+	ConditionDrifted ConditionType = "Drifted"
+	// ConditionWaitingForDependency is true while an object referenced by the spec is missing or not yet ready.
+	ConditionWaitingForDependency ConditionType = "WaitingForDependency"
+	// ConditionOwnershipConflict is true when the Azure resource belongs to another object or was not created by this operator.
+	ConditionOwnershipConflict ConditionType = "OwnershipConflict"
+	// ConditionPaused is true while reconciliation is suspended by the pause annotation.
+	ConditionPaused ConditionType = "Paused"
+)
+
+// Reason codes used by the generic reconcilers.
+const (
+	ReasonSucceeded          = "Succeeded"
+	ReasonInProgress         = "InProgress"
+	ReasonReconcileFailed    = "ReconcileFailed"
+	ReasonDeleting           = "Deleting"
+	ReasonDeleteFailed       = "DeleteFailed"
+	ReasonDriftDetected      = "DriftDetected"
+	ReasonDependencyNotReady = "DependencyNotReady"
+	ReasonDependenciesReady  = "DependenciesReady"
+	ReasonOwnershipConflict  = "OwnershipConflict"
+	ReasonPaused             = "Paused"
+	ReasonResumed            = "Resumed"
+)
+
+// Condition describes one aspect of the observed state of an object.
+type Condition struct {
+	// Type of the condition, e.g. Ready.
+	Type ConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Reason is a short CamelCase code for the last transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the last transition.
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the last time the status of this condition changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// Conditions is the list of conditions on an object's status.
+type Conditions []Condition
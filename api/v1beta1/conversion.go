@@ -0,0 +1,557 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1
+
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// The Convert functions of every type are generated into zz_generated.conversion.go, except for the types below.
+// v1alpha1 holds these lists as pointers to slices, which conversion-gen cannot map onto the plain slices of v1beta1.
+
+// Convert_v1beta1_EndpointProperties_To_v1alpha1_EndpointProperties converts a v1beta1 EndpointProperties to v1alpha1.
+func Convert_v1beta1_EndpointProperties_To_v1alpha1_EndpointProperties(in *EndpointProperties, out *v1alpha1.EndpointProperties, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_EndpointProperties_To_v1alpha1_EndpointProperties(in, out, s); err != nil {
+		return err
+	}
+	out.CustomHeaders = nil
+	if in.CustomHeaders != nil {
+		customHeaders := make([]v1alpha1.MonitorConfigCustomHeadersItem, len(in.CustomHeaders))
+		for i := range in.CustomHeaders {
+			if err := Convert_v1beta1_MonitorConfigCustomHeadersItem_To_v1alpha1_MonitorConfigCustomHeadersItem(&in.CustomHeaders[i], &customHeaders[i], s); err != nil {
+				return err
+			}
+		}
+		out.CustomHeaders = &customHeaders
+	}
+	return nil
+}
+
+// Convert_v1alpha1_EndpointProperties_To_v1beta1_EndpointProperties converts a v1alpha1 EndpointProperties to v1beta1.
+func Convert_v1alpha1_EndpointProperties_To_v1beta1_EndpointProperties(in *v1alpha1.EndpointProperties, out *EndpointProperties, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_EndpointProperties_To_v1beta1_EndpointProperties(in, out, s); err != nil {
+		return err
+	}
+	out.CustomHeaders = nil
+	if in.CustomHeaders != nil {
+		out.CustomHeaders = make([]MonitorConfigCustomHeadersItem, len(*in.CustomHeaders))
+		for i := range *in.CustomHeaders {
+			if err := Convert_v1alpha1_MonitorConfigCustomHeadersItem_To_v1beta1_MonitorConfigCustomHeadersItem(&(*in.CustomHeaders)[i], &out.CustomHeaders[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_InterfaceIPConfig_To_v1alpha1_InterfaceIPConfig converts a v1beta1 InterfaceIPConfig to v1alpha1.
+func Convert_v1beta1_InterfaceIPConfig_To_v1alpha1_InterfaceIPConfig(in *InterfaceIPConfig, out *v1alpha1.InterfaceIPConfig, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_InterfaceIPConfig_To_v1alpha1_InterfaceIPConfig(in, out, s); err != nil {
+		return err
+	}
+	out.LoadBalancers = nil
+	if in.LoadBalancers != nil {
+		loadBalancers := make([]v1alpha1.BackendPoolReference, len(in.LoadBalancers))
+		for i := range in.LoadBalancers {
+			if err := Convert_v1beta1_BackendPoolReference_To_v1alpha1_BackendPoolReference(&in.LoadBalancers[i], &loadBalancers[i], s); err != nil {
+				return err
+			}
+		}
+		out.LoadBalancers = &loadBalancers
+	}
+	return nil
+}
+
+// Convert_v1alpha1_InterfaceIPConfig_To_v1beta1_InterfaceIPConfig converts a v1alpha1 InterfaceIPConfig to v1beta1.
+func Convert_v1alpha1_InterfaceIPConfig_To_v1beta1_InterfaceIPConfig(in *v1alpha1.InterfaceIPConfig, out *InterfaceIPConfig, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_InterfaceIPConfig_To_v1beta1_InterfaceIPConfig(in, out, s); err != nil {
+		return err
+	}
+	out.LoadBalancers = nil
+	if in.LoadBalancers != nil {
+		out.LoadBalancers = make([]BackendPoolReference, len(*in.LoadBalancers))
+		for i := range *in.LoadBalancers {
+			if err := Convert_v1alpha1_BackendPoolReference_To_v1beta1_BackendPoolReference(&(*in.LoadBalancers)[i], &out.LoadBalancers[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec converts a v1beta1 LoadBalancerSpec to v1alpha1.
+func Convert_v1beta1_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(in *LoadBalancerSpec, out *v1alpha1.LoadBalancerSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Rules = nil
+	if in.Rules != nil {
+		rules := make([]v1alpha1.RuleSpec, len(in.Rules))
+		for i := range in.Rules {
+			if err := Convert_v1beta1_RuleSpec_To_v1alpha1_RuleSpec(&in.Rules[i], &rules[i], s); err != nil {
+				return err
+			}
+		}
+		out.Rules = &rules
+	}
+	out.Probes = nil
+	if in.Probes != nil {
+		probes := make([]int, len(in.Probes))
+		copy(probes, in.Probes)
+		out.Probes = &probes
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec converts a v1alpha1 LoadBalancerSpec to v1beta1.
+func Convert_v1alpha1_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in *v1alpha1.LoadBalancerSpec, out *LoadBalancerSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Rules = nil
+	if in.Rules != nil {
+		out.Rules = make([]RuleSpec, len(*in.Rules))
+		for i := range *in.Rules {
+			if err := Convert_v1alpha1_RuleSpec_To_v1beta1_RuleSpec(&(*in.Rules)[i], &out.Rules[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	out.Probes = nil
+	if in.Probes != nil {
+		out.Probes = make([]int, len(*in.Probes))
+		copy(out.Probes, *in.Probes)
+	}
+	return nil
+}
+
+// Convert_v1beta1_MonitorConfig_To_v1alpha1_MonitorConfig converts a v1beta1 MonitorConfig to v1alpha1.
+func Convert_v1beta1_MonitorConfig_To_v1alpha1_MonitorConfig(in *MonitorConfig, out *v1alpha1.MonitorConfig, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_MonitorConfig_To_v1alpha1_MonitorConfig(in, out, s); err != nil {
+		return err
+	}
+	out.CustomHeaders = nil
+	if in.CustomHeaders != nil {
+		customHeaders := make([]v1alpha1.MonitorConfigCustomHeadersItem, len(in.CustomHeaders))
+		for i := range in.CustomHeaders {
+			if err := Convert_v1beta1_MonitorConfigCustomHeadersItem_To_v1alpha1_MonitorConfigCustomHeadersItem(&in.CustomHeaders[i], &customHeaders[i], s); err != nil {
+				return err
+			}
+		}
+		out.CustomHeaders = &customHeaders
+	}
+	out.ExpectedStatusCodeRanges = nil
+	if in.ExpectedStatusCodeRanges != nil {
+		expectedStatusCodeRanges := make([]v1alpha1.MonitorConfigExpectedStatusCodeRangesItem, len(in.ExpectedStatusCodeRanges))
+		for i := range in.ExpectedStatusCodeRanges {
+			if err := Convert_v1beta1_MonitorConfigExpectedStatusCodeRangesItem_To_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem(&in.ExpectedStatusCodeRanges[i], &expectedStatusCodeRanges[i], s); err != nil {
+				return err
+			}
+		}
+		out.ExpectedStatusCodeRanges = &expectedStatusCodeRanges
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MonitorConfig_To_v1beta1_MonitorConfig converts a v1alpha1 MonitorConfig to v1beta1.
+func Convert_v1alpha1_MonitorConfig_To_v1beta1_MonitorConfig(in *v1alpha1.MonitorConfig, out *MonitorConfig, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_MonitorConfig_To_v1beta1_MonitorConfig(in, out, s); err != nil {
+		return err
+	}
+	out.CustomHeaders = nil
+	if in.CustomHeaders != nil {
+		out.CustomHeaders = make([]MonitorConfigCustomHeadersItem, len(*in.CustomHeaders))
+		for i := range *in.CustomHeaders {
+			if err := Convert_v1alpha1_MonitorConfigCustomHeadersItem_To_v1beta1_MonitorConfigCustomHeadersItem(&(*in.CustomHeaders)[i], &out.CustomHeaders[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	out.ExpectedStatusCodeRanges = nil
+	if in.ExpectedStatusCodeRanges != nil {
+		out.ExpectedStatusCodeRanges = make([]MonitorConfigExpectedStatusCodeRangesItem, len(*in.ExpectedStatusCodeRanges))
+		for i := range *in.ExpectedStatusCodeRanges {
+			if err := Convert_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem_To_v1beta1_MonitorConfigExpectedStatusCodeRangesItem(&(*in.ExpectedStatusCodeRanges)[i], &out.ExpectedStatusCodeRanges[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec converts a v1beta1 NetworkInterfaceSpec to v1alpha1.
+func Convert_v1beta1_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(in *NetworkInterfaceSpec, out *v1alpha1.NetworkInterfaceSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(in, out, s); err != nil {
+		return err
+	}
+	out.IPConfigurations = nil
+	if in.IPConfigurations != nil {
+		iPConfigurations := make([]v1alpha1.InterfaceIPConfig, len(in.IPConfigurations))
+		for i := range in.IPConfigurations {
+			if err := Convert_v1beta1_InterfaceIPConfig_To_v1alpha1_InterfaceIPConfig(&in.IPConfigurations[i], &iPConfigurations[i], s); err != nil {
+				return err
+			}
+		}
+		out.IPConfigurations = &iPConfigurations
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceSpec_To_v1beta1_NetworkInterfaceSpec converts a v1alpha1 NetworkInterfaceSpec to v1beta1.
+func Convert_v1alpha1_NetworkInterfaceSpec_To_v1beta1_NetworkInterfaceSpec(in *v1alpha1.NetworkInterfaceSpec, out *NetworkInterfaceSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_NetworkInterfaceSpec_To_v1beta1_NetworkInterfaceSpec(in, out, s); err != nil {
+		return err
+	}
+	out.IPConfigurations = nil
+	if in.IPConfigurations != nil {
+		out.IPConfigurations = make([]InterfaceIPConfig, len(*in.IPConfigurations))
+		for i := range *in.IPConfigurations {
+			if err := Convert_v1alpha1_InterfaceIPConfig_To_v1beta1_InterfaceIPConfig(&(*in.IPConfigurations)[i], &out.IPConfigurations[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_TrafficManagerSpec_To_v1alpha1_TrafficManagerSpec converts a v1beta1 TrafficManagerSpec to v1alpha1.
+func Convert_v1beta1_TrafficManagerSpec_To_v1alpha1_TrafficManagerSpec(in *TrafficManagerSpec, out *v1alpha1.TrafficManagerSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_TrafficManagerSpec_To_v1alpha1_TrafficManagerSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Endpoints = nil
+	if in.Endpoints != nil {
+		endpoints := make([]v1alpha1.EndpointSpec, len(in.Endpoints))
+		for i := range in.Endpoints {
+			if err := Convert_v1beta1_EndpointSpec_To_v1alpha1_EndpointSpec(&in.Endpoints[i], &endpoints[i], s); err != nil {
+				return err
+			}
+		}
+		out.Endpoints = &endpoints
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TrafficManagerSpec_To_v1beta1_TrafficManagerSpec converts a v1alpha1 TrafficManagerSpec to v1beta1.
+func Convert_v1alpha1_TrafficManagerSpec_To_v1beta1_TrafficManagerSpec(in *v1alpha1.TrafficManagerSpec, out *TrafficManagerSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_TrafficManagerSpec_To_v1beta1_TrafficManagerSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Endpoints = nil
+	if in.Endpoints != nil {
+		out.Endpoints = make([]EndpointSpec, len(*in.Endpoints))
+		for i := range *in.Endpoints {
+			if err := Convert_v1alpha1_EndpointSpec_To_v1beta1_EndpointSpec(&(*in.Endpoints)[i], &out.Endpoints[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_TrafficManagerStatus_To_v1alpha1_TrafficManagerStatus converts a v1beta1 TrafficManagerStatus to v1alpha1.
+func Convert_v1beta1_TrafficManagerStatus_To_v1alpha1_TrafficManagerStatus(in *TrafficManagerStatus, out *v1alpha1.TrafficManagerStatus, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_TrafficManagerStatus_To_v1alpha1_TrafficManagerStatus(in, out, s); err != nil {
+		return err
+	}
+	out.EndpointStatus = nil
+	if in.EndpointStatus != nil {
+		endpointStatus := make([]v1alpha1.EndpointStatus, len(in.EndpointStatus))
+		for i := range in.EndpointStatus {
+			if err := Convert_v1beta1_EndpointStatus_To_v1alpha1_EndpointStatus(&in.EndpointStatus[i], &endpointStatus[i], s); err != nil {
+				return err
+			}
+		}
+		out.EndpointStatus = &endpointStatus
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TrafficManagerStatus_To_v1beta1_TrafficManagerStatus converts a v1alpha1 TrafficManagerStatus to v1beta1.
+func Convert_v1alpha1_TrafficManagerStatus_To_v1beta1_TrafficManagerStatus(in *v1alpha1.TrafficManagerStatus, out *TrafficManagerStatus, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_TrafficManagerStatus_To_v1beta1_TrafficManagerStatus(in, out, s); err != nil {
+		return err
+	}
+	out.EndpointStatus = nil
+	if in.EndpointStatus != nil {
+		out.EndpointStatus = make([]EndpointStatus, len(*in.EndpointStatus))
+		for i := range *in.EndpointStatus {
+			if err := Convert_v1alpha1_EndpointStatus_To_v1beta1_EndpointStatus(&(*in.EndpointStatus)[i], &out.EndpointStatus[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_VMSpec_To_v1alpha1_VMSpec converts a v1beta1 VMSpec to v1alpha1.
+func Convert_v1beta1_VMSpec_To_v1alpha1_VMSpec(in *VMSpec, out *v1alpha1.VMSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_VMSpec_To_v1alpha1_VMSpec(in, out, s); err != nil {
+		return err
+	}
+	out.SecondaryNICs = nil
+	if in.SecondaryNICs != nil {
+		secondaryNICs := make([]string, len(in.SecondaryNICs))
+		copy(secondaryNICs, in.SecondaryNICs)
+		out.SecondaryNICs = &secondaryNICs
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VMSpec_To_v1beta1_VMSpec converts a v1alpha1 VMSpec to v1beta1.
+func Convert_v1alpha1_VMSpec_To_v1beta1_VMSpec(in *v1alpha1.VMSpec, out *VMSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_VMSpec_To_v1beta1_VMSpec(in, out, s); err != nil {
+		return err
+	}
+	out.SecondaryNICs = nil
+	if in.SecondaryNICs != nil {
+		out.SecondaryNICs = make([]string, len(*in.SecondaryNICs))
+		copy(out.SecondaryNICs, *in.SecondaryNICs)
+	}
+	return nil
+}
+
+// ConvertTo converts this DockerConfig to the hub version.
+func (src *DockerConfig) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_DockerConfig_To_v1alpha1_DockerConfig(src, dstRaw.(*v1alpha1.DockerConfig), nil)
+}
+
+// ConvertFrom converts the hub version to this DockerConfig.
+func (dst *DockerConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_DockerConfig_To_v1beta1_DockerConfig(srcRaw.(*v1alpha1.DockerConfig), dst, nil)
+}
+
+// ConvertTo converts this Identity to the hub version.
+func (src *Identity) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_Identity_To_v1alpha1_Identity(src, dstRaw.(*v1alpha1.Identity), nil)
+}
+
+// ConvertFrom converts the hub version to this Identity.
+func (dst *Identity) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_Identity_To_v1beta1_Identity(srcRaw.(*v1alpha1.Identity), dst, nil)
+}
+
+// ConvertTo converts this Keyvault to the hub version.
+func (src *Keyvault) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_Keyvault_To_v1alpha1_Keyvault(src, dstRaw.(*v1alpha1.Keyvault), nil)
+}
+
+// ConvertFrom converts the hub version to this Keyvault.
+func (dst *Keyvault) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_Keyvault_To_v1beta1_Keyvault(srcRaw.(*v1alpha1.Keyvault), dst, nil)
+}
+
+// ConvertTo converts this LoadBalancer to the hub version.
+func (src *LoadBalancer) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_LoadBalancer_To_v1alpha1_LoadBalancer(src, dstRaw.(*v1alpha1.LoadBalancer), nil)
+}
+
+// ConvertFrom converts the hub version to this LoadBalancer.
+func (dst *LoadBalancer) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_LoadBalancer_To_v1beta1_LoadBalancer(srcRaw.(*v1alpha1.LoadBalancer), dst, nil)
+}
+
+// ConvertTo converts this NetworkInterface to the hub version.
+func (src *NetworkInterface) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_NetworkInterface_To_v1alpha1_NetworkInterface(src, dstRaw.(*v1alpha1.NetworkInterface), nil)
+}
+
+// ConvertFrom converts the hub version to this NetworkInterface.
+func (dst *NetworkInterface) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_NetworkInterface_To_v1beta1_NetworkInterface(srcRaw.(*v1alpha1.NetworkInterface), dst, nil)
+}
+
+// ConvertTo converts this PublicIP to the hub version.
+func (src *PublicIP) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_PublicIP_To_v1alpha1_PublicIP(src, dstRaw.(*v1alpha1.PublicIP), nil)
+}
+
+// ConvertFrom converts the hub version to this PublicIP.
+func (dst *PublicIP) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_PublicIP_To_v1beta1_PublicIP(srcRaw.(*v1alpha1.PublicIP), dst, nil)
+}
+
+// ConvertTo converts this Redis to the hub version.
+func (src *Redis) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_Redis_To_v1alpha1_Redis(src, dstRaw.(*v1alpha1.Redis), nil)
+}
+
+// ConvertFrom converts the hub version to this Redis.
+func (dst *Redis) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_Redis_To_v1beta1_Redis(srcRaw.(*v1alpha1.Redis), dst, nil)
+}
+
+// ConvertTo converts this RedisKey to the hub version.
+func (src *RedisKey) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_RedisKey_To_v1alpha1_RedisKey(src, dstRaw.(*v1alpha1.RedisKey), nil)
+}
+
+// ConvertFrom converts the hub version to this RedisKey.
+func (dst *RedisKey) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_RedisKey_To_v1beta1_RedisKey(srcRaw.(*v1alpha1.RedisKey), dst, nil)
+}
+
+// ConvertTo converts this ResourceGroup to the hub version.
+func (src *ResourceGroup) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_ResourceGroup_To_v1alpha1_ResourceGroup(src, dstRaw.(*v1alpha1.ResourceGroup), nil)
+}
+
+// ConvertFrom converts the hub version to this ResourceGroup.
+func (dst *ResourceGroup) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_ResourceGroup_To_v1beta1_ResourceGroup(srcRaw.(*v1alpha1.ResourceGroup), dst, nil)
+}
+
+// ConvertTo converts this Secret to the hub version.
+func (src *Secret) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_Secret_To_v1alpha1_Secret(src, dstRaw.(*v1alpha1.Secret), nil)
+}
+
+// ConvertFrom converts the hub version to this Secret.
+func (dst *Secret) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_Secret_To_v1beta1_Secret(srcRaw.(*v1alpha1.Secret), dst, nil)
+}
+
+// ConvertTo converts this SecretBundle to the hub version.
+func (src *SecretBundle) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_SecretBundle_To_v1alpha1_SecretBundle(src, dstRaw.(*v1alpha1.SecretBundle), nil)
+}
+
+// ConvertFrom converts the hub version to this SecretBundle.
+func (dst *SecretBundle) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_SecretBundle_To_v1beta1_SecretBundle(srcRaw.(*v1alpha1.SecretBundle), dst, nil)
+}
+
+// ConvertTo converts this SecurityGroup to the hub version.
+func (src *SecurityGroup) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_SecurityGroup_To_v1alpha1_SecurityGroup(src, dstRaw.(*v1alpha1.SecurityGroup), nil)
+}
+
+// ConvertFrom converts the hub version to this SecurityGroup.
+func (dst *SecurityGroup) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_SecurityGroup_To_v1beta1_SecurityGroup(srcRaw.(*v1alpha1.SecurityGroup), dst, nil)
+}
+
+// ConvertTo converts this ServiceBusKey to the hub version.
+func (src *ServiceBusKey) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_ServiceBusKey_To_v1alpha1_ServiceBusKey(src, dstRaw.(*v1alpha1.ServiceBusKey), nil)
+}
+
+// ConvertFrom converts the hub version to this ServiceBusKey.
+func (dst *ServiceBusKey) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_ServiceBusKey_To_v1beta1_ServiceBusKey(srcRaw.(*v1alpha1.ServiceBusKey), dst, nil)
+}
+
+// ConvertTo converts this ServiceBusNamespace to the hub version.
+func (src *ServiceBusNamespace) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_ServiceBusNamespace_To_v1alpha1_ServiceBusNamespace(src, dstRaw.(*v1alpha1.ServiceBusNamespace), nil)
+}
+
+// ConvertFrom converts the hub version to this ServiceBusNamespace.
+func (dst *ServiceBusNamespace) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_ServiceBusNamespace_To_v1beta1_ServiceBusNamespace(srcRaw.(*v1alpha1.ServiceBusNamespace), dst, nil)
+}
+
+// ConvertTo converts this SQLFirewallRule to the hub version.
+func (src *SQLFirewallRule) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_SQLFirewallRule_To_v1alpha1_SQLFirewallRule(src, dstRaw.(*v1alpha1.SQLFirewallRule), nil)
+}
+
+// ConvertFrom converts the hub version to this SQLFirewallRule.
+func (dst *SQLFirewallRule) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_SQLFirewallRule_To_v1beta1_SQLFirewallRule(srcRaw.(*v1alpha1.SQLFirewallRule), dst, nil)
+}
+
+// ConvertTo converts this SQLServer to the hub version.
+func (src *SQLServer) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_SQLServer_To_v1alpha1_SQLServer(src, dstRaw.(*v1alpha1.SQLServer), nil)
+}
+
+// ConvertFrom converts the hub version to this SQLServer.
+func (dst *SQLServer) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_SQLServer_To_v1beta1_SQLServer(srcRaw.(*v1alpha1.SQLServer), dst, nil)
+}
+
+// ConvertTo converts this StorageAccount to the hub version.
+func (src *StorageAccount) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_StorageAccount_To_v1alpha1_StorageAccount(src, dstRaw.(*v1alpha1.StorageAccount), nil)
+}
+
+// ConvertFrom converts the hub version to this StorageAccount.
+func (dst *StorageAccount) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_StorageAccount_To_v1beta1_StorageAccount(srcRaw.(*v1alpha1.StorageAccount), dst, nil)
+}
+
+// ConvertTo converts this StorageKey to the hub version.
+func (src *StorageKey) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_StorageKey_To_v1alpha1_StorageKey(src, dstRaw.(*v1alpha1.StorageKey), nil)
+}
+
+// ConvertFrom converts the hub version to this StorageKey.
+func (dst *StorageKey) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_StorageKey_To_v1beta1_StorageKey(srcRaw.(*v1alpha1.StorageKey), dst, nil)
+}
+
+// ConvertTo converts this Subnet to the hub version.
+func (src *Subnet) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_Subnet_To_v1alpha1_Subnet(src, dstRaw.(*v1alpha1.Subnet), nil)
+}
+
+// ConvertFrom converts the hub version to this Subnet.
+func (dst *Subnet) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_Subnet_To_v1beta1_Subnet(srcRaw.(*v1alpha1.Subnet), dst, nil)
+}
+
+// ConvertTo converts this TLSSecret to the hub version.
+func (src *TLSSecret) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_TLSSecret_To_v1alpha1_TLSSecret(src, dstRaw.(*v1alpha1.TLSSecret), nil)
+}
+
+// ConvertFrom converts the hub version to this TLSSecret.
+func (dst *TLSSecret) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_TLSSecret_To_v1beta1_TLSSecret(srcRaw.(*v1alpha1.TLSSecret), dst, nil)
+}
+
+// ConvertTo converts this TrafficManager to the hub version.
+func (src *TrafficManager) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_TrafficManager_To_v1alpha1_TrafficManager(src, dstRaw.(*v1alpha1.TrafficManager), nil)
+}
+
+// ConvertFrom converts the hub version to this TrafficManager.
+func (dst *TrafficManager) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_TrafficManager_To_v1beta1_TrafficManager(srcRaw.(*v1alpha1.TrafficManager), dst, nil)
+}
+
+// ConvertTo converts this VirtualNetwork to the hub version.
+func (src *VirtualNetwork) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_VirtualNetwork_To_v1alpha1_VirtualNetwork(src, dstRaw.(*v1alpha1.VirtualNetwork), nil)
+}
+
+// ConvertFrom converts the hub version to this VirtualNetwork.
+func (dst *VirtualNetwork) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_VirtualNetwork_To_v1beta1_VirtualNetwork(srcRaw.(*v1alpha1.VirtualNetwork), dst, nil)
+}
+
+// ConvertTo converts this VM to the hub version.
+func (src *VM) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_VM_To_v1alpha1_VM(src, dstRaw.(*v1alpha1.VM), nil)
+}
+
+// ConvertFrom converts the hub version to this VM.
+func (dst *VM) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_VM_To_v1beta1_VM(srcRaw.(*v1alpha1.VM), dst, nil)
+}
+
+// ConvertTo converts this VMScaleSet to the hub version.
+func (src *VMScaleSet) ConvertTo(dstRaw conversion.Hub) error {
+	return Convert_v1beta1_VMScaleSet_To_v1alpha1_VMScaleSet(src, dstRaw.(*v1alpha1.VMScaleSet), nil)
+}
+
+// ConvertFrom converts the hub version to this VMScaleSet.
+func (dst *VMScaleSet) ConvertFrom(srcRaw conversion.Hub) error {
+	return Convert_v1alpha1_VMScaleSet_To_v1beta1_VMScaleSet(srcRaw.(*v1alpha1.VMScaleSet), dst, nil)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1_test
+
+import (
+	"encoding/json"
+	"math/rand"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	webhookconversion "sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/api/v1beta1"
+)
+
+// fuzzIterations is the number of random objects of each kind converted in each direction.
+const fuzzIterations = 20
+
+var _ = Describe("conversion", func() {
+
+	scheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(scheme)
+	_ = v1beta1.AddToScheme(scheme)
+
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(rand.Int63()), serializer.NewCodecFactory(scheme))
+
+	newObject := func(gvk schema.GroupVersionKind) runtime.Object {
+		obj, err := scheme.New(gvk)
+		Expect(err).ToNot(HaveOccurred())
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+		return obj
+	}
+
+	fuzzed := func(gvk schema.GroupVersionKind) runtime.Object {
+		obj := newObject(gvk)
+		f.Fuzz(obj)
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+		return obj
+	}
+
+	// kinds lists every top level kind of v1beta1, skipping lists.
+	kinds := func() []string {
+		var names []string
+		for gvk := range scheme.AllKnownTypes() {
+			if gvk.GroupVersion() != v1beta1.GroupVersion {
+				continue
+			}
+			if _, ok := newObject(gvk).(conversion.Convertible); ok {
+				names = append(names, gvk.Kind)
+			}
+		}
+		return names
+	}
+
+	It("should make every kind convertible", func() {
+		for gvk := range scheme.AllKnownTypes() {
+			if gvk.GroupVersion() != v1alpha1.GroupVersion {
+				continue
+			}
+			obj := newObject(gvk)
+			if _, ok := obj.(conversion.Hub); !ok {
+				continue
+			}
+			convertible, err := webhookconversion.IsConvertible(scheme, obj)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(convertible).To(BeTrue(), gvk.Kind)
+		}
+		Expect(kinds()).To(HaveLen(24))
+	})
+
+	It("should round trip v1beta1 objects through v1alpha1", func() {
+		for _, kind := range kinds() {
+			for i := 0; i < fuzzIterations; i++ {
+				spoke := fuzzed(v1beta1.GroupVersion.WithKind(kind))
+				hub := newObject(v1alpha1.GroupVersion.WithKind(kind))
+				Expect(spoke.(conversion.Convertible).ConvertTo(hub.(conversion.Hub))).To(Succeed())
+				Expect(hub.GetObjectKind().GroupVersionKind().Version).To(Equal("v1alpha1"))
+
+				back := newObject(v1beta1.GroupVersion.WithKind(kind))
+				Expect(back.(conversion.Convertible).ConvertFrom(hub.(conversion.Hub))).To(Succeed())
+				Expect(apiequality.Semantic.DeepEqual(spoke, back)).To(BeTrue(), cmp.Diff(spoke, back))
+			}
+		}
+	})
+
+	It("should round trip v1alpha1 objects through v1beta1", func() {
+		for _, kind := range kinds() {
+			for i := 0; i < fuzzIterations; i++ {
+				hub := fuzzed(v1alpha1.GroupVersion.WithKind(kind))
+				spoke := newObject(v1beta1.GroupVersion.WithKind(kind))
+				Expect(spoke.(conversion.Convertible).ConvertFrom(hub.(conversion.Hub))).To(Succeed())
+				Expect(spoke.GetObjectKind().GroupVersionKind().Version).To(Equal("v1beta1"))
+
+				back := newObject(v1alpha1.GroupVersion.WithKind(kind))
+				Expect(spoke.(conversion.Convertible).ConvertTo(back.(conversion.Hub))).To(Succeed())
+				Expect(apiequality.Semantic.DeepEqual(hub, back)).To(BeTrue(), cmp.Diff(hub, back))
+			}
+		}
+	})
+
+	It("should serialize the subscription of a traffic manager as subscriptionId", func() {
+		hub := &v1alpha1.TrafficManager{Spec: v1alpha1.TrafficManagerSpec{SubscriptionID: "sub"}}
+		spoke := &v1beta1.TrafficManager{}
+		Expect(spoke.ConvertFrom(hub)).To(Succeed())
+		Expect(spoke.Spec.SubscriptionID).To(Equal("sub"))
+
+		data, err := json.Marshal(spoke.Spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"subscriptionId":"sub"`))
+		Expect(string(data)).ToNot(ContainSubstring("endpoints"))
+	})
+
+	It("should convert pointer slices to plain slices", func() {
+		hub := &v1alpha1.LoadBalancer{Spec: v1alpha1.LoadBalancerSpec{
+			Rules:  &[]v1alpha1.RuleSpec{{Name: "https", FrontendPort: 443, BackendPort: 8443}},
+			Probes: &[]int{8443},
+		}}
+		spoke := &v1beta1.LoadBalancer{}
+		Expect(spoke.ConvertFrom(hub)).To(Succeed())
+		Expect(spoke.Spec.Rules).To(Equal([]v1beta1.RuleSpec{{Name: "https", FrontendPort: 443, BackendPort: 8443}}))
+		Expect(spoke.Spec.Probes).To(Equal([]int{8443}))
+	})
+})
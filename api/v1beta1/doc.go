@@ -0,0 +1,7 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+// Conversions to and from the v1alpha1 hub are generated by conversion-gen into zz_generated.conversion.go.
+// +k8s:conversion-gen=github.com/alexeldeib/incendiary-iguana/api/v1alpha1
+package v1beta1
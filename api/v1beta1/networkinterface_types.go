@@ -0,0 +1,103 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkInterfaceSpec defines the desired state of NetworkInterface
+type NetworkInterfaceSpec struct {
+	// Name is the name of the security group.
+	Name string `json:"name"`
+	// Location osecurity group (e.g., eastus2)
+	Location string `json:"location"`
+	// ResourceGroup containsecurity group.
+	ResourceGroup string `json:"resourceGroup"`
+	// SubscriptionID contains the Resource group. Is a GUID.
+	SubscriptionID string `json:"subscriptionId"`
+	// Network is the name of the VNet containing this subnet
+	// +optional
+	Network string `json:"network,omitempty"`
+	// Subnet contains the name to an Azure subnet which this NIC should belong to.
+	// +optional
+	Subnet string `json:"subnet,omitempty"`
+	// SubnetRef optionally references a Subnet object which this NIC should belong to. It takes precedence over Network and Subnet.
+	// +optional
+	SubnetRef *ObjectReference `json:"subnetRef,omitempty"`
+	// IPConfigurations is an array of IP configurations belonging to this interface.
+	IPConfigurations []InterfaceIPConfig `json:"ipConfigurations,omitempty"`
+}
+
+// InterfaceIPConfig describes a single IP configuration for a NIC.
+type InterfaceIPConfig struct {
+	// PublicIP contains an optional reference to an existing IP address to bind to this NIC.
+	PublicIP *ResourceReference `json:"publicIP,omitempty"`
+	// PublicIPRef optionally references a PublicIP object to bind to this NIC. It takes precedence over PublicIP.
+	// +optional
+	PublicIPRef *ObjectReference `json:"publicIPRef,omitempty"`
+	// PrivateIP contains an optional private IP address to bind to this NIC.
+	PrivateIP *string `json:"privateIP,omitempty"`
+	// BackendPoolReferences contains an optional reference to a Load Balancer backend pool for this configuration.
+	LoadBalancers []BackendPoolReference `json:"loadBalancers,omitempty"`
+}
+
+// ResourceReference contains information to identify a generic Azure resource.
+type ResourceReference struct {
+	// Name is the name of the referenced resource.
+	Name string `json:"name"`
+	// ResourceGroup contain the referenced resource.
+	ResourceGroup string `json:"resourceGroup"`
+	// SubscriptionID contains the Resource group. Is a GUID.
+	SubscriptionID string `json:"subscriptionId"`
+}
+
+// BackendPoolReference contains a reference to a Load Balancer backend pool for this configuration.
+type BackendPoolReference struct {
+	// Name is the name of the referenced resource.
+	Name string `json:"name"`
+	// LoadBalancer is the name of the associated Load balancer.
+	LoadBalancer string `json:"loadBalancer"`
+	// ResourceGroup contain the referenced resource.
+	ResourceGroup string `json:"resourceGroup"`
+	// SubscriptionID contains the Resource group. Is a GUID.
+	SubscriptionID string `json:"subscriptionId"`
+}
+
+// NetworkInterfaceStatus defines the observed state of NetworkInterface
+type NetworkInterfaceStatus struct {
+	// ProvisioningState sync the provisioning status of the resource from Azure.
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	// ID is the fully qualified Azure resource ID.
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=networkinterfaces,shortName={nic,nics},categories=all
+
+// NetworkInterface is the Schema for the networkinterfaces API
+type NetworkInterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkInterfaceSpec   `json:"spec,omitempty"`
+	Status NetworkInterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// NetworkInterfaceList contains a list of NetworkInterface
+type NetworkInterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkInterface `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NetworkInterface{}, &NetworkInterfaceList{})
+}
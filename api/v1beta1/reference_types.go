@@ -0,0 +1,13 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1
+
+// ObjectReference points at another custom resource managed by this operator in the same cluster.
+type ObjectReference struct {
+	// Name of the referenced object.
+	Name string `json:"name"`
+	// Namespace of the referenced object. Defaults to the namespace of the referencing object.
+	Namespace string `json:"namespace,omitempty"`
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubnetSpec defines the desired state of Subnet
+type SubnetSpec struct {
+	// Name is the name of the Azure Virtual Network.
+	Name string `json:"name"`
+	// ResourceGroup contains the Virtual Network.
+	ResourceGroup string `json:"resourceGroup"`
+	// SubscriptionID contains the Resource group. Is a GUID.
+	SubscriptionID string `json:"subscriptionId"`
+	// Network is the name of the VNet containing this subnet
+	// +optional
+	Network string `json:"network,omitempty"`
+	// NetworkRef optionally references a VirtualNetwork object containing this subnet. It takes precedence over Network.
+	// +optional
+	NetworkRef *ObjectReference `json:"networkRef,omitempty"`
+	// Subnet is the desired CIDR block of this subnet. Must be defined in addresses of the network.
+	Subnet string `json:"subnet"`
+}
+
+// SubnetStatus defines the observed state of Subnet
+type SubnetStatus struct {
+	// ProvisioningState sync the provisioning status of the resource from Azure.
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	// ID is the fully qualified Azure resource ID.
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=subnets,categories=all
+
+// Subnet is the Schema for the subnets API
+type Subnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetSpec   `json:"spec,omitempty"`
+	Status SubnetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SubnetList contains a list of Subnet
+type SubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subnet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualNetworkSpec defines the desired state of VirtualNetwork
+type VirtualNetworkSpec struct {
+	// Name is the name of the Azure Virtual Network.
+	Name string `json:"name"`
+	// Location of the Virtual Network (e.g., eastus2)
+	Location string `json:"location"`
+	// ResourceGroup contains the Virtual Network.
+	ResourceGroup string `json:"resourceGroup"`
+	// SubscriptionID contains the Resource group. Is a GUID.
+	SubscriptionID string `json:"subscriptionId"`
+	// Addresses is an array of CIDR blocks describing the available addresses on this virtual network.
+	Addresses []string `json:"addresses"`
+}
+
+// VirtualNetworkStatus defines the observed state of VirtualNetwork
+type VirtualNetworkStatus struct {
+	// ProvisioningState sync the provisioning status of the resource from Azure.
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	// ID is the fully qualified Azure resource ID.
+	ID             *string `json:"id,omitempty"`
+	ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=virtualnetworks,shortName=vnet,categories=all
+
+// VirtualNetwork is the Schema for the virtualnetworks API
+type VirtualNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualNetworkSpec   `json:"spec,omitempty"`
+	Status VirtualNetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VirtualNetworkList contains a list of VirtualNetwork
+type VirtualNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualNetwork `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualNetwork{}, &VirtualNetworkList{})
+}
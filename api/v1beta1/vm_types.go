@@ -0,0 +1,79 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VMSpec defines the desired state of VM
+type VMSpec struct {
+	// Name is the name of the security group.
+	Name string `json:"name"`
+	// Location osecurity group (e.g., eastus2)
+	Location string `json:"location"`
+	// Zone indicates the Availability Zone for this machine. Usually either "1", "2", or "3".
+	Zone *string `json:"zone,omitempty"`
+	// ResourceGroup containsecurity group.
+	ResourceGroup string `json:"resourceGroup"`
+	// SubscriptionID contains the Resource group. Is a GUID.
+	SubscriptionID string `json:"subscriptionId"`
+	// SKU is the sku of the machine in Azure, e.g. Standard_E4_v3
+	SKU string `json:"sku"`
+	// CustomData is the cloud-init/script user data for the machine.
+	CustomData *string `json:"customData,omitempty"`
+	// SSHPublicKey is the key of the of the provisioned user on the VM.
+	SSHPublicKey string `json:"sshPublicKey"`
+	// PrimaryNIC is the Azure ID of the primary NIC on this machine.
+	// +optional
+	PrimaryNIC string `json:"primaryNic,omitempty"`
+	// PrimaryNICRef optionally references the NetworkInterface object to use as the primary NIC. It takes precedence over PrimaryNIC.
+	// +optional
+	PrimaryNICRef *ObjectReference `json:"primaryNicRef,omitempty"`
+	// SecondaryNICs is the list of IDs of non-primary NICs on this machine. +optional
+	SecondaryNICs []string `json:"secondaryNics,omitempty"`
+	// SecondaryNICRefs optionally references NetworkInterface objects to attach in addition to SecondaryNICs.
+	// +optional
+	SecondaryNICRefs []ObjectReference `json:"secondaryNicRefs,omitempty"`
+	// DiskSize is the size of the OS disk in GB
+	DiskSize int32 `json:"diskSize"`
+}
+
+// VMStatus defines the observed state of VM
+type VMStatus struct {
+	// ProvisioningState sync the provisioning status of the resource from Azure.
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	// ID is the fully qualified Azure resource ID.
+	ID *string `json:"id,omitempty"`
+	// Zone indicates the Availability Zone for this machine. Usually either "1", "2", or "3".
+	Zone           *string `json:"zone,omitempty"`
+	ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=vms,shortName=vm,categories=all
+
+// VM is the Schema for the VMs API
+type VM struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMSpec   `json:"spec,omitempty"`
+	Status VMStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMList contains a list of VM
+type VMList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VM `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VM{}, &VMList{})
+}
@@ -0,0 +1,4617 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	unsafe "unsafe"
+
+	network "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	v1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AzureError)(nil), (*v1alpha1.AzureError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AzureError_To_v1alpha1_AzureError(a.(*AzureError), b.(*v1alpha1.AzureError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AzureError)(nil), (*AzureError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AzureError_To_v1beta1_AzureError(a.(*v1alpha1.AzureError), b.(*AzureError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AzureRef)(nil), (*v1alpha1.AzureRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AzureRef_To_v1alpha1_AzureRef(a.(*AzureRef), b.(*v1alpha1.AzureRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AzureRef)(nil), (*AzureRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AzureRef_To_v1beta1_AzureRef(a.(*v1alpha1.AzureRef), b.(*AzureRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackendPoolReference)(nil), (*v1alpha1.BackendPoolReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackendPoolReference_To_v1alpha1_BackendPoolReference(a.(*BackendPoolReference), b.(*v1alpha1.BackendPoolReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BackendPoolReference)(nil), (*BackendPoolReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackendPoolReference_To_v1beta1_BackendPoolReference(a.(*v1alpha1.BackendPoolReference), b.(*BackendPoolReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*v1alpha1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Condition_To_v1alpha1_Condition(a.(*Condition), b.(*v1alpha1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Condition)(nil), (*Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Condition_To_v1beta1_Condition(a.(*v1alpha1.Condition), b.(*Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSConfig)(nil), (*v1alpha1.DNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSConfig_To_v1alpha1_DNSConfig(a.(*DNSConfig), b.(*v1alpha1.DNSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DNSConfig)(nil), (*DNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSConfig_To_v1beta1_DNSConfig(a.(*v1alpha1.DNSConfig), b.(*DNSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DockerConfig)(nil), (*v1alpha1.DockerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DockerConfig_To_v1alpha1_DockerConfig(a.(*DockerConfig), b.(*v1alpha1.DockerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DockerConfig)(nil), (*DockerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DockerConfig_To_v1beta1_DockerConfig(a.(*v1alpha1.DockerConfig), b.(*DockerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DockerConfigList)(nil), (*v1alpha1.DockerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DockerConfigList_To_v1alpha1_DockerConfigList(a.(*DockerConfigList), b.(*v1alpha1.DockerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DockerConfigList)(nil), (*DockerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DockerConfigList_To_v1beta1_DockerConfigList(a.(*v1alpha1.DockerConfigList), b.(*DockerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DockerConfigSpec)(nil), (*v1alpha1.DockerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DockerConfigSpec_To_v1alpha1_DockerConfigSpec(a.(*DockerConfigSpec), b.(*v1alpha1.DockerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DockerConfigSpec)(nil), (*DockerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DockerConfigSpec_To_v1beta1_DockerConfigSpec(a.(*v1alpha1.DockerConfigSpec), b.(*DockerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DockerConfigStatus)(nil), (*v1alpha1.DockerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DockerConfigStatus_To_v1alpha1_DockerConfigStatus(a.(*DockerConfigStatus), b.(*v1alpha1.DockerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DockerConfigStatus)(nil), (*DockerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DockerConfigStatus_To_v1beta1_DockerConfigStatus(a.(*v1alpha1.DockerConfigStatus), b.(*DockerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EndpointProperties)(nil), (*v1alpha1.EndpointProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EndpointProperties_To_v1alpha1_EndpointProperties(a.(*EndpointProperties), b.(*v1alpha1.EndpointProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.EndpointProperties)(nil), (*EndpointProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EndpointProperties_To_v1beta1_EndpointProperties(a.(*v1alpha1.EndpointProperties), b.(*EndpointProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EndpointSpec)(nil), (*v1alpha1.EndpointSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EndpointSpec_To_v1alpha1_EndpointSpec(a.(*EndpointSpec), b.(*v1alpha1.EndpointSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.EndpointSpec)(nil), (*EndpointSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EndpointSpec_To_v1beta1_EndpointSpec(a.(*v1alpha1.EndpointSpec), b.(*EndpointSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EndpointStatus)(nil), (*v1alpha1.EndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EndpointStatus_To_v1alpha1_EndpointStatus(a.(*EndpointStatus), b.(*v1alpha1.EndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.EndpointStatus)(nil), (*EndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EndpointStatus_To_v1beta1_EndpointStatus(a.(*v1alpha1.EndpointStatus), b.(*EndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FrontendIPConfigurationSpec)(nil), (*v1alpha1.FrontendIPConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FrontendIPConfigurationSpec_To_v1alpha1_FrontendIPConfigurationSpec(a.(*FrontendIPConfigurationSpec), b.(*v1alpha1.FrontendIPConfigurationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.FrontendIPConfigurationSpec)(nil), (*FrontendIPConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FrontendIPConfigurationSpec_To_v1beta1_FrontendIPConfigurationSpec(a.(*v1alpha1.FrontendIPConfigurationSpec), b.(*FrontendIPConfigurationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Identity)(nil), (*v1alpha1.Identity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Identity_To_v1alpha1_Identity(a.(*Identity), b.(*v1alpha1.Identity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Identity)(nil), (*Identity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Identity_To_v1beta1_Identity(a.(*v1alpha1.Identity), b.(*Identity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IdentityList)(nil), (*v1alpha1.IdentityList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IdentityList_To_v1alpha1_IdentityList(a.(*IdentityList), b.(*v1alpha1.IdentityList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.IdentityList)(nil), (*IdentityList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentityList_To_v1beta1_IdentityList(a.(*v1alpha1.IdentityList), b.(*IdentityList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IdentitySpec)(nil), (*v1alpha1.IdentitySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IdentitySpec_To_v1alpha1_IdentitySpec(a.(*IdentitySpec), b.(*v1alpha1.IdentitySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.IdentitySpec)(nil), (*IdentitySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentitySpec_To_v1beta1_IdentitySpec(a.(*v1alpha1.IdentitySpec), b.(*IdentitySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IdentityStatus)(nil), (*v1alpha1.IdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IdentityStatus_To_v1alpha1_IdentityStatus(a.(*IdentityStatus), b.(*v1alpha1.IdentityStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.IdentityStatus)(nil), (*IdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentityStatus_To_v1beta1_IdentityStatus(a.(*v1alpha1.IdentityStatus), b.(*IdentityStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InterfaceIPConfig)(nil), (*v1alpha1.InterfaceIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceIPConfig_To_v1alpha1_InterfaceIPConfig(a.(*InterfaceIPConfig), b.(*v1alpha1.InterfaceIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.InterfaceIPConfig)(nil), (*InterfaceIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceIPConfig_To_v1beta1_InterfaceIPConfig(a.(*v1alpha1.InterfaceIPConfig), b.(*InterfaceIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Keyvault)(nil), (*v1alpha1.Keyvault)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Keyvault_To_v1alpha1_Keyvault(a.(*Keyvault), b.(*v1alpha1.Keyvault), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Keyvault)(nil), (*Keyvault)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Keyvault_To_v1beta1_Keyvault(a.(*v1alpha1.Keyvault), b.(*Keyvault), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KeyvaultList)(nil), (*v1alpha1.KeyvaultList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KeyvaultList_To_v1alpha1_KeyvaultList(a.(*KeyvaultList), b.(*v1alpha1.KeyvaultList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.KeyvaultList)(nil), (*KeyvaultList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KeyvaultList_To_v1beta1_KeyvaultList(a.(*v1alpha1.KeyvaultList), b.(*KeyvaultList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KeyvaultSpec)(nil), (*v1alpha1.KeyvaultSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KeyvaultSpec_To_v1alpha1_KeyvaultSpec(a.(*KeyvaultSpec), b.(*v1alpha1.KeyvaultSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.KeyvaultSpec)(nil), (*KeyvaultSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KeyvaultSpec_To_v1beta1_KeyvaultSpec(a.(*v1alpha1.KeyvaultSpec), b.(*KeyvaultSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KeyvaultStatus)(nil), (*v1alpha1.KeyvaultStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KeyvaultStatus_To_v1alpha1_KeyvaultStatus(a.(*KeyvaultStatus), b.(*v1alpha1.KeyvaultStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.KeyvaultStatus)(nil), (*KeyvaultStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KeyvaultStatus_To_v1beta1_KeyvaultStatus(a.(*v1alpha1.KeyvaultStatus), b.(*KeyvaultStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancer)(nil), (*v1alpha1.LoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancer_To_v1alpha1_LoadBalancer(a.(*LoadBalancer), b.(*v1alpha1.LoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LoadBalancer)(nil), (*LoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancer_To_v1beta1_LoadBalancer(a.(*v1alpha1.LoadBalancer), b.(*LoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerList)(nil), (*v1alpha1.LoadBalancerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancerList_To_v1alpha1_LoadBalancerList(a.(*LoadBalancerList), b.(*v1alpha1.LoadBalancerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LoadBalancerList)(nil), (*LoadBalancerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerList_To_v1beta1_LoadBalancerList(a.(*v1alpha1.LoadBalancerList), b.(*LoadBalancerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerSpec)(nil), (*v1alpha1.LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(a.(*LoadBalancerSpec), b.(*v1alpha1.LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LoadBalancerSpec)(nil), (*LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(a.(*v1alpha1.LoadBalancerSpec), b.(*LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerStatus)(nil), (*v1alpha1.LoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancerStatus_To_v1alpha1_LoadBalancerStatus(a.(*LoadBalancerStatus), b.(*v1alpha1.LoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LoadBalancerStatus)(nil), (*LoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(a.(*v1alpha1.LoadBalancerStatus), b.(*LoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MonitorConfig)(nil), (*v1alpha1.MonitorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitorConfig_To_v1alpha1_MonitorConfig(a.(*MonitorConfig), b.(*v1alpha1.MonitorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.MonitorConfig)(nil), (*MonitorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MonitorConfig_To_v1beta1_MonitorConfig(a.(*v1alpha1.MonitorConfig), b.(*MonitorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MonitorConfigCustomHeadersItem)(nil), (*v1alpha1.MonitorConfigCustomHeadersItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitorConfigCustomHeadersItem_To_v1alpha1_MonitorConfigCustomHeadersItem(a.(*MonitorConfigCustomHeadersItem), b.(*v1alpha1.MonitorConfigCustomHeadersItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.MonitorConfigCustomHeadersItem)(nil), (*MonitorConfigCustomHeadersItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MonitorConfigCustomHeadersItem_To_v1beta1_MonitorConfigCustomHeadersItem(a.(*v1alpha1.MonitorConfigCustomHeadersItem), b.(*MonitorConfigCustomHeadersItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MonitorConfigExpectedStatusCodeRangesItem)(nil), (*v1alpha1.MonitorConfigExpectedStatusCodeRangesItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitorConfigExpectedStatusCodeRangesItem_To_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem(a.(*MonitorConfigExpectedStatusCodeRangesItem), b.(*v1alpha1.MonitorConfigExpectedStatusCodeRangesItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.MonitorConfigExpectedStatusCodeRangesItem)(nil), (*MonitorConfigExpectedStatusCodeRangesItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem_To_v1beta1_MonitorConfigExpectedStatusCodeRangesItem(a.(*v1alpha1.MonitorConfigExpectedStatusCodeRangesItem), b.(*MonitorConfigExpectedStatusCodeRangesItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterface)(nil), (*v1alpha1.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkInterface_To_v1alpha1_NetworkInterface(a.(*NetworkInterface), b.(*v1alpha1.NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterface_To_v1beta1_NetworkInterface(a.(*v1alpha1.NetworkInterface), b.(*NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceList)(nil), (*v1alpha1.NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(a.(*NetworkInterfaceList), b.(*v1alpha1.NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NetworkInterfaceList)(nil), (*NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceList_To_v1beta1_NetworkInterfaceList(a.(*v1alpha1.NetworkInterfaceList), b.(*NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceSpec)(nil), (*v1alpha1.NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(a.(*NetworkInterfaceSpec), b.(*v1alpha1.NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NetworkInterfaceSpec)(nil), (*NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceSpec_To_v1beta1_NetworkInterfaceSpec(a.(*v1alpha1.NetworkInterfaceSpec), b.(*NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceStatus)(nil), (*v1alpha1.NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(a.(*NetworkInterfaceStatus), b.(*v1alpha1.NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NetworkInterfaceStatus)(nil), (*NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceStatus_To_v1beta1_NetworkInterfaceStatus(a.(*v1alpha1.NetworkInterfaceStatus), b.(*NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ObjectReference)(nil), (*v1alpha1.ObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ObjectReference_To_v1alpha1_ObjectReference(a.(*ObjectReference), b.(*v1alpha1.ObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ObjectReference)(nil), (*ObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectReference_To_v1beta1_ObjectReference(a.(*v1alpha1.ObjectReference), b.(*ObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Operation)(nil), (*v1alpha1.Operation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Operation_To_v1alpha1_Operation(a.(*Operation), b.(*v1alpha1.Operation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Operation)(nil), (*Operation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Operation_To_v1beta1_Operation(a.(*v1alpha1.Operation), b.(*Operation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperationRecord)(nil), (*v1alpha1.OperationRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OperationRecord_To_v1alpha1_OperationRecord(a.(*OperationRecord), b.(*v1alpha1.OperationRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OperationRecord)(nil), (*OperationRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperationRecord_To_v1beta1_OperationRecord(a.(*v1alpha1.OperationRecord), b.(*OperationRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIP)(nil), (*v1alpha1.PublicIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PublicIP_To_v1alpha1_PublicIP(a.(*PublicIP), b.(*v1alpha1.PublicIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIP)(nil), (*PublicIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIP_To_v1beta1_PublicIP(a.(*v1alpha1.PublicIP), b.(*PublicIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPList)(nil), (*v1alpha1.PublicIPList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PublicIPList_To_v1alpha1_PublicIPList(a.(*PublicIPList), b.(*v1alpha1.PublicIPList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIPList)(nil), (*PublicIPList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPList_To_v1beta1_PublicIPList(a.(*v1alpha1.PublicIPList), b.(*PublicIPList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPSpec)(nil), (*v1alpha1.PublicIPSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PublicIPSpec_To_v1alpha1_PublicIPSpec(a.(*PublicIPSpec), b.(*v1alpha1.PublicIPSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIPSpec)(nil), (*PublicIPSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPSpec_To_v1beta1_PublicIPSpec(a.(*v1alpha1.PublicIPSpec), b.(*PublicIPSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPStatus)(nil), (*v1alpha1.PublicIPStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PublicIPStatus_To_v1alpha1_PublicIPStatus(a.(*PublicIPStatus), b.(*v1alpha1.PublicIPStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PublicIPStatus)(nil), (*PublicIPStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPStatus_To_v1beta1_PublicIPStatus(a.(*v1alpha1.PublicIPStatus), b.(*PublicIPStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Redis)(nil), (*v1alpha1.Redis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Redis_To_v1alpha1_Redis(a.(*Redis), b.(*v1alpha1.Redis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Redis)(nil), (*Redis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Redis_To_v1beta1_Redis(a.(*v1alpha1.Redis), b.(*Redis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisKey)(nil), (*v1alpha1.RedisKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisKey_To_v1alpha1_RedisKey(a.(*RedisKey), b.(*v1alpha1.RedisKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisKey)(nil), (*RedisKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisKey_To_v1beta1_RedisKey(a.(*v1alpha1.RedisKey), b.(*RedisKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisKeyList)(nil), (*v1alpha1.RedisKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisKeyList_To_v1alpha1_RedisKeyList(a.(*RedisKeyList), b.(*v1alpha1.RedisKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisKeyList)(nil), (*RedisKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisKeyList_To_v1beta1_RedisKeyList(a.(*v1alpha1.RedisKeyList), b.(*RedisKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisKeySpec)(nil), (*v1alpha1.RedisKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisKeySpec_To_v1alpha1_RedisKeySpec(a.(*RedisKeySpec), b.(*v1alpha1.RedisKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisKeySpec)(nil), (*RedisKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisKeySpec_To_v1beta1_RedisKeySpec(a.(*v1alpha1.RedisKeySpec), b.(*RedisKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisKeyStatus)(nil), (*v1alpha1.RedisKeyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisKeyStatus_To_v1alpha1_RedisKeyStatus(a.(*RedisKeyStatus), b.(*v1alpha1.RedisKeyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisKeyStatus)(nil), (*RedisKeyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisKeyStatus_To_v1beta1_RedisKeyStatus(a.(*v1alpha1.RedisKeyStatus), b.(*RedisKeyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisList)(nil), (*v1alpha1.RedisList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisList_To_v1alpha1_RedisList(a.(*RedisList), b.(*v1alpha1.RedisList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisList)(nil), (*RedisList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisList_To_v1beta1_RedisList(a.(*v1alpha1.RedisList), b.(*RedisList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisSku)(nil), (*v1alpha1.RedisSku)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisSku_To_v1alpha1_RedisSku(a.(*RedisSku), b.(*v1alpha1.RedisSku), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisSku)(nil), (*RedisSku)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisSku_To_v1beta1_RedisSku(a.(*v1alpha1.RedisSku), b.(*RedisSku), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisSpec)(nil), (*v1alpha1.RedisSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisSpec_To_v1alpha1_RedisSpec(a.(*RedisSpec), b.(*v1alpha1.RedisSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisSpec)(nil), (*RedisSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisSpec_To_v1beta1_RedisSpec(a.(*v1alpha1.RedisSpec), b.(*RedisSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedisStatus)(nil), (*v1alpha1.RedisStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RedisStatus_To_v1alpha1_RedisStatus(a.(*RedisStatus), b.(*v1alpha1.RedisStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RedisStatus)(nil), (*RedisStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedisStatus_To_v1beta1_RedisStatus(a.(*v1alpha1.RedisStatus), b.(*RedisStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*v1alpha1.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceGroup_To_v1alpha1_ResourceGroup(a.(*ResourceGroup), b.(*v1alpha1.ResourceGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceGroup)(nil), (*ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceGroup_To_v1beta1_ResourceGroup(a.(*v1alpha1.ResourceGroup), b.(*ResourceGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceGroupList)(nil), (*v1alpha1.ResourceGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceGroupList_To_v1alpha1_ResourceGroupList(a.(*ResourceGroupList), b.(*v1alpha1.ResourceGroupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceGroupList)(nil), (*ResourceGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceGroupList_To_v1beta1_ResourceGroupList(a.(*v1alpha1.ResourceGroupList), b.(*ResourceGroupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceGroupSpec)(nil), (*v1alpha1.ResourceGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceGroupSpec_To_v1alpha1_ResourceGroupSpec(a.(*ResourceGroupSpec), b.(*v1alpha1.ResourceGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceGroupSpec)(nil), (*ResourceGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceGroupSpec_To_v1beta1_ResourceGroupSpec(a.(*v1alpha1.ResourceGroupSpec), b.(*ResourceGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceGroupStatus)(nil), (*v1alpha1.ResourceGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceGroupStatus_To_v1alpha1_ResourceGroupStatus(a.(*ResourceGroupStatus), b.(*v1alpha1.ResourceGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceGroupStatus)(nil), (*ResourceGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceGroupStatus_To_v1beta1_ResourceGroupStatus(a.(*v1alpha1.ResourceGroupStatus), b.(*ResourceGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceReference)(nil), (*v1alpha1.ResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceReference_To_v1alpha1_ResourceReference(a.(*ResourceReference), b.(*v1alpha1.ResourceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceReference)(nil), (*ResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceReference_To_v1beta1_ResourceReference(a.(*v1alpha1.ResourceReference), b.(*ResourceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceStatus)(nil), (*v1alpha1.ResourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(a.(*ResourceStatus), b.(*v1alpha1.ResourceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ResourceStatus)(nil), (*ResourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(a.(*v1alpha1.ResourceStatus), b.(*ResourceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RuleSpec)(nil), (*v1alpha1.RuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RuleSpec_To_v1alpha1_RuleSpec(a.(*RuleSpec), b.(*v1alpha1.RuleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RuleSpec)(nil), (*RuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RuleSpec_To_v1beta1_RuleSpec(a.(*v1alpha1.RuleSpec), b.(*RuleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLFirewallRule)(nil), (*v1alpha1.SQLFirewallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLFirewallRule_To_v1alpha1_SQLFirewallRule(a.(*SQLFirewallRule), b.(*v1alpha1.SQLFirewallRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLFirewallRule)(nil), (*SQLFirewallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLFirewallRule_To_v1beta1_SQLFirewallRule(a.(*v1alpha1.SQLFirewallRule), b.(*SQLFirewallRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLFirewallRuleList)(nil), (*v1alpha1.SQLFirewallRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLFirewallRuleList_To_v1alpha1_SQLFirewallRuleList(a.(*SQLFirewallRuleList), b.(*v1alpha1.SQLFirewallRuleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLFirewallRuleList)(nil), (*SQLFirewallRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLFirewallRuleList_To_v1beta1_SQLFirewallRuleList(a.(*v1alpha1.SQLFirewallRuleList), b.(*SQLFirewallRuleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLFirewallRuleSpec)(nil), (*v1alpha1.SQLFirewallRuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLFirewallRuleSpec_To_v1alpha1_SQLFirewallRuleSpec(a.(*SQLFirewallRuleSpec), b.(*v1alpha1.SQLFirewallRuleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLFirewallRuleSpec)(nil), (*SQLFirewallRuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLFirewallRuleSpec_To_v1beta1_SQLFirewallRuleSpec(a.(*v1alpha1.SQLFirewallRuleSpec), b.(*SQLFirewallRuleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLFirewallRuleStatus)(nil), (*v1alpha1.SQLFirewallRuleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLFirewallRuleStatus_To_v1alpha1_SQLFirewallRuleStatus(a.(*SQLFirewallRuleStatus), b.(*v1alpha1.SQLFirewallRuleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLFirewallRuleStatus)(nil), (*SQLFirewallRuleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLFirewallRuleStatus_To_v1beta1_SQLFirewallRuleStatus(a.(*v1alpha1.SQLFirewallRuleStatus), b.(*SQLFirewallRuleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLServer)(nil), (*v1alpha1.SQLServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLServer_To_v1alpha1_SQLServer(a.(*SQLServer), b.(*v1alpha1.SQLServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLServer)(nil), (*SQLServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLServer_To_v1beta1_SQLServer(a.(*v1alpha1.SQLServer), b.(*SQLServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLServerList)(nil), (*v1alpha1.SQLServerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLServerList_To_v1alpha1_SQLServerList(a.(*SQLServerList), b.(*v1alpha1.SQLServerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLServerList)(nil), (*SQLServerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLServerList_To_v1beta1_SQLServerList(a.(*v1alpha1.SQLServerList), b.(*SQLServerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLServerSpec)(nil), (*v1alpha1.SQLServerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLServerSpec_To_v1alpha1_SQLServerSpec(a.(*SQLServerSpec), b.(*v1alpha1.SQLServerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLServerSpec)(nil), (*SQLServerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLServerSpec_To_v1beta1_SQLServerSpec(a.(*v1alpha1.SQLServerSpec), b.(*SQLServerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SQLServerStatus)(nil), (*v1alpha1.SQLServerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SQLServerStatus_To_v1alpha1_SQLServerStatus(a.(*SQLServerStatus), b.(*v1alpha1.SQLServerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SQLServerStatus)(nil), (*SQLServerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SQLServerStatus_To_v1beta1_SQLServerStatus(a.(*v1alpha1.SQLServerStatus), b.(*SQLServerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Secret)(nil), (*v1alpha1.Secret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Secret_To_v1alpha1_Secret(a.(*Secret), b.(*v1alpha1.Secret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Secret)(nil), (*Secret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Secret_To_v1beta1_Secret(a.(*v1alpha1.Secret), b.(*Secret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretBundle)(nil), (*v1alpha1.SecretBundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBundle_To_v1alpha1_SecretBundle(a.(*SecretBundle), b.(*v1alpha1.SecretBundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretBundle)(nil), (*SecretBundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretBundle_To_v1beta1_SecretBundle(a.(*v1alpha1.SecretBundle), b.(*SecretBundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretBundleList)(nil), (*v1alpha1.SecretBundleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBundleList_To_v1alpha1_SecretBundleList(a.(*SecretBundleList), b.(*v1alpha1.SecretBundleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretBundleList)(nil), (*SecretBundleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretBundleList_To_v1beta1_SecretBundleList(a.(*v1alpha1.SecretBundleList), b.(*SecretBundleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretBundleSpec)(nil), (*v1alpha1.SecretBundleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBundleSpec_To_v1alpha1_SecretBundleSpec(a.(*SecretBundleSpec), b.(*v1alpha1.SecretBundleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretBundleSpec)(nil), (*SecretBundleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretBundleSpec_To_v1beta1_SecretBundleSpec(a.(*v1alpha1.SecretBundleSpec), b.(*SecretBundleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretBundleStatus)(nil), (*v1alpha1.SecretBundleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBundleStatus_To_v1alpha1_SecretBundleStatus(a.(*SecretBundleStatus), b.(*v1alpha1.SecretBundleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretBundleStatus)(nil), (*SecretBundleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretBundleStatus_To_v1beta1_SecretBundleStatus(a.(*v1alpha1.SecretBundleStatus), b.(*SecretBundleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretIdentifier)(nil), (*v1alpha1.SecretIdentifier)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretIdentifier_To_v1alpha1_SecretIdentifier(a.(*SecretIdentifier), b.(*v1alpha1.SecretIdentifier), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretIdentifier)(nil), (*SecretIdentifier)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretIdentifier_To_v1beta1_SecretIdentifier(a.(*v1alpha1.SecretIdentifier), b.(*SecretIdentifier), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretList)(nil), (*v1alpha1.SecretList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretList_To_v1alpha1_SecretList(a.(*SecretList), b.(*v1alpha1.SecretList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretList)(nil), (*SecretList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretList_To_v1beta1_SecretList(a.(*v1alpha1.SecretList), b.(*SecretList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretSpec)(nil), (*v1alpha1.SecretSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretSpec_To_v1alpha1_SecretSpec(a.(*SecretSpec), b.(*v1alpha1.SecretSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretSpec)(nil), (*SecretSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretSpec_To_v1beta1_SecretSpec(a.(*v1alpha1.SecretSpec), b.(*SecretSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretStatus)(nil), (*v1alpha1.SecretStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretStatus_To_v1alpha1_SecretStatus(a.(*SecretStatus), b.(*v1alpha1.SecretStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretStatus)(nil), (*SecretStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretStatus_To_v1beta1_SecretStatus(a.(*v1alpha1.SecretStatus), b.(*SecretStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*v1alpha1.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecurityGroup_To_v1alpha1_SecurityGroup(a.(*SecurityGroup), b.(*v1alpha1.SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroup_To_v1beta1_SecurityGroup(a.(*v1alpha1.SecurityGroup), b.(*SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupList)(nil), (*v1alpha1.SecurityGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecurityGroupList_To_v1alpha1_SecurityGroupList(a.(*SecurityGroupList), b.(*v1alpha1.SecurityGroupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecurityGroupList)(nil), (*SecurityGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroupList_To_v1beta1_SecurityGroupList(a.(*v1alpha1.SecurityGroupList), b.(*SecurityGroupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupSpec)(nil), (*v1alpha1.SecurityGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecurityGroupSpec_To_v1alpha1_SecurityGroupSpec(a.(*SecurityGroupSpec), b.(*v1alpha1.SecurityGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecurityGroupSpec)(nil), (*SecurityGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroupSpec_To_v1beta1_SecurityGroupSpec(a.(*v1alpha1.SecurityGroupSpec), b.(*SecurityGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupStatus)(nil), (*v1alpha1.SecurityGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecurityGroupStatus_To_v1alpha1_SecurityGroupStatus(a.(*SecurityGroupStatus), b.(*v1alpha1.SecurityGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecurityGroupStatus)(nil), (*SecurityGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(a.(*v1alpha1.SecurityGroupStatus), b.(*SecurityGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityRule)(nil), (*v1alpha1.SecurityRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecurityRule_To_v1alpha1_SecurityRule(a.(*SecurityRule), b.(*v1alpha1.SecurityRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecurityRule)(nil), (*SecurityRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityRule_To_v1beta1_SecurityRule(a.(*v1alpha1.SecurityRule), b.(*SecurityRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusKey)(nil), (*v1alpha1.ServiceBusKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusKey_To_v1alpha1_ServiceBusKey(a.(*ServiceBusKey), b.(*v1alpha1.ServiceBusKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusKey)(nil), (*ServiceBusKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusKey_To_v1beta1_ServiceBusKey(a.(*v1alpha1.ServiceBusKey), b.(*ServiceBusKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusKeyList)(nil), (*v1alpha1.ServiceBusKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusKeyList_To_v1alpha1_ServiceBusKeyList(a.(*ServiceBusKeyList), b.(*v1alpha1.ServiceBusKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusKeyList)(nil), (*ServiceBusKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusKeyList_To_v1beta1_ServiceBusKeyList(a.(*v1alpha1.ServiceBusKeyList), b.(*ServiceBusKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusKeySpec)(nil), (*v1alpha1.ServiceBusKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusKeySpec_To_v1alpha1_ServiceBusKeySpec(a.(*ServiceBusKeySpec), b.(*v1alpha1.ServiceBusKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusKeySpec)(nil), (*ServiceBusKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusKeySpec_To_v1beta1_ServiceBusKeySpec(a.(*v1alpha1.ServiceBusKeySpec), b.(*ServiceBusKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusKeyStatus)(nil), (*v1alpha1.ServiceBusKeyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusKeyStatus_To_v1alpha1_ServiceBusKeyStatus(a.(*ServiceBusKeyStatus), b.(*v1alpha1.ServiceBusKeyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusKeyStatus)(nil), (*ServiceBusKeyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusKeyStatus_To_v1beta1_ServiceBusKeyStatus(a.(*v1alpha1.ServiceBusKeyStatus), b.(*ServiceBusKeyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusNamespace)(nil), (*v1alpha1.ServiceBusNamespace)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusNamespace_To_v1alpha1_ServiceBusNamespace(a.(*ServiceBusNamespace), b.(*v1alpha1.ServiceBusNamespace), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusNamespace)(nil), (*ServiceBusNamespace)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusNamespace_To_v1beta1_ServiceBusNamespace(a.(*v1alpha1.ServiceBusNamespace), b.(*ServiceBusNamespace), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusNamespaceList)(nil), (*v1alpha1.ServiceBusNamespaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusNamespaceList_To_v1alpha1_ServiceBusNamespaceList(a.(*ServiceBusNamespaceList), b.(*v1alpha1.ServiceBusNamespaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusNamespaceList)(nil), (*ServiceBusNamespaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusNamespaceList_To_v1beta1_ServiceBusNamespaceList(a.(*v1alpha1.ServiceBusNamespaceList), b.(*ServiceBusNamespaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusNamespaceSku)(nil), (*v1alpha1.ServiceBusNamespaceSku)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusNamespaceSku_To_v1alpha1_ServiceBusNamespaceSku(a.(*ServiceBusNamespaceSku), b.(*v1alpha1.ServiceBusNamespaceSku), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusNamespaceSku)(nil), (*ServiceBusNamespaceSku)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusNamespaceSku_To_v1beta1_ServiceBusNamespaceSku(a.(*v1alpha1.ServiceBusNamespaceSku), b.(*ServiceBusNamespaceSku), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusNamespaceSpec)(nil), (*v1alpha1.ServiceBusNamespaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusNamespaceSpec_To_v1alpha1_ServiceBusNamespaceSpec(a.(*ServiceBusNamespaceSpec), b.(*v1alpha1.ServiceBusNamespaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusNamespaceSpec)(nil), (*ServiceBusNamespaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusNamespaceSpec_To_v1beta1_ServiceBusNamespaceSpec(a.(*v1alpha1.ServiceBusNamespaceSpec), b.(*ServiceBusNamespaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBusNamespaceStatus)(nil), (*v1alpha1.ServiceBusNamespaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBusNamespaceStatus_To_v1alpha1_ServiceBusNamespaceStatus(a.(*ServiceBusNamespaceStatus), b.(*v1alpha1.ServiceBusNamespaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ServiceBusNamespaceStatus)(nil), (*ServiceBusNamespaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceBusNamespaceStatus_To_v1beta1_ServiceBusNamespaceStatus(a.(*v1alpha1.ServiceBusNamespaceStatus), b.(*ServiceBusNamespaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageAccount)(nil), (*v1alpha1.StorageAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageAccount_To_v1alpha1_StorageAccount(a.(*StorageAccount), b.(*v1alpha1.StorageAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageAccount)(nil), (*StorageAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageAccount_To_v1beta1_StorageAccount(a.(*v1alpha1.StorageAccount), b.(*StorageAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageAccountList)(nil), (*v1alpha1.StorageAccountList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageAccountList_To_v1alpha1_StorageAccountList(a.(*StorageAccountList), b.(*v1alpha1.StorageAccountList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageAccountList)(nil), (*StorageAccountList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageAccountList_To_v1beta1_StorageAccountList(a.(*v1alpha1.StorageAccountList), b.(*StorageAccountList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageAccountSpec)(nil), (*v1alpha1.StorageAccountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageAccountSpec_To_v1alpha1_StorageAccountSpec(a.(*StorageAccountSpec), b.(*v1alpha1.StorageAccountSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageAccountSpec)(nil), (*StorageAccountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageAccountSpec_To_v1beta1_StorageAccountSpec(a.(*v1alpha1.StorageAccountSpec), b.(*StorageAccountSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageAccountStatus)(nil), (*v1alpha1.StorageAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageAccountStatus_To_v1alpha1_StorageAccountStatus(a.(*StorageAccountStatus), b.(*v1alpha1.StorageAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageAccountStatus)(nil), (*StorageAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageAccountStatus_To_v1beta1_StorageAccountStatus(a.(*v1alpha1.StorageAccountStatus), b.(*StorageAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageKey)(nil), (*v1alpha1.StorageKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageKey_To_v1alpha1_StorageKey(a.(*StorageKey), b.(*v1alpha1.StorageKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageKey)(nil), (*StorageKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageKey_To_v1beta1_StorageKey(a.(*v1alpha1.StorageKey), b.(*StorageKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageKeyList)(nil), (*v1alpha1.StorageKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageKeyList_To_v1alpha1_StorageKeyList(a.(*StorageKeyList), b.(*v1alpha1.StorageKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageKeyList)(nil), (*StorageKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageKeyList_To_v1beta1_StorageKeyList(a.(*v1alpha1.StorageKeyList), b.(*StorageKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageKeySpec)(nil), (*v1alpha1.StorageKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageKeySpec_To_v1alpha1_StorageKeySpec(a.(*StorageKeySpec), b.(*v1alpha1.StorageKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageKeySpec)(nil), (*StorageKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageKeySpec_To_v1beta1_StorageKeySpec(a.(*v1alpha1.StorageKeySpec), b.(*StorageKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageKeyStatus)(nil), (*v1alpha1.StorageKeyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageKeyStatus_To_v1alpha1_StorageKeyStatus(a.(*StorageKeyStatus), b.(*v1alpha1.StorageKeyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StorageKeyStatus)(nil), (*StorageKeyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageKeyStatus_To_v1beta1_StorageKeyStatus(a.(*v1alpha1.StorageKeyStatus), b.(*StorageKeyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*v1alpha1.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Subnet_To_v1alpha1_Subnet(a.(*Subnet), b.(*v1alpha1.Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Subnet_To_v1beta1_Subnet(a.(*v1alpha1.Subnet), b.(*Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SubnetList)(nil), (*v1alpha1.SubnetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SubnetList_To_v1alpha1_SubnetList(a.(*SubnetList), b.(*v1alpha1.SubnetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SubnetList)(nil), (*SubnetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubnetList_To_v1beta1_SubnetList(a.(*v1alpha1.SubnetList), b.(*SubnetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SubnetSpec)(nil), (*v1alpha1.SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SubnetSpec_To_v1alpha1_SubnetSpec(a.(*SubnetSpec), b.(*v1alpha1.SubnetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubnetSpec_To_v1beta1_SubnetSpec(a.(*v1alpha1.SubnetSpec), b.(*SubnetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SubnetStatus)(nil), (*v1alpha1.SubnetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SubnetStatus_To_v1alpha1_SubnetStatus(a.(*SubnetStatus), b.(*v1alpha1.SubnetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SubnetStatus)(nil), (*SubnetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubnetStatus_To_v1beta1_SubnetStatus(a.(*v1alpha1.SubnetStatus), b.(*SubnetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSSecret)(nil), (*v1alpha1.TLSSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TLSSecret_To_v1alpha1_TLSSecret(a.(*TLSSecret), b.(*v1alpha1.TLSSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TLSSecret)(nil), (*TLSSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSSecret_To_v1beta1_TLSSecret(a.(*v1alpha1.TLSSecret), b.(*TLSSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSSecretList)(nil), (*v1alpha1.TLSSecretList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TLSSecretList_To_v1alpha1_TLSSecretList(a.(*TLSSecretList), b.(*v1alpha1.TLSSecretList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TLSSecretList)(nil), (*TLSSecretList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSSecretList_To_v1beta1_TLSSecretList(a.(*v1alpha1.TLSSecretList), b.(*TLSSecretList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSSecretSpec)(nil), (*v1alpha1.TLSSecretSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TLSSecretSpec_To_v1alpha1_TLSSecretSpec(a.(*TLSSecretSpec), b.(*v1alpha1.TLSSecretSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TLSSecretSpec)(nil), (*TLSSecretSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSSecretSpec_To_v1beta1_TLSSecretSpec(a.(*v1alpha1.TLSSecretSpec), b.(*TLSSecretSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSSecretStatus)(nil), (*v1alpha1.TLSSecretStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TLSSecretStatus_To_v1alpha1_TLSSecretStatus(a.(*TLSSecretStatus), b.(*v1alpha1.TLSSecretStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TLSSecretStatus)(nil), (*TLSSecretStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSSecretStatus_To_v1beta1_TLSSecretStatus(a.(*v1alpha1.TLSSecretStatus), b.(*TLSSecretStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficManager)(nil), (*v1alpha1.TrafficManager)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficManager_To_v1alpha1_TrafficManager(a.(*TrafficManager), b.(*v1alpha1.TrafficManager), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TrafficManager)(nil), (*TrafficManager)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficManager_To_v1beta1_TrafficManager(a.(*v1alpha1.TrafficManager), b.(*TrafficManager), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficManagerList)(nil), (*v1alpha1.TrafficManagerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficManagerList_To_v1alpha1_TrafficManagerList(a.(*TrafficManagerList), b.(*v1alpha1.TrafficManagerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TrafficManagerList)(nil), (*TrafficManagerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficManagerList_To_v1beta1_TrafficManagerList(a.(*v1alpha1.TrafficManagerList), b.(*TrafficManagerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficManagerSpec)(nil), (*v1alpha1.TrafficManagerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficManagerSpec_To_v1alpha1_TrafficManagerSpec(a.(*TrafficManagerSpec), b.(*v1alpha1.TrafficManagerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TrafficManagerSpec)(nil), (*TrafficManagerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficManagerSpec_To_v1beta1_TrafficManagerSpec(a.(*v1alpha1.TrafficManagerSpec), b.(*TrafficManagerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficManagerStatus)(nil), (*v1alpha1.TrafficManagerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficManagerStatus_To_v1alpha1_TrafficManagerStatus(a.(*TrafficManagerStatus), b.(*v1alpha1.TrafficManagerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TrafficManagerStatus)(nil), (*TrafficManagerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficManagerStatus_To_v1beta1_TrafficManagerStatus(a.(*v1alpha1.TrafficManagerStatus), b.(*TrafficManagerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VM)(nil), (*v1alpha1.VM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VM_To_v1alpha1_VM(a.(*VM), b.(*v1alpha1.VM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VM)(nil), (*VM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VM_To_v1beta1_VM(a.(*v1alpha1.VM), b.(*VM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMList)(nil), (*v1alpha1.VMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMList_To_v1alpha1_VMList(a.(*VMList), b.(*v1alpha1.VMList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VMList)(nil), (*VMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMList_To_v1beta1_VMList(a.(*v1alpha1.VMList), b.(*VMList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMScaleSet)(nil), (*v1alpha1.VMScaleSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMScaleSet_To_v1alpha1_VMScaleSet(a.(*VMScaleSet), b.(*v1alpha1.VMScaleSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VMScaleSet)(nil), (*VMScaleSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMScaleSet_To_v1beta1_VMScaleSet(a.(*v1alpha1.VMScaleSet), b.(*VMScaleSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMScaleSetList)(nil), (*v1alpha1.VMScaleSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMScaleSetList_To_v1alpha1_VMScaleSetList(a.(*VMScaleSetList), b.(*v1alpha1.VMScaleSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VMScaleSetList)(nil), (*VMScaleSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMScaleSetList_To_v1beta1_VMScaleSetList(a.(*v1alpha1.VMScaleSetList), b.(*VMScaleSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMScaleSetSpec)(nil), (*v1alpha1.VMScaleSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMScaleSetSpec_To_v1alpha1_VMScaleSetSpec(a.(*VMScaleSetSpec), b.(*v1alpha1.VMScaleSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VMScaleSetSpec)(nil), (*VMScaleSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMScaleSetSpec_To_v1beta1_VMScaleSetSpec(a.(*v1alpha1.VMScaleSetSpec), b.(*VMScaleSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMScaleSetStatus)(nil), (*v1alpha1.VMScaleSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMScaleSetStatus_To_v1alpha1_VMScaleSetStatus(a.(*VMScaleSetStatus), b.(*v1alpha1.VMScaleSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VMScaleSetStatus)(nil), (*VMScaleSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMScaleSetStatus_To_v1beta1_VMScaleSetStatus(a.(*v1alpha1.VMScaleSetStatus), b.(*VMScaleSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMSpec)(nil), (*v1alpha1.VMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMSpec_To_v1alpha1_VMSpec(a.(*VMSpec), b.(*v1alpha1.VMSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VMSpec)(nil), (*VMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMSpec_To_v1beta1_VMSpec(a.(*v1alpha1.VMSpec), b.(*VMSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMStatus)(nil), (*v1alpha1.VMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMStatus_To_v1alpha1_VMStatus(a.(*VMStatus), b.(*v1alpha1.VMStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VMStatus)(nil), (*VMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMStatus_To_v1beta1_VMStatus(a.(*v1alpha1.VMStatus), b.(*VMStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetwork)(nil), (*v1alpha1.VirtualNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualNetwork_To_v1alpha1_VirtualNetwork(a.(*VirtualNetwork), b.(*v1alpha1.VirtualNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VirtualNetwork)(nil), (*VirtualNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualNetwork_To_v1beta1_VirtualNetwork(a.(*v1alpha1.VirtualNetwork), b.(*VirtualNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkList)(nil), (*v1alpha1.VirtualNetworkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualNetworkList_To_v1alpha1_VirtualNetworkList(a.(*VirtualNetworkList), b.(*v1alpha1.VirtualNetworkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VirtualNetworkList)(nil), (*VirtualNetworkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualNetworkList_To_v1beta1_VirtualNetworkList(a.(*v1alpha1.VirtualNetworkList), b.(*VirtualNetworkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkSpec)(nil), (*v1alpha1.VirtualNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualNetworkSpec_To_v1alpha1_VirtualNetworkSpec(a.(*VirtualNetworkSpec), b.(*v1alpha1.VirtualNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VirtualNetworkSpec)(nil), (*VirtualNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualNetworkSpec_To_v1beta1_VirtualNetworkSpec(a.(*v1alpha1.VirtualNetworkSpec), b.(*VirtualNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkStatus)(nil), (*v1alpha1.VirtualNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VirtualNetworkStatus_To_v1alpha1_VirtualNetworkStatus(a.(*VirtualNetworkStatus), b.(*v1alpha1.VirtualNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VirtualNetworkStatus)(nil), (*VirtualNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualNetworkStatus_To_v1beta1_VirtualNetworkStatus(a.(*v1alpha1.VirtualNetworkStatus), b.(*VirtualNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.EndpointProperties)(nil), (*EndpointProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EndpointProperties_To_v1beta1_EndpointProperties(a.(*v1alpha1.EndpointProperties), b.(*EndpointProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.InterfaceIPConfig)(nil), (*InterfaceIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InterfaceIPConfig_To_v1beta1_InterfaceIPConfig(a.(*v1alpha1.InterfaceIPConfig), b.(*InterfaceIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.LoadBalancerSpec)(nil), (*LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(a.(*v1alpha1.LoadBalancerSpec), b.(*LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.MonitorConfig)(nil), (*MonitorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MonitorConfig_To_v1beta1_MonitorConfig(a.(*v1alpha1.MonitorConfig), b.(*MonitorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.NetworkInterfaceSpec)(nil), (*NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceSpec_To_v1beta1_NetworkInterfaceSpec(a.(*v1alpha1.NetworkInterfaceSpec), b.(*NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.TrafficManagerSpec)(nil), (*TrafficManagerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficManagerSpec_To_v1beta1_TrafficManagerSpec(a.(*v1alpha1.TrafficManagerSpec), b.(*TrafficManagerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.TrafficManagerStatus)(nil), (*TrafficManagerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficManagerStatus_To_v1beta1_TrafficManagerStatus(a.(*v1alpha1.TrafficManagerStatus), b.(*TrafficManagerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.VMSpec)(nil), (*VMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMSpec_To_v1beta1_VMSpec(a.(*v1alpha1.VMSpec), b.(*VMSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*EndpointProperties)(nil), (*v1alpha1.EndpointProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EndpointProperties_To_v1alpha1_EndpointProperties(a.(*EndpointProperties), b.(*v1alpha1.EndpointProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*InterfaceIPConfig)(nil), (*v1alpha1.InterfaceIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InterfaceIPConfig_To_v1alpha1_InterfaceIPConfig(a.(*InterfaceIPConfig), b.(*v1alpha1.InterfaceIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*LoadBalancerSpec)(nil), (*v1alpha1.LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(a.(*LoadBalancerSpec), b.(*v1alpha1.LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*MonitorConfig)(nil), (*v1alpha1.MonitorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitorConfig_To_v1alpha1_MonitorConfig(a.(*MonitorConfig), b.(*v1alpha1.MonitorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NetworkInterfaceSpec)(nil), (*v1alpha1.NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(a.(*NetworkInterfaceSpec), b.(*v1alpha1.NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TrafficManagerSpec)(nil), (*v1alpha1.TrafficManagerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficManagerSpec_To_v1alpha1_TrafficManagerSpec(a.(*TrafficManagerSpec), b.(*v1alpha1.TrafficManagerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TrafficManagerStatus)(nil), (*v1alpha1.TrafficManagerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficManagerStatus_To_v1alpha1_TrafficManagerStatus(a.(*TrafficManagerStatus), b.(*v1alpha1.TrafficManagerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VMSpec)(nil), (*v1alpha1.VMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VMSpec_To_v1alpha1_VMSpec(a.(*VMSpec), b.(*v1alpha1.VMSpec), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_AzureError_To_v1alpha1_AzureError(in *AzureError, out *v1alpha1.AzureError, s conversion.Scope) error {
+	out.Code = in.Code
+	out.Category = in.Category
+	out.Message = in.Message
+	out.Target = in.Target
+	out.RequestID = in.RequestID
+	out.CorrelationID = in.CorrelationID
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1beta1_AzureError_To_v1alpha1_AzureError is an autogenerated conversion function.
+func Convert_v1beta1_AzureError_To_v1alpha1_AzureError(in *AzureError, out *v1alpha1.AzureError, s conversion.Scope) error {
+	return autoConvert_v1beta1_AzureError_To_v1alpha1_AzureError(in, out, s)
+}
+
+func autoConvert_v1alpha1_AzureError_To_v1beta1_AzureError(in *v1alpha1.AzureError, out *AzureError, s conversion.Scope) error {
+	out.Code = in.Code
+	out.Category = in.Category
+	out.Message = in.Message
+	out.Target = in.Target
+	out.RequestID = in.RequestID
+	out.CorrelationID = in.CorrelationID
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1alpha1_AzureError_To_v1beta1_AzureError is an autogenerated conversion function.
+func Convert_v1alpha1_AzureError_To_v1beta1_AzureError(in *v1alpha1.AzureError, out *AzureError, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AzureError_To_v1beta1_AzureError(in, out, s)
+}
+
+func autoConvert_v1beta1_AzureRef_To_v1alpha1_AzureRef(in *AzureRef, out *v1alpha1.AzureRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1beta1_AzureRef_To_v1alpha1_AzureRef is an autogenerated conversion function.
+func Convert_v1beta1_AzureRef_To_v1alpha1_AzureRef(in *AzureRef, out *v1alpha1.AzureRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_AzureRef_To_v1alpha1_AzureRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_AzureRef_To_v1beta1_AzureRef(in *v1alpha1.AzureRef, out *AzureRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1alpha1_AzureRef_To_v1beta1_AzureRef is an autogenerated conversion function.
+func Convert_v1alpha1_AzureRef_To_v1beta1_AzureRef(in *v1alpha1.AzureRef, out *AzureRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AzureRef_To_v1beta1_AzureRef(in, out, s)
+}
+
+func autoConvert_v1beta1_BackendPoolReference_To_v1alpha1_BackendPoolReference(in *BackendPoolReference, out *v1alpha1.BackendPoolReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LoadBalancer = in.LoadBalancer
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1beta1_BackendPoolReference_To_v1alpha1_BackendPoolReference is an autogenerated conversion function.
+func Convert_v1beta1_BackendPoolReference_To_v1alpha1_BackendPoolReference(in *BackendPoolReference, out *v1alpha1.BackendPoolReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackendPoolReference_To_v1alpha1_BackendPoolReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackendPoolReference_To_v1beta1_BackendPoolReference(in *v1alpha1.BackendPoolReference, out *BackendPoolReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LoadBalancer = in.LoadBalancer
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1alpha1_BackendPoolReference_To_v1beta1_BackendPoolReference is an autogenerated conversion function.
+func Convert_v1alpha1_BackendPoolReference_To_v1beta1_BackendPoolReference(in *v1alpha1.BackendPoolReference, out *BackendPoolReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackendPoolReference_To_v1beta1_BackendPoolReference(in, out, s)
+}
+
+func autoConvert_v1beta1_Condition_To_v1alpha1_Condition(in *Condition, out *v1alpha1.Condition, s conversion.Scope) error {
+	out.Type = v1alpha1.ConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1beta1_Condition_To_v1alpha1_Condition is an autogenerated conversion function.
+func Convert_v1beta1_Condition_To_v1alpha1_Condition(in *Condition, out *v1alpha1.Condition, s conversion.Scope) error {
+	return autoConvert_v1beta1_Condition_To_v1alpha1_Condition(in, out, s)
+}
+
+func autoConvert_v1alpha1_Condition_To_v1beta1_Condition(in *v1alpha1.Condition, out *Condition, s conversion.Scope) error {
+	out.Type = ConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1alpha1_Condition_To_v1beta1_Condition is an autogenerated conversion function.
+func Convert_v1alpha1_Condition_To_v1beta1_Condition(in *v1alpha1.Condition, out *Condition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Condition_To_v1beta1_Condition(in, out, s)
+}
+
+func autoConvert_v1beta1_DNSConfig_To_v1alpha1_DNSConfig(in *DNSConfig, out *v1alpha1.DNSConfig, s conversion.Scope) error {
+	out.RelativeName = (*string)(unsafe.Pointer(in.RelativeName))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_v1beta1_DNSConfig_To_v1alpha1_DNSConfig is an autogenerated conversion function.
+func Convert_v1beta1_DNSConfig_To_v1alpha1_DNSConfig(in *DNSConfig, out *v1alpha1.DNSConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSConfig_To_v1alpha1_DNSConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSConfig_To_v1beta1_DNSConfig(in *v1alpha1.DNSConfig, out *DNSConfig, s conversion.Scope) error {
+	out.RelativeName = (*string)(unsafe.Pointer(in.RelativeName))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_v1alpha1_DNSConfig_To_v1beta1_DNSConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSConfig_To_v1beta1_DNSConfig(in *v1alpha1.DNSConfig, out *DNSConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSConfig_To_v1beta1_DNSConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_DockerConfig_To_v1alpha1_DockerConfig(in *DockerConfig, out *v1alpha1.DockerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_DockerConfigSpec_To_v1alpha1_DockerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_DockerConfigStatus_To_v1alpha1_DockerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_DockerConfig_To_v1alpha1_DockerConfig is an autogenerated conversion function.
+func Convert_v1beta1_DockerConfig_To_v1alpha1_DockerConfig(in *DockerConfig, out *v1alpha1.DockerConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_DockerConfig_To_v1alpha1_DockerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DockerConfig_To_v1beta1_DockerConfig(in *v1alpha1.DockerConfig, out *DockerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_DockerConfigSpec_To_v1beta1_DockerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_DockerConfigStatus_To_v1beta1_DockerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DockerConfig_To_v1beta1_DockerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DockerConfig_To_v1beta1_DockerConfig(in *v1alpha1.DockerConfig, out *DockerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DockerConfig_To_v1beta1_DockerConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_DockerConfigList_To_v1alpha1_DockerConfigList(in *DockerConfigList, out *v1alpha1.DockerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.DockerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_DockerConfigList_To_v1alpha1_DockerConfigList is an autogenerated conversion function.
+func Convert_v1beta1_DockerConfigList_To_v1alpha1_DockerConfigList(in *DockerConfigList, out *v1alpha1.DockerConfigList, s conversion.Scope) error {
+	return autoConvert_v1beta1_DockerConfigList_To_v1alpha1_DockerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha1_DockerConfigList_To_v1beta1_DockerConfigList(in *v1alpha1.DockerConfigList, out *DockerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]DockerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_DockerConfigList_To_v1beta1_DockerConfigList is an autogenerated conversion function.
+func Convert_v1alpha1_DockerConfigList_To_v1beta1_DockerConfigList(in *v1alpha1.DockerConfigList, out *DockerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DockerConfigList_To_v1beta1_DockerConfigList(in, out, s)
+}
+
+func autoConvert_v1beta1_DockerConfigSpec_To_v1alpha1_DockerConfigSpec(in *DockerConfigSpec, out *v1alpha1.DockerConfigSpec, s conversion.Scope) error {
+	out.Username = in.Username
+	out.Password = in.Password
+	out.Vault = in.Vault
+	out.Email = in.Email
+	out.Server = in.Server
+	return nil
+}
+
+// Convert_v1beta1_DockerConfigSpec_To_v1alpha1_DockerConfigSpec is an autogenerated conversion function.
+func Convert_v1beta1_DockerConfigSpec_To_v1alpha1_DockerConfigSpec(in *DockerConfigSpec, out *v1alpha1.DockerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_DockerConfigSpec_To_v1alpha1_DockerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_DockerConfigSpec_To_v1beta1_DockerConfigSpec(in *v1alpha1.DockerConfigSpec, out *DockerConfigSpec, s conversion.Scope) error {
+	out.Username = in.Username
+	out.Password = in.Password
+	out.Vault = in.Vault
+	out.Email = in.Email
+	out.Server = in.Server
+	return nil
+}
+
+// Convert_v1alpha1_DockerConfigSpec_To_v1beta1_DockerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha1_DockerConfigSpec_To_v1beta1_DockerConfigSpec(in *v1alpha1.DockerConfigSpec, out *DockerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DockerConfigSpec_To_v1beta1_DockerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_DockerConfigStatus_To_v1alpha1_DockerConfigStatus(in *DockerConfigStatus, out *v1alpha1.DockerConfigStatus, s conversion.Scope) error {
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_DockerConfigStatus_To_v1alpha1_DockerConfigStatus is an autogenerated conversion function.
+func Convert_v1beta1_DockerConfigStatus_To_v1alpha1_DockerConfigStatus(in *DockerConfigStatus, out *v1alpha1.DockerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_DockerConfigStatus_To_v1alpha1_DockerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DockerConfigStatus_To_v1beta1_DockerConfigStatus(in *v1alpha1.DockerConfigStatus, out *DockerConfigStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DockerConfigStatus_To_v1beta1_DockerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DockerConfigStatus_To_v1beta1_DockerConfigStatus(in *v1alpha1.DockerConfigStatus, out *DockerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DockerConfigStatus_To_v1beta1_DockerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_EndpointProperties_To_v1alpha1_EndpointProperties(in *EndpointProperties, out *v1alpha1.EndpointProperties, s conversion.Scope) error {
+	out.Target = (*string)(unsafe.Pointer(in.Target))
+	out.Weight = (*int64)(unsafe.Pointer(in.Weight))
+	out.Priority = in.Priority
+	out.EndpointLocation = in.EndpointLocation
+	// WARNING: in.CustomHeaders requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.MonitorConfigCustomHeadersItem vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.MonitorConfigCustomHeadersItem)
+	return nil
+}
+
+func autoConvert_v1alpha1_EndpointProperties_To_v1beta1_EndpointProperties(in *v1alpha1.EndpointProperties, out *EndpointProperties, s conversion.Scope) error {
+	out.Target = (*string)(unsafe.Pointer(in.Target))
+	out.Weight = (*int64)(unsafe.Pointer(in.Weight))
+	out.Priority = in.Priority
+	out.EndpointLocation = in.EndpointLocation
+	// WARNING: in.CustomHeaders requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.MonitorConfigCustomHeadersItem vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.MonitorConfigCustomHeadersItem)
+	return nil
+}
+
+func autoConvert_v1beta1_EndpointSpec_To_v1alpha1_EndpointSpec(in *EndpointSpec, out *v1alpha1.EndpointSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_EndpointProperties_To_v1alpha1_EndpointProperties(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_EndpointSpec_To_v1alpha1_EndpointSpec is an autogenerated conversion function.
+func Convert_v1beta1_EndpointSpec_To_v1alpha1_EndpointSpec(in *EndpointSpec, out *v1alpha1.EndpointSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_EndpointSpec_To_v1alpha1_EndpointSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_EndpointSpec_To_v1beta1_EndpointSpec(in *v1alpha1.EndpointSpec, out *EndpointSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_EndpointProperties_To_v1beta1_EndpointProperties(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_EndpointSpec_To_v1beta1_EndpointSpec is an autogenerated conversion function.
+func Convert_v1alpha1_EndpointSpec_To_v1beta1_EndpointSpec(in *v1alpha1.EndpointSpec, out *EndpointSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EndpointSpec_To_v1beta1_EndpointSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_EndpointStatus_To_v1alpha1_EndpointStatus(in *EndpointStatus, out *v1alpha1.EndpointStatus, s conversion.Scope) error {
+	out.MonitorStatus = in.MonitorStatus
+	return nil
+}
+
+// Convert_v1beta1_EndpointStatus_To_v1alpha1_EndpointStatus is an autogenerated conversion function.
+func Convert_v1beta1_EndpointStatus_To_v1alpha1_EndpointStatus(in *EndpointStatus, out *v1alpha1.EndpointStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_EndpointStatus_To_v1alpha1_EndpointStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_EndpointStatus_To_v1beta1_EndpointStatus(in *v1alpha1.EndpointStatus, out *EndpointStatus, s conversion.Scope) error {
+	out.MonitorStatus = in.MonitorStatus
+	return nil
+}
+
+// Convert_v1alpha1_EndpointStatus_To_v1beta1_EndpointStatus is an autogenerated conversion function.
+func Convert_v1alpha1_EndpointStatus_To_v1beta1_EndpointStatus(in *v1alpha1.EndpointStatus, out *EndpointStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EndpointStatus_To_v1beta1_EndpointStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_FrontendIPConfigurationSpec_To_v1alpha1_FrontendIPConfigurationSpec(in *FrontendIPConfigurationSpec, out *v1alpha1.FrontendIPConfigurationSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Subnet = in.Subnet
+	out.PublicIP = in.PublicIP
+	return nil
+}
+
+// Convert_v1beta1_FrontendIPConfigurationSpec_To_v1alpha1_FrontendIPConfigurationSpec is an autogenerated conversion function.
+func Convert_v1beta1_FrontendIPConfigurationSpec_To_v1alpha1_FrontendIPConfigurationSpec(in *FrontendIPConfigurationSpec, out *v1alpha1.FrontendIPConfigurationSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_FrontendIPConfigurationSpec_To_v1alpha1_FrontendIPConfigurationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_FrontendIPConfigurationSpec_To_v1beta1_FrontendIPConfigurationSpec(in *v1alpha1.FrontendIPConfigurationSpec, out *FrontendIPConfigurationSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Subnet = in.Subnet
+	out.PublicIP = in.PublicIP
+	return nil
+}
+
+// Convert_v1alpha1_FrontendIPConfigurationSpec_To_v1beta1_FrontendIPConfigurationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_FrontendIPConfigurationSpec_To_v1beta1_FrontendIPConfigurationSpec(in *v1alpha1.FrontendIPConfigurationSpec, out *FrontendIPConfigurationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FrontendIPConfigurationSpec_To_v1beta1_FrontendIPConfigurationSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_Identity_To_v1alpha1_Identity(in *Identity, out *v1alpha1.Identity, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_IdentitySpec_To_v1alpha1_IdentitySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_IdentityStatus_To_v1alpha1_IdentityStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Identity_To_v1alpha1_Identity is an autogenerated conversion function.
+func Convert_v1beta1_Identity_To_v1alpha1_Identity(in *Identity, out *v1alpha1.Identity, s conversion.Scope) error {
+	return autoConvert_v1beta1_Identity_To_v1alpha1_Identity(in, out, s)
+}
+
+func autoConvert_v1alpha1_Identity_To_v1beta1_Identity(in *v1alpha1.Identity, out *Identity, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_IdentitySpec_To_v1beta1_IdentitySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_IdentityStatus_To_v1beta1_IdentityStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Identity_To_v1beta1_Identity is an autogenerated conversion function.
+func Convert_v1alpha1_Identity_To_v1beta1_Identity(in *v1alpha1.Identity, out *Identity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Identity_To_v1beta1_Identity(in, out, s)
+}
+
+func autoConvert_v1beta1_IdentityList_To_v1alpha1_IdentityList(in *IdentityList, out *v1alpha1.IdentityList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.Identity)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_IdentityList_To_v1alpha1_IdentityList is an autogenerated conversion function.
+func Convert_v1beta1_IdentityList_To_v1alpha1_IdentityList(in *IdentityList, out *v1alpha1.IdentityList, s conversion.Scope) error {
+	return autoConvert_v1beta1_IdentityList_To_v1alpha1_IdentityList(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentityList_To_v1beta1_IdentityList(in *v1alpha1.IdentityList, out *IdentityList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Identity)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_IdentityList_To_v1beta1_IdentityList is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityList_To_v1beta1_IdentityList(in *v1alpha1.IdentityList, out *IdentityList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityList_To_v1beta1_IdentityList(in, out, s)
+}
+
+func autoConvert_v1beta1_IdentitySpec_To_v1alpha1_IdentitySpec(in *IdentitySpec, out *v1alpha1.IdentitySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1beta1_IdentitySpec_To_v1alpha1_IdentitySpec is an autogenerated conversion function.
+func Convert_v1beta1_IdentitySpec_To_v1alpha1_IdentitySpec(in *IdentitySpec, out *v1alpha1.IdentitySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_IdentitySpec_To_v1alpha1_IdentitySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentitySpec_To_v1beta1_IdentitySpec(in *v1alpha1.IdentitySpec, out *IdentitySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1alpha1_IdentitySpec_To_v1beta1_IdentitySpec is an autogenerated conversion function.
+func Convert_v1alpha1_IdentitySpec_To_v1beta1_IdentitySpec(in *v1alpha1.IdentitySpec, out *IdentitySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentitySpec_To_v1beta1_IdentitySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_IdentityStatus_To_v1alpha1_IdentityStatus(in *IdentityStatus, out *v1alpha1.IdentityStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_IdentityStatus_To_v1alpha1_IdentityStatus is an autogenerated conversion function.
+func Convert_v1beta1_IdentityStatus_To_v1alpha1_IdentityStatus(in *IdentityStatus, out *v1alpha1.IdentityStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_IdentityStatus_To_v1alpha1_IdentityStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentityStatus_To_v1beta1_IdentityStatus(in *v1alpha1.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IdentityStatus_To_v1beta1_IdentityStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityStatus_To_v1beta1_IdentityStatus(in *v1alpha1.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityStatus_To_v1beta1_IdentityStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_InterfaceIPConfig_To_v1alpha1_InterfaceIPConfig(in *InterfaceIPConfig, out *v1alpha1.InterfaceIPConfig, s conversion.Scope) error {
+	out.PublicIP = (*v1alpha1.ResourceReference)(unsafe.Pointer(in.PublicIP))
+	out.PublicIPRef = (*v1alpha1.ObjectReference)(unsafe.Pointer(in.PublicIPRef))
+	out.PrivateIP = (*string)(unsafe.Pointer(in.PrivateIP))
+	// WARNING: in.LoadBalancers requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.BackendPoolReference vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.BackendPoolReference)
+	return nil
+}
+
+func autoConvert_v1alpha1_InterfaceIPConfig_To_v1beta1_InterfaceIPConfig(in *v1alpha1.InterfaceIPConfig, out *InterfaceIPConfig, s conversion.Scope) error {
+	out.PublicIP = (*ResourceReference)(unsafe.Pointer(in.PublicIP))
+	out.PublicIPRef = (*ObjectReference)(unsafe.Pointer(in.PublicIPRef))
+	out.PrivateIP = (*string)(unsafe.Pointer(in.PrivateIP))
+	// WARNING: in.LoadBalancers requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.BackendPoolReference vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.BackendPoolReference)
+	return nil
+}
+
+func autoConvert_v1beta1_Keyvault_To_v1alpha1_Keyvault(in *Keyvault, out *v1alpha1.Keyvault, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_KeyvaultSpec_To_v1alpha1_KeyvaultSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_KeyvaultStatus_To_v1alpha1_KeyvaultStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Keyvault_To_v1alpha1_Keyvault is an autogenerated conversion function.
+func Convert_v1beta1_Keyvault_To_v1alpha1_Keyvault(in *Keyvault, out *v1alpha1.Keyvault, s conversion.Scope) error {
+	return autoConvert_v1beta1_Keyvault_To_v1alpha1_Keyvault(in, out, s)
+}
+
+func autoConvert_v1alpha1_Keyvault_To_v1beta1_Keyvault(in *v1alpha1.Keyvault, out *Keyvault, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_KeyvaultSpec_To_v1beta1_KeyvaultSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_KeyvaultStatus_To_v1beta1_KeyvaultStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Keyvault_To_v1beta1_Keyvault is an autogenerated conversion function.
+func Convert_v1alpha1_Keyvault_To_v1beta1_Keyvault(in *v1alpha1.Keyvault, out *Keyvault, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Keyvault_To_v1beta1_Keyvault(in, out, s)
+}
+
+func autoConvert_v1beta1_KeyvaultList_To_v1alpha1_KeyvaultList(in *KeyvaultList, out *v1alpha1.KeyvaultList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.Keyvault)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_KeyvaultList_To_v1alpha1_KeyvaultList is an autogenerated conversion function.
+func Convert_v1beta1_KeyvaultList_To_v1alpha1_KeyvaultList(in *KeyvaultList, out *v1alpha1.KeyvaultList, s conversion.Scope) error {
+	return autoConvert_v1beta1_KeyvaultList_To_v1alpha1_KeyvaultList(in, out, s)
+}
+
+func autoConvert_v1alpha1_KeyvaultList_To_v1beta1_KeyvaultList(in *v1alpha1.KeyvaultList, out *KeyvaultList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Keyvault)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_KeyvaultList_To_v1beta1_KeyvaultList is an autogenerated conversion function.
+func Convert_v1alpha1_KeyvaultList_To_v1beta1_KeyvaultList(in *v1alpha1.KeyvaultList, out *KeyvaultList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KeyvaultList_To_v1beta1_KeyvaultList(in, out, s)
+}
+
+func autoConvert_v1beta1_KeyvaultSpec_To_v1alpha1_KeyvaultSpec(in *KeyvaultSpec, out *v1alpha1.KeyvaultSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TenantID = in.TenantID
+	return nil
+}
+
+// Convert_v1beta1_KeyvaultSpec_To_v1alpha1_KeyvaultSpec is an autogenerated conversion function.
+func Convert_v1beta1_KeyvaultSpec_To_v1alpha1_KeyvaultSpec(in *KeyvaultSpec, out *v1alpha1.KeyvaultSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_KeyvaultSpec_To_v1alpha1_KeyvaultSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_KeyvaultSpec_To_v1beta1_KeyvaultSpec(in *v1alpha1.KeyvaultSpec, out *KeyvaultSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TenantID = in.TenantID
+	return nil
+}
+
+// Convert_v1alpha1_KeyvaultSpec_To_v1beta1_KeyvaultSpec is an autogenerated conversion function.
+func Convert_v1alpha1_KeyvaultSpec_To_v1beta1_KeyvaultSpec(in *v1alpha1.KeyvaultSpec, out *KeyvaultSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KeyvaultSpec_To_v1beta1_KeyvaultSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_KeyvaultStatus_To_v1alpha1_KeyvaultStatus(in *KeyvaultStatus, out *v1alpha1.KeyvaultStatus, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_KeyvaultStatus_To_v1alpha1_KeyvaultStatus is an autogenerated conversion function.
+func Convert_v1beta1_KeyvaultStatus_To_v1alpha1_KeyvaultStatus(in *KeyvaultStatus, out *v1alpha1.KeyvaultStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_KeyvaultStatus_To_v1alpha1_KeyvaultStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_KeyvaultStatus_To_v1beta1_KeyvaultStatus(in *v1alpha1.KeyvaultStatus, out *KeyvaultStatus, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_KeyvaultStatus_To_v1beta1_KeyvaultStatus is an autogenerated conversion function.
+func Convert_v1alpha1_KeyvaultStatus_To_v1beta1_KeyvaultStatus(in *v1alpha1.KeyvaultStatus, out *KeyvaultStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KeyvaultStatus_To_v1beta1_KeyvaultStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_LoadBalancer_To_v1alpha1_LoadBalancer(in *LoadBalancer, out *v1alpha1.LoadBalancer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_LoadBalancerStatus_To_v1alpha1_LoadBalancerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_LoadBalancer_To_v1alpha1_LoadBalancer is an autogenerated conversion function.
+func Convert_v1beta1_LoadBalancer_To_v1alpha1_LoadBalancer(in *LoadBalancer, out *v1alpha1.LoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoadBalancer_To_v1alpha1_LoadBalancer(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancer_To_v1beta1_LoadBalancer(in *v1alpha1.LoadBalancer, out *LoadBalancer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancer_To_v1beta1_LoadBalancer is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancer_To_v1beta1_LoadBalancer(in *v1alpha1.LoadBalancer, out *LoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancer_To_v1beta1_LoadBalancer(in, out, s)
+}
+
+func autoConvert_v1beta1_LoadBalancerList_To_v1alpha1_LoadBalancerList(in *LoadBalancerList, out *v1alpha1.LoadBalancerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.LoadBalancer, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_LoadBalancer_To_v1alpha1_LoadBalancer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_LoadBalancerList_To_v1alpha1_LoadBalancerList is an autogenerated conversion function.
+func Convert_v1beta1_LoadBalancerList_To_v1alpha1_LoadBalancerList(in *LoadBalancerList, out *v1alpha1.LoadBalancerList, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoadBalancerList_To_v1alpha1_LoadBalancerList(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerList_To_v1beta1_LoadBalancerList(in *v1alpha1.LoadBalancerList, out *LoadBalancerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancer, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoadBalancer_To_v1beta1_LoadBalancer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerList_To_v1beta1_LoadBalancerList is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerList_To_v1beta1_LoadBalancerList(in *v1alpha1.LoadBalancerList, out *LoadBalancerList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerList_To_v1beta1_LoadBalancerList(in, out, s)
+}
+
+func autoConvert_v1beta1_LoadBalancerSpec_To_v1alpha1_LoadBalancerSpec(in *LoadBalancerSpec, out *v1alpha1.LoadBalancerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.SKU = (*string)(unsafe.Pointer(in.SKU))
+	out.Frontends = *(*[]string)(unsafe.Pointer(&in.Frontends))
+	out.FrontendRefs = *(*[]v1alpha1.ObjectReference)(unsafe.Pointer(&in.FrontendRefs))
+	out.BackendPools = *(*[]string)(unsafe.Pointer(&in.BackendPools))
+	// WARNING: in.Rules requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.RuleSpec vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.RuleSpec)
+	// WARNING: in.Probes requires manual conversion: inconvertible types ([]int vs *[]int)
+	return nil
+}
+
+func autoConvert_v1alpha1_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in *v1alpha1.LoadBalancerSpec, out *LoadBalancerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.SKU = (*string)(unsafe.Pointer(in.SKU))
+	out.Frontends = *(*[]string)(unsafe.Pointer(&in.Frontends))
+	out.FrontendRefs = *(*[]ObjectReference)(unsafe.Pointer(&in.FrontendRefs))
+	out.BackendPools = *(*[]string)(unsafe.Pointer(&in.BackendPools))
+	// WARNING: in.Rules requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.RuleSpec vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.RuleSpec)
+	// WARNING: in.Probes requires manual conversion: inconvertible types (*[]int vs []int)
+	return nil
+}
+
+func autoConvert_v1beta1_LoadBalancerStatus_To_v1alpha1_LoadBalancerStatus(in *LoadBalancerStatus, out *v1alpha1.LoadBalancerStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_LoadBalancerStatus_To_v1alpha1_LoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1beta1_LoadBalancerStatus_To_v1alpha1_LoadBalancerStatus(in *LoadBalancerStatus, out *v1alpha1.LoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoadBalancerStatus_To_v1alpha1_LoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(in *v1alpha1.LoadBalancerStatus, out *LoadBalancerStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(in *v1alpha1.LoadBalancerStatus, out *LoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerStatus_To_v1beta1_LoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MonitorConfig_To_v1alpha1_MonitorConfig(in *MonitorConfig, out *v1alpha1.MonitorConfig, s conversion.Scope) error {
+	out.Protocol = in.Protocol
+	out.Port = (*int64)(unsafe.Pointer(in.Port))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.IntervalInSeconds = (*int64)(unsafe.Pointer(in.IntervalInSeconds))
+	out.TimeoutInSeconds = (*int64)(unsafe.Pointer(in.TimeoutInSeconds))
+	out.ToleratedNumberOfFailures = (*int64)(unsafe.Pointer(in.ToleratedNumberOfFailures))
+	// WARNING: in.CustomHeaders requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.MonitorConfigCustomHeadersItem vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.MonitorConfigCustomHeadersItem)
+	// WARNING: in.ExpectedStatusCodeRanges requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.MonitorConfigExpectedStatusCodeRangesItem vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.MonitorConfigExpectedStatusCodeRangesItem)
+	return nil
+}
+
+func autoConvert_v1alpha1_MonitorConfig_To_v1beta1_MonitorConfig(in *v1alpha1.MonitorConfig, out *MonitorConfig, s conversion.Scope) error {
+	out.Protocol = in.Protocol
+	out.Port = (*int64)(unsafe.Pointer(in.Port))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.IntervalInSeconds = (*int64)(unsafe.Pointer(in.IntervalInSeconds))
+	out.TimeoutInSeconds = (*int64)(unsafe.Pointer(in.TimeoutInSeconds))
+	out.ToleratedNumberOfFailures = (*int64)(unsafe.Pointer(in.ToleratedNumberOfFailures))
+	// WARNING: in.CustomHeaders requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.MonitorConfigCustomHeadersItem vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.MonitorConfigCustomHeadersItem)
+	// WARNING: in.ExpectedStatusCodeRanges requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.MonitorConfigExpectedStatusCodeRangesItem vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.MonitorConfigExpectedStatusCodeRangesItem)
+	return nil
+}
+
+func autoConvert_v1beta1_MonitorConfigCustomHeadersItem_To_v1alpha1_MonitorConfigCustomHeadersItem(in *MonitorConfigCustomHeadersItem, out *v1alpha1.MonitorConfigCustomHeadersItem, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Value = (*string)(unsafe.Pointer(in.Value))
+	return nil
+}
+
+// Convert_v1beta1_MonitorConfigCustomHeadersItem_To_v1alpha1_MonitorConfigCustomHeadersItem is an autogenerated conversion function.
+func Convert_v1beta1_MonitorConfigCustomHeadersItem_To_v1alpha1_MonitorConfigCustomHeadersItem(in *MonitorConfigCustomHeadersItem, out *v1alpha1.MonitorConfigCustomHeadersItem, s conversion.Scope) error {
+	return autoConvert_v1beta1_MonitorConfigCustomHeadersItem_To_v1alpha1_MonitorConfigCustomHeadersItem(in, out, s)
+}
+
+func autoConvert_v1alpha1_MonitorConfigCustomHeadersItem_To_v1beta1_MonitorConfigCustomHeadersItem(in *v1alpha1.MonitorConfigCustomHeadersItem, out *MonitorConfigCustomHeadersItem, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Value = (*string)(unsafe.Pointer(in.Value))
+	return nil
+}
+
+// Convert_v1alpha1_MonitorConfigCustomHeadersItem_To_v1beta1_MonitorConfigCustomHeadersItem is an autogenerated conversion function.
+func Convert_v1alpha1_MonitorConfigCustomHeadersItem_To_v1beta1_MonitorConfigCustomHeadersItem(in *v1alpha1.MonitorConfigCustomHeadersItem, out *MonitorConfigCustomHeadersItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MonitorConfigCustomHeadersItem_To_v1beta1_MonitorConfigCustomHeadersItem(in, out, s)
+}
+
+func autoConvert_v1beta1_MonitorConfigExpectedStatusCodeRangesItem_To_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem(in *MonitorConfigExpectedStatusCodeRangesItem, out *v1alpha1.MonitorConfigExpectedStatusCodeRangesItem, s conversion.Scope) error {
+	out.Min = (*int32)(unsafe.Pointer(in.Min))
+	out.Max = (*int32)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_v1beta1_MonitorConfigExpectedStatusCodeRangesItem_To_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem is an autogenerated conversion function.
+func Convert_v1beta1_MonitorConfigExpectedStatusCodeRangesItem_To_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem(in *MonitorConfigExpectedStatusCodeRangesItem, out *v1alpha1.MonitorConfigExpectedStatusCodeRangesItem, s conversion.Scope) error {
+	return autoConvert_v1beta1_MonitorConfigExpectedStatusCodeRangesItem_To_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem(in, out, s)
+}
+
+func autoConvert_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem_To_v1beta1_MonitorConfigExpectedStatusCodeRangesItem(in *v1alpha1.MonitorConfigExpectedStatusCodeRangesItem, out *MonitorConfigExpectedStatusCodeRangesItem, s conversion.Scope) error {
+	out.Min = (*int32)(unsafe.Pointer(in.Min))
+	out.Max = (*int32)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem_To_v1beta1_MonitorConfigExpectedStatusCodeRangesItem is an autogenerated conversion function.
+func Convert_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem_To_v1beta1_MonitorConfigExpectedStatusCodeRangesItem(in *v1alpha1.MonitorConfigExpectedStatusCodeRangesItem, out *MonitorConfigExpectedStatusCodeRangesItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MonitorConfigExpectedStatusCodeRangesItem_To_v1beta1_MonitorConfigExpectedStatusCodeRangesItem(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkInterface_To_v1alpha1_NetworkInterface(in *NetworkInterface, out *v1alpha1.NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworkInterface_To_v1alpha1_NetworkInterface is an autogenerated conversion function.
+func Convert_v1beta1_NetworkInterface_To_v1alpha1_NetworkInterface(in *NetworkInterface, out *v1alpha1.NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkInterface_To_v1alpha1_NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterface_To_v1beta1_NetworkInterface(in *v1alpha1.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NetworkInterfaceSpec_To_v1beta1_NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_NetworkInterfaceStatus_To_v1beta1_NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterface_To_v1beta1_NetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterface_To_v1beta1_NetworkInterface(in *v1alpha1.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterface_To_v1beta1_NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(in *NetworkInterfaceList, out *v1alpha1.NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.NetworkInterface, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_NetworkInterface_To_v1alpha1_NetworkInterface(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v1beta1_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(in *NetworkInterfaceList, out *v1alpha1.NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceList_To_v1beta1_NetworkInterfaceList(in *v1alpha1.NetworkInterfaceList, out *NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_NetworkInterface_To_v1beta1_NetworkInterface(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceList_To_v1beta1_NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceList_To_v1beta1_NetworkInterfaceList(in *v1alpha1.NetworkInterfaceList, out *NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceList_To_v1beta1_NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(in *NetworkInterfaceSpec, out *v1alpha1.NetworkInterfaceSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Network = in.Network
+	out.Subnet = in.Subnet
+	out.SubnetRef = (*v1alpha1.ObjectReference)(unsafe.Pointer(in.SubnetRef))
+	// WARNING: in.IPConfigurations requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.InterfaceIPConfig vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.InterfaceIPConfig)
+	return nil
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceSpec_To_v1beta1_NetworkInterfaceSpec(in *v1alpha1.NetworkInterfaceSpec, out *NetworkInterfaceSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Network = in.Network
+	out.Subnet = in.Subnet
+	out.SubnetRef = (*ObjectReference)(unsafe.Pointer(in.SubnetRef))
+	// WARNING: in.IPConfigurations requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.InterfaceIPConfig vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.InterfaceIPConfig)
+	return nil
+}
+
+func autoConvert_v1beta1_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(in *NetworkInterfaceStatus, out *v1alpha1.NetworkInterfaceStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v1beta1_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(in *NetworkInterfaceStatus, out *v1alpha1.NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceStatus_To_v1beta1_NetworkInterfaceStatus(in *v1alpha1.NetworkInterfaceStatus, out *NetworkInterfaceStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceStatus_To_v1beta1_NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceStatus_To_v1beta1_NetworkInterfaceStatus(in *v1alpha1.NetworkInterfaceStatus, out *NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceStatus_To_v1beta1_NetworkInterfaceStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ObjectReference_To_v1alpha1_ObjectReference(in *ObjectReference, out *v1alpha1.ObjectReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1beta1_ObjectReference_To_v1alpha1_ObjectReference is an autogenerated conversion function.
+func Convert_v1beta1_ObjectReference_To_v1alpha1_ObjectReference(in *ObjectReference, out *v1alpha1.ObjectReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ObjectReference_To_v1alpha1_ObjectReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ObjectReference_To_v1beta1_ObjectReference(in *v1alpha1.ObjectReference, out *ObjectReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_ObjectReference_To_v1beta1_ObjectReference is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectReference_To_v1beta1_ObjectReference(in *v1alpha1.ObjectReference, out *ObjectReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectReference_To_v1beta1_ObjectReference(in, out, s)
+}
+
+func autoConvert_v1beta1_Operation_To_v1alpha1_Operation(in *Operation, out *v1alpha1.Operation, s conversion.Scope) error {
+	out.Method = in.Method
+	out.PollingURL = in.PollingURL
+	out.PollingMethod = in.PollingMethod
+	out.ResultURL = in.ResultURL
+	out.State = in.State
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_v1beta1_Operation_To_v1alpha1_Operation is an autogenerated conversion function.
+func Convert_v1beta1_Operation_To_v1alpha1_Operation(in *Operation, out *v1alpha1.Operation, s conversion.Scope) error {
+	return autoConvert_v1beta1_Operation_To_v1alpha1_Operation(in, out, s)
+}
+
+func autoConvert_v1alpha1_Operation_To_v1beta1_Operation(in *v1alpha1.Operation, out *Operation, s conversion.Scope) error {
+	out.Method = in.Method
+	out.PollingURL = in.PollingURL
+	out.PollingMethod = in.PollingMethod
+	out.ResultURL = in.ResultURL
+	out.State = in.State
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_v1alpha1_Operation_To_v1beta1_Operation is an autogenerated conversion function.
+func Convert_v1alpha1_Operation_To_v1beta1_Operation(in *v1alpha1.Operation, out *Operation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Operation_To_v1beta1_Operation(in, out, s)
+}
+
+func autoConvert_v1beta1_OperationRecord_To_v1alpha1_OperationRecord(in *OperationRecord, out *v1alpha1.OperationRecord, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Result = in.Result
+	out.Code = in.Code
+	out.RequestID = in.RequestID
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1beta1_OperationRecord_To_v1alpha1_OperationRecord is an autogenerated conversion function.
+func Convert_v1beta1_OperationRecord_To_v1alpha1_OperationRecord(in *OperationRecord, out *v1alpha1.OperationRecord, s conversion.Scope) error {
+	return autoConvert_v1beta1_OperationRecord_To_v1alpha1_OperationRecord(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperationRecord_To_v1beta1_OperationRecord(in *v1alpha1.OperationRecord, out *OperationRecord, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Result = in.Result
+	out.Code = in.Code
+	out.RequestID = in.RequestID
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1alpha1_OperationRecord_To_v1beta1_OperationRecord is an autogenerated conversion function.
+func Convert_v1alpha1_OperationRecord_To_v1beta1_OperationRecord(in *v1alpha1.OperationRecord, out *OperationRecord, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperationRecord_To_v1beta1_OperationRecord(in, out, s)
+}
+
+func autoConvert_v1beta1_PublicIP_To_v1alpha1_PublicIP(in *PublicIP, out *v1alpha1.PublicIP, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_PublicIPSpec_To_v1alpha1_PublicIPSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_PublicIPStatus_To_v1alpha1_PublicIPStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_PublicIP_To_v1alpha1_PublicIP is an autogenerated conversion function.
+func Convert_v1beta1_PublicIP_To_v1alpha1_PublicIP(in *PublicIP, out *v1alpha1.PublicIP, s conversion.Scope) error {
+	return autoConvert_v1beta1_PublicIP_To_v1alpha1_PublicIP(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIP_To_v1beta1_PublicIP(in *v1alpha1.PublicIP, out *PublicIP, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_PublicIPSpec_To_v1beta1_PublicIPSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PublicIPStatus_To_v1beta1_PublicIPStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PublicIP_To_v1beta1_PublicIP is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIP_To_v1beta1_PublicIP(in *v1alpha1.PublicIP, out *PublicIP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIP_To_v1beta1_PublicIP(in, out, s)
+}
+
+func autoConvert_v1beta1_PublicIPList_To_v1alpha1_PublicIPList(in *PublicIPList, out *v1alpha1.PublicIPList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.PublicIP)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_PublicIPList_To_v1alpha1_PublicIPList is an autogenerated conversion function.
+func Convert_v1beta1_PublicIPList_To_v1alpha1_PublicIPList(in *PublicIPList, out *v1alpha1.PublicIPList, s conversion.Scope) error {
+	return autoConvert_v1beta1_PublicIPList_To_v1alpha1_PublicIPList(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPList_To_v1beta1_PublicIPList(in *v1alpha1.PublicIPList, out *PublicIPList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]PublicIP)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPList_To_v1beta1_PublicIPList is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPList_To_v1beta1_PublicIPList(in *v1alpha1.PublicIPList, out *PublicIPList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPList_To_v1beta1_PublicIPList(in, out, s)
+}
+
+func autoConvert_v1beta1_PublicIPSpec_To_v1alpha1_PublicIPSpec(in *PublicIPSpec, out *v1alpha1.PublicIPSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.SKU = (*string)(unsafe.Pointer(in.SKU))
+	return nil
+}
+
+// Convert_v1beta1_PublicIPSpec_To_v1alpha1_PublicIPSpec is an autogenerated conversion function.
+func Convert_v1beta1_PublicIPSpec_To_v1alpha1_PublicIPSpec(in *PublicIPSpec, out *v1alpha1.PublicIPSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_PublicIPSpec_To_v1alpha1_PublicIPSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPSpec_To_v1beta1_PublicIPSpec(in *v1alpha1.PublicIPSpec, out *PublicIPSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.SKU = (*string)(unsafe.Pointer(in.SKU))
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPSpec_To_v1beta1_PublicIPSpec is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPSpec_To_v1beta1_PublicIPSpec(in *v1alpha1.PublicIPSpec, out *PublicIPSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPSpec_To_v1beta1_PublicIPSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_PublicIPStatus_To_v1alpha1_PublicIPStatus(in *PublicIPStatus, out *v1alpha1.PublicIPStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_PublicIPStatus_To_v1alpha1_PublicIPStatus is an autogenerated conversion function.
+func Convert_v1beta1_PublicIPStatus_To_v1alpha1_PublicIPStatus(in *PublicIPStatus, out *v1alpha1.PublicIPStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_PublicIPStatus_To_v1alpha1_PublicIPStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPStatus_To_v1beta1_PublicIPStatus(in *v1alpha1.PublicIPStatus, out *PublicIPStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPStatus_To_v1beta1_PublicIPStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPStatus_To_v1beta1_PublicIPStatus(in *v1alpha1.PublicIPStatus, out *PublicIPStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPStatus_To_v1beta1_PublicIPStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_Redis_To_v1alpha1_Redis(in *Redis, out *v1alpha1.Redis, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_RedisSpec_To_v1alpha1_RedisSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_RedisStatus_To_v1alpha1_RedisStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Redis_To_v1alpha1_Redis is an autogenerated conversion function.
+func Convert_v1beta1_Redis_To_v1alpha1_Redis(in *Redis, out *v1alpha1.Redis, s conversion.Scope) error {
+	return autoConvert_v1beta1_Redis_To_v1alpha1_Redis(in, out, s)
+}
+
+func autoConvert_v1alpha1_Redis_To_v1beta1_Redis(in *v1alpha1.Redis, out *Redis, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RedisSpec_To_v1beta1_RedisSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RedisStatus_To_v1beta1_RedisStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Redis_To_v1beta1_Redis is an autogenerated conversion function.
+func Convert_v1alpha1_Redis_To_v1beta1_Redis(in *v1alpha1.Redis, out *Redis, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Redis_To_v1beta1_Redis(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisKey_To_v1alpha1_RedisKey(in *RedisKey, out *v1alpha1.RedisKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_RedisKeySpec_To_v1alpha1_RedisKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_RedisKeyStatus_To_v1alpha1_RedisKeyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_RedisKey_To_v1alpha1_RedisKey is an autogenerated conversion function.
+func Convert_v1beta1_RedisKey_To_v1alpha1_RedisKey(in *RedisKey, out *v1alpha1.RedisKey, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisKey_To_v1alpha1_RedisKey(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisKey_To_v1beta1_RedisKey(in *v1alpha1.RedisKey, out *RedisKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RedisKeySpec_To_v1beta1_RedisKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RedisKeyStatus_To_v1beta1_RedisKeyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RedisKey_To_v1beta1_RedisKey is an autogenerated conversion function.
+func Convert_v1alpha1_RedisKey_To_v1beta1_RedisKey(in *v1alpha1.RedisKey, out *RedisKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisKey_To_v1beta1_RedisKey(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisKeyList_To_v1alpha1_RedisKeyList(in *RedisKeyList, out *v1alpha1.RedisKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.RedisKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_RedisKeyList_To_v1alpha1_RedisKeyList is an autogenerated conversion function.
+func Convert_v1beta1_RedisKeyList_To_v1alpha1_RedisKeyList(in *RedisKeyList, out *v1alpha1.RedisKeyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisKeyList_To_v1alpha1_RedisKeyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisKeyList_To_v1beta1_RedisKeyList(in *v1alpha1.RedisKeyList, out *RedisKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]RedisKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_RedisKeyList_To_v1beta1_RedisKeyList is an autogenerated conversion function.
+func Convert_v1alpha1_RedisKeyList_To_v1beta1_RedisKeyList(in *v1alpha1.RedisKeyList, out *RedisKeyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisKeyList_To_v1beta1_RedisKeyList(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisKeySpec_To_v1alpha1_RedisKeySpec(in *RedisKeySpec, out *v1alpha1.RedisKeySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = in.TargetSecret
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	return nil
+}
+
+// Convert_v1beta1_RedisKeySpec_To_v1alpha1_RedisKeySpec is an autogenerated conversion function.
+func Convert_v1beta1_RedisKeySpec_To_v1alpha1_RedisKeySpec(in *RedisKeySpec, out *v1alpha1.RedisKeySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisKeySpec_To_v1alpha1_RedisKeySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisKeySpec_To_v1beta1_RedisKeySpec(in *v1alpha1.RedisKeySpec, out *RedisKeySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = in.TargetSecret
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	return nil
+}
+
+// Convert_v1alpha1_RedisKeySpec_To_v1beta1_RedisKeySpec is an autogenerated conversion function.
+func Convert_v1alpha1_RedisKeySpec_To_v1beta1_RedisKeySpec(in *v1alpha1.RedisKeySpec, out *RedisKeySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisKeySpec_To_v1beta1_RedisKeySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisKeyStatus_To_v1alpha1_RedisKeyStatus(in *RedisKeyStatus, out *v1alpha1.RedisKeyStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_RedisKeyStatus_To_v1alpha1_RedisKeyStatus is an autogenerated conversion function.
+func Convert_v1beta1_RedisKeyStatus_To_v1alpha1_RedisKeyStatus(in *RedisKeyStatus, out *v1alpha1.RedisKeyStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisKeyStatus_To_v1alpha1_RedisKeyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisKeyStatus_To_v1beta1_RedisKeyStatus(in *v1alpha1.RedisKeyStatus, out *RedisKeyStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RedisKeyStatus_To_v1beta1_RedisKeyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RedisKeyStatus_To_v1beta1_RedisKeyStatus(in *v1alpha1.RedisKeyStatus, out *RedisKeyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisKeyStatus_To_v1beta1_RedisKeyStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisList_To_v1alpha1_RedisList(in *RedisList, out *v1alpha1.RedisList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.Redis)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_RedisList_To_v1alpha1_RedisList is an autogenerated conversion function.
+func Convert_v1beta1_RedisList_To_v1alpha1_RedisList(in *RedisList, out *v1alpha1.RedisList, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisList_To_v1alpha1_RedisList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisList_To_v1beta1_RedisList(in *v1alpha1.RedisList, out *RedisList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Redis)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_RedisList_To_v1beta1_RedisList is an autogenerated conversion function.
+func Convert_v1alpha1_RedisList_To_v1beta1_RedisList(in *v1alpha1.RedisList, out *RedisList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisList_To_v1beta1_RedisList(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisSku_To_v1alpha1_RedisSku(in *RedisSku, out *v1alpha1.RedisSku, s conversion.Scope) error {
+	out.Name = v1alpha1.SkuName(in.Name)
+	out.Family = v1alpha1.RedisSkuFamily(in.Family)
+	out.Capacity = in.Capacity
+	return nil
+}
+
+// Convert_v1beta1_RedisSku_To_v1alpha1_RedisSku is an autogenerated conversion function.
+func Convert_v1beta1_RedisSku_To_v1alpha1_RedisSku(in *RedisSku, out *v1alpha1.RedisSku, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisSku_To_v1alpha1_RedisSku(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisSku_To_v1beta1_RedisSku(in *v1alpha1.RedisSku, out *RedisSku, s conversion.Scope) error {
+	out.Name = SkuName(in.Name)
+	out.Family = RedisSkuFamily(in.Family)
+	out.Capacity = in.Capacity
+	return nil
+}
+
+// Convert_v1alpha1_RedisSku_To_v1beta1_RedisSku is an autogenerated conversion function.
+func Convert_v1alpha1_RedisSku_To_v1beta1_RedisSku(in *v1alpha1.RedisSku, out *RedisSku, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisSku_To_v1beta1_RedisSku(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisSpec_To_v1alpha1_RedisSpec(in *RedisSpec, out *v1alpha1.RedisSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	if err := Convert_v1beta1_RedisSku_To_v1alpha1_RedisSku(&in.SKU, &out.SKU, s); err != nil {
+		return err
+	}
+	out.EnableNonSslPort = in.EnableNonSslPort
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	return nil
+}
+
+// Convert_v1beta1_RedisSpec_To_v1alpha1_RedisSpec is an autogenerated conversion function.
+func Convert_v1beta1_RedisSpec_To_v1alpha1_RedisSpec(in *RedisSpec, out *v1alpha1.RedisSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisSpec_To_v1alpha1_RedisSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisSpec_To_v1beta1_RedisSpec(in *v1alpha1.RedisSpec, out *RedisSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	if err := Convert_v1alpha1_RedisSku_To_v1beta1_RedisSku(&in.SKU, &out.SKU, s); err != nil {
+		return err
+	}
+	out.EnableNonSslPort = in.EnableNonSslPort
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	return nil
+}
+
+// Convert_v1alpha1_RedisSpec_To_v1beta1_RedisSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RedisSpec_To_v1beta1_RedisSpec(in *v1alpha1.RedisSpec, out *RedisSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisSpec_To_v1beta1_RedisSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_RedisStatus_To_v1alpha1_RedisStatus(in *RedisStatus, out *v1alpha1.RedisStatus, s conversion.Scope) error {
+	out.ProvisioningState = in.ProvisioningState
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_RedisStatus_To_v1alpha1_RedisStatus is an autogenerated conversion function.
+func Convert_v1beta1_RedisStatus_To_v1alpha1_RedisStatus(in *RedisStatus, out *v1alpha1.RedisStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RedisStatus_To_v1alpha1_RedisStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedisStatus_To_v1beta1_RedisStatus(in *v1alpha1.RedisStatus, out *RedisStatus, s conversion.Scope) error {
+	out.ProvisioningState = in.ProvisioningState
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RedisStatus_To_v1beta1_RedisStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RedisStatus_To_v1beta1_RedisStatus(in *v1alpha1.RedisStatus, out *RedisStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedisStatus_To_v1beta1_RedisStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ResourceGroup_To_v1alpha1_ResourceGroup(in *ResourceGroup, out *v1alpha1.ResourceGroup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ResourceGroupSpec_To_v1alpha1_ResourceGroupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ResourceGroupStatus_To_v1alpha1_ResourceGroupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ResourceGroup_To_v1alpha1_ResourceGroup is an autogenerated conversion function.
+func Convert_v1beta1_ResourceGroup_To_v1alpha1_ResourceGroup(in *ResourceGroup, out *v1alpha1.ResourceGroup, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceGroup_To_v1alpha1_ResourceGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceGroup_To_v1beta1_ResourceGroup(in *v1alpha1.ResourceGroup, out *ResourceGroup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ResourceGroupSpec_To_v1beta1_ResourceGroupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ResourceGroupStatus_To_v1beta1_ResourceGroupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ResourceGroup_To_v1beta1_ResourceGroup is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceGroup_To_v1beta1_ResourceGroup(in *v1alpha1.ResourceGroup, out *ResourceGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceGroup_To_v1beta1_ResourceGroup(in, out, s)
+}
+
+func autoConvert_v1beta1_ResourceGroupList_To_v1alpha1_ResourceGroupList(in *ResourceGroupList, out *v1alpha1.ResourceGroupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.ResourceGroup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ResourceGroupList_To_v1alpha1_ResourceGroupList is an autogenerated conversion function.
+func Convert_v1beta1_ResourceGroupList_To_v1alpha1_ResourceGroupList(in *ResourceGroupList, out *v1alpha1.ResourceGroupList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceGroupList_To_v1alpha1_ResourceGroupList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceGroupList_To_v1beta1_ResourceGroupList(in *v1alpha1.ResourceGroupList, out *ResourceGroupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ResourceGroup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceGroupList_To_v1beta1_ResourceGroupList is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceGroupList_To_v1beta1_ResourceGroupList(in *v1alpha1.ResourceGroupList, out *ResourceGroupList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceGroupList_To_v1beta1_ResourceGroupList(in, out, s)
+}
+
+func autoConvert_v1beta1_ResourceGroupSpec_To_v1alpha1_ResourceGroupSpec(in *ResourceGroupSpec, out *v1alpha1.ResourceGroupSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1beta1_ResourceGroupSpec_To_v1alpha1_ResourceGroupSpec is an autogenerated conversion function.
+func Convert_v1beta1_ResourceGroupSpec_To_v1alpha1_ResourceGroupSpec(in *ResourceGroupSpec, out *v1alpha1.ResourceGroupSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceGroupSpec_To_v1alpha1_ResourceGroupSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceGroupSpec_To_v1beta1_ResourceGroupSpec(in *v1alpha1.ResourceGroupSpec, out *ResourceGroupSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1alpha1_ResourceGroupSpec_To_v1beta1_ResourceGroupSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceGroupSpec_To_v1beta1_ResourceGroupSpec(in *v1alpha1.ResourceGroupSpec, out *ResourceGroupSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceGroupSpec_To_v1beta1_ResourceGroupSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ResourceGroupStatus_To_v1alpha1_ResourceGroupStatus(in *ResourceGroupStatus, out *v1alpha1.ResourceGroupStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ResourceGroupStatus_To_v1alpha1_ResourceGroupStatus is an autogenerated conversion function.
+func Convert_v1beta1_ResourceGroupStatus_To_v1alpha1_ResourceGroupStatus(in *ResourceGroupStatus, out *v1alpha1.ResourceGroupStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceGroupStatus_To_v1alpha1_ResourceGroupStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceGroupStatus_To_v1beta1_ResourceGroupStatus(in *v1alpha1.ResourceGroupStatus, out *ResourceGroupStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ResourceGroupStatus_To_v1beta1_ResourceGroupStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceGroupStatus_To_v1beta1_ResourceGroupStatus(in *v1alpha1.ResourceGroupStatus, out *ResourceGroupStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceGroupStatus_To_v1beta1_ResourceGroupStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ResourceReference_To_v1alpha1_ResourceReference(in *ResourceReference, out *v1alpha1.ResourceReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1beta1_ResourceReference_To_v1alpha1_ResourceReference is an autogenerated conversion function.
+func Convert_v1beta1_ResourceReference_To_v1alpha1_ResourceReference(in *ResourceReference, out *v1alpha1.ResourceReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceReference_To_v1beta1_ResourceReference(in *v1alpha1.ResourceReference, out *ResourceReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1alpha1_ResourceReference_To_v1beta1_ResourceReference is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceReference_To_v1beta1_ResourceReference(in *v1alpha1.ResourceReference, out *ResourceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceReference_To_v1beta1_ResourceReference(in, out, s)
+}
+
+func autoConvert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(in *ResourceStatus, out *v1alpha1.ResourceStatus, s conversion.Scope) error {
+	out.Conditions = *(*v1alpha1.Conditions)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
+	out.SpecHash = in.SpecHash
+	out.FailedSpecHash = in.FailedSpecHash
+	out.Operation = (*v1alpha1.Operation)(unsafe.Pointer(in.Operation))
+	out.LastError = (*v1alpha1.AzureError)(unsafe.Pointer(in.LastError))
+	out.RecentOperations = *(*[]v1alpha1.OperationRecord)(unsafe.Pointer(&in.RecentOperations))
+	return nil
+}
+
+// Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus is an autogenerated conversion function.
+func Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(in *ResourceStatus, out *v1alpha1.ResourceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(in *v1alpha1.ResourceStatus, out *ResourceStatus, s conversion.Scope) error {
+	out.Conditions = *(*Conditions)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
+	out.SpecHash = in.SpecHash
+	out.FailedSpecHash = in.FailedSpecHash
+	out.Operation = (*Operation)(unsafe.Pointer(in.Operation))
+	out.LastError = (*AzureError)(unsafe.Pointer(in.LastError))
+	out.RecentOperations = *(*[]OperationRecord)(unsafe.Pointer(&in.RecentOperations))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(in *v1alpha1.ResourceStatus, out *ResourceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RuleSpec_To_v1alpha1_RuleSpec(in *RuleSpec, out *v1alpha1.RuleSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Frontend = in.Frontend
+	out.BackendPool = in.BackendPool
+	out.Probe = in.Probe
+	out.Protocol = in.Protocol
+	out.FrontendPort = in.FrontendPort
+	out.BackendPort = in.BackendPort
+	return nil
+}
+
+// Convert_v1beta1_RuleSpec_To_v1alpha1_RuleSpec is an autogenerated conversion function.
+func Convert_v1beta1_RuleSpec_To_v1alpha1_RuleSpec(in *RuleSpec, out *v1alpha1.RuleSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_RuleSpec_To_v1alpha1_RuleSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RuleSpec_To_v1beta1_RuleSpec(in *v1alpha1.RuleSpec, out *RuleSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Frontend = in.Frontend
+	out.BackendPool = in.BackendPool
+	out.Probe = in.Probe
+	out.Protocol = in.Protocol
+	out.FrontendPort = in.FrontendPort
+	out.BackendPort = in.BackendPort
+	return nil
+}
+
+// Convert_v1alpha1_RuleSpec_To_v1beta1_RuleSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RuleSpec_To_v1beta1_RuleSpec(in *v1alpha1.RuleSpec, out *RuleSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RuleSpec_To_v1beta1_RuleSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLFirewallRule_To_v1alpha1_SQLFirewallRule(in *SQLFirewallRule, out *v1alpha1.SQLFirewallRule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SQLFirewallRuleSpec_To_v1alpha1_SQLFirewallRuleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_SQLFirewallRuleStatus_To_v1alpha1_SQLFirewallRuleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SQLFirewallRule_To_v1alpha1_SQLFirewallRule is an autogenerated conversion function.
+func Convert_v1beta1_SQLFirewallRule_To_v1alpha1_SQLFirewallRule(in *SQLFirewallRule, out *v1alpha1.SQLFirewallRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLFirewallRule_To_v1alpha1_SQLFirewallRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLFirewallRule_To_v1beta1_SQLFirewallRule(in *v1alpha1.SQLFirewallRule, out *SQLFirewallRule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SQLFirewallRuleSpec_To_v1beta1_SQLFirewallRuleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SQLFirewallRuleStatus_To_v1beta1_SQLFirewallRuleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SQLFirewallRule_To_v1beta1_SQLFirewallRule is an autogenerated conversion function.
+func Convert_v1alpha1_SQLFirewallRule_To_v1beta1_SQLFirewallRule(in *v1alpha1.SQLFirewallRule, out *SQLFirewallRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLFirewallRule_To_v1beta1_SQLFirewallRule(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLFirewallRuleList_To_v1alpha1_SQLFirewallRuleList(in *SQLFirewallRuleList, out *v1alpha1.SQLFirewallRuleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.SQLFirewallRule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_SQLFirewallRuleList_To_v1alpha1_SQLFirewallRuleList is an autogenerated conversion function.
+func Convert_v1beta1_SQLFirewallRuleList_To_v1alpha1_SQLFirewallRuleList(in *SQLFirewallRuleList, out *v1alpha1.SQLFirewallRuleList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLFirewallRuleList_To_v1alpha1_SQLFirewallRuleList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLFirewallRuleList_To_v1beta1_SQLFirewallRuleList(in *v1alpha1.SQLFirewallRuleList, out *SQLFirewallRuleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SQLFirewallRule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SQLFirewallRuleList_To_v1beta1_SQLFirewallRuleList is an autogenerated conversion function.
+func Convert_v1alpha1_SQLFirewallRuleList_To_v1beta1_SQLFirewallRuleList(in *v1alpha1.SQLFirewallRuleList, out *SQLFirewallRuleList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLFirewallRuleList_To_v1beta1_SQLFirewallRuleList(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLFirewallRuleSpec_To_v1alpha1_SQLFirewallRuleSpec(in *SQLFirewallRuleSpec, out *v1alpha1.SQLFirewallRuleSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Server = in.Server
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1beta1_SQLFirewallRuleSpec_To_v1alpha1_SQLFirewallRuleSpec is an autogenerated conversion function.
+func Convert_v1beta1_SQLFirewallRuleSpec_To_v1alpha1_SQLFirewallRuleSpec(in *SQLFirewallRuleSpec, out *v1alpha1.SQLFirewallRuleSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLFirewallRuleSpec_To_v1alpha1_SQLFirewallRuleSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLFirewallRuleSpec_To_v1beta1_SQLFirewallRuleSpec(in *v1alpha1.SQLFirewallRuleSpec, out *SQLFirewallRuleSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Server = in.Server
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha1_SQLFirewallRuleSpec_To_v1beta1_SQLFirewallRuleSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SQLFirewallRuleSpec_To_v1beta1_SQLFirewallRuleSpec(in *v1alpha1.SQLFirewallRuleSpec, out *SQLFirewallRuleSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLFirewallRuleSpec_To_v1beta1_SQLFirewallRuleSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLFirewallRuleStatus_To_v1alpha1_SQLFirewallRuleStatus(in *SQLFirewallRuleStatus, out *v1alpha1.SQLFirewallRuleStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SQLFirewallRuleStatus_To_v1alpha1_SQLFirewallRuleStatus is an autogenerated conversion function.
+func Convert_v1beta1_SQLFirewallRuleStatus_To_v1alpha1_SQLFirewallRuleStatus(in *SQLFirewallRuleStatus, out *v1alpha1.SQLFirewallRuleStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLFirewallRuleStatus_To_v1alpha1_SQLFirewallRuleStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLFirewallRuleStatus_To_v1beta1_SQLFirewallRuleStatus(in *v1alpha1.SQLFirewallRuleStatus, out *SQLFirewallRuleStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SQLFirewallRuleStatus_To_v1beta1_SQLFirewallRuleStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SQLFirewallRuleStatus_To_v1beta1_SQLFirewallRuleStatus(in *v1alpha1.SQLFirewallRuleStatus, out *SQLFirewallRuleStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLFirewallRuleStatus_To_v1beta1_SQLFirewallRuleStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLServer_To_v1alpha1_SQLServer(in *SQLServer, out *v1alpha1.SQLServer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SQLServerSpec_To_v1alpha1_SQLServerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_SQLServerStatus_To_v1alpha1_SQLServerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SQLServer_To_v1alpha1_SQLServer is an autogenerated conversion function.
+func Convert_v1beta1_SQLServer_To_v1alpha1_SQLServer(in *SQLServer, out *v1alpha1.SQLServer, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLServer_To_v1alpha1_SQLServer(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLServer_To_v1beta1_SQLServer(in *v1alpha1.SQLServer, out *SQLServer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SQLServerSpec_To_v1beta1_SQLServerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SQLServerStatus_To_v1beta1_SQLServerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SQLServer_To_v1beta1_SQLServer is an autogenerated conversion function.
+func Convert_v1alpha1_SQLServer_To_v1beta1_SQLServer(in *v1alpha1.SQLServer, out *SQLServer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLServer_To_v1beta1_SQLServer(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLServerList_To_v1alpha1_SQLServerList(in *SQLServerList, out *v1alpha1.SQLServerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.SQLServer)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_SQLServerList_To_v1alpha1_SQLServerList is an autogenerated conversion function.
+func Convert_v1beta1_SQLServerList_To_v1alpha1_SQLServerList(in *SQLServerList, out *v1alpha1.SQLServerList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLServerList_To_v1alpha1_SQLServerList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLServerList_To_v1beta1_SQLServerList(in *v1alpha1.SQLServerList, out *SQLServerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SQLServer)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SQLServerList_To_v1beta1_SQLServerList is an autogenerated conversion function.
+func Convert_v1alpha1_SQLServerList_To_v1beta1_SQLServerList(in *v1alpha1.SQLServerList, out *SQLServerList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLServerList_To_v1beta1_SQLServerList(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLServerSpec_To_v1alpha1_SQLServerSpec(in *SQLServerSpec, out *v1alpha1.SQLServerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.AllowAzureServiceAccess = (*bool)(unsafe.Pointer(in.AllowAzureServiceAccess))
+	return nil
+}
+
+// Convert_v1beta1_SQLServerSpec_To_v1alpha1_SQLServerSpec is an autogenerated conversion function.
+func Convert_v1beta1_SQLServerSpec_To_v1alpha1_SQLServerSpec(in *SQLServerSpec, out *v1alpha1.SQLServerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLServerSpec_To_v1alpha1_SQLServerSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLServerSpec_To_v1beta1_SQLServerSpec(in *v1alpha1.SQLServerSpec, out *SQLServerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.AllowAzureServiceAccess = (*bool)(unsafe.Pointer(in.AllowAzureServiceAccess))
+	return nil
+}
+
+// Convert_v1alpha1_SQLServerSpec_To_v1beta1_SQLServerSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SQLServerSpec_To_v1beta1_SQLServerSpec(in *v1alpha1.SQLServerSpec, out *SQLServerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLServerSpec_To_v1beta1_SQLServerSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SQLServerStatus_To_v1alpha1_SQLServerStatus(in *SQLServerStatus, out *v1alpha1.SQLServerStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SQLServerStatus_To_v1alpha1_SQLServerStatus is an autogenerated conversion function.
+func Convert_v1beta1_SQLServerStatus_To_v1alpha1_SQLServerStatus(in *SQLServerStatus, out *v1alpha1.SQLServerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SQLServerStatus_To_v1alpha1_SQLServerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SQLServerStatus_To_v1beta1_SQLServerStatus(in *v1alpha1.SQLServerStatus, out *SQLServerStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SQLServerStatus_To_v1beta1_SQLServerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SQLServerStatus_To_v1beta1_SQLServerStatus(in *v1alpha1.SQLServerStatus, out *SQLServerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SQLServerStatus_To_v1beta1_SQLServerStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_Secret_To_v1alpha1_Secret(in *Secret, out *v1alpha1.Secret, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SecretSpec_To_v1alpha1_SecretSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_SecretStatus_To_v1alpha1_SecretStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Secret_To_v1alpha1_Secret is an autogenerated conversion function.
+func Convert_v1beta1_Secret_To_v1alpha1_Secret(in *Secret, out *v1alpha1.Secret, s conversion.Scope) error {
+	return autoConvert_v1beta1_Secret_To_v1alpha1_Secret(in, out, s)
+}
+
+func autoConvert_v1alpha1_Secret_To_v1beta1_Secret(in *v1alpha1.Secret, out *Secret, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SecretSpec_To_v1beta1_SecretSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretStatus_To_v1beta1_SecretStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Secret_To_v1beta1_Secret is an autogenerated conversion function.
+func Convert_v1alpha1_Secret_To_v1beta1_Secret(in *v1alpha1.Secret, out *Secret, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Secret_To_v1beta1_Secret(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretBundle_To_v1alpha1_SecretBundle(in *SecretBundle, out *v1alpha1.SecretBundle, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SecretBundleSpec_To_v1alpha1_SecretBundleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_SecretBundleStatus_To_v1alpha1_SecretBundleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SecretBundle_To_v1alpha1_SecretBundle is an autogenerated conversion function.
+func Convert_v1beta1_SecretBundle_To_v1alpha1_SecretBundle(in *SecretBundle, out *v1alpha1.SecretBundle, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBundle_To_v1alpha1_SecretBundle(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretBundle_To_v1beta1_SecretBundle(in *v1alpha1.SecretBundle, out *SecretBundle, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SecretBundleSpec_To_v1beta1_SecretBundleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecretBundleStatus_To_v1beta1_SecretBundleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SecretBundle_To_v1beta1_SecretBundle is an autogenerated conversion function.
+func Convert_v1alpha1_SecretBundle_To_v1beta1_SecretBundle(in *v1alpha1.SecretBundle, out *SecretBundle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretBundle_To_v1beta1_SecretBundle(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretBundleList_To_v1alpha1_SecretBundleList(in *SecretBundleList, out *v1alpha1.SecretBundleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.SecretBundle)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_SecretBundleList_To_v1alpha1_SecretBundleList is an autogenerated conversion function.
+func Convert_v1beta1_SecretBundleList_To_v1alpha1_SecretBundleList(in *SecretBundleList, out *v1alpha1.SecretBundleList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBundleList_To_v1alpha1_SecretBundleList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretBundleList_To_v1beta1_SecretBundleList(in *v1alpha1.SecretBundleList, out *SecretBundleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SecretBundle)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SecretBundleList_To_v1beta1_SecretBundleList is an autogenerated conversion function.
+func Convert_v1alpha1_SecretBundleList_To_v1beta1_SecretBundleList(in *v1alpha1.SecretBundleList, out *SecretBundleList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretBundleList_To_v1beta1_SecretBundleList(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretBundleSpec_To_v1alpha1_SecretBundleSpec(in *SecretBundleSpec, out *v1alpha1.SecretBundleSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Secrets = *(*map[string]v1alpha1.SecretIdentifier)(unsafe.Pointer(&in.Secrets))
+	return nil
+}
+
+// Convert_v1beta1_SecretBundleSpec_To_v1alpha1_SecretBundleSpec is an autogenerated conversion function.
+func Convert_v1beta1_SecretBundleSpec_To_v1alpha1_SecretBundleSpec(in *SecretBundleSpec, out *v1alpha1.SecretBundleSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBundleSpec_To_v1alpha1_SecretBundleSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretBundleSpec_To_v1beta1_SecretBundleSpec(in *v1alpha1.SecretBundleSpec, out *SecretBundleSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Secrets = *(*map[string]SecretIdentifier)(unsafe.Pointer(&in.Secrets))
+	return nil
+}
+
+// Convert_v1alpha1_SecretBundleSpec_To_v1beta1_SecretBundleSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SecretBundleSpec_To_v1beta1_SecretBundleSpec(in *v1alpha1.SecretBundleSpec, out *SecretBundleSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretBundleSpec_To_v1beta1_SecretBundleSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretBundleStatus_To_v1alpha1_SecretBundleStatus(in *SecretBundleStatus, out *v1alpha1.SecretBundleStatus, s conversion.Scope) error {
+	out.Secrets = *(*map[string]string)(unsafe.Pointer(&in.Secrets))
+	out.State = (*string)(unsafe.Pointer(in.State))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SecretBundleStatus_To_v1alpha1_SecretBundleStatus is an autogenerated conversion function.
+func Convert_v1beta1_SecretBundleStatus_To_v1alpha1_SecretBundleStatus(in *SecretBundleStatus, out *v1alpha1.SecretBundleStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBundleStatus_To_v1alpha1_SecretBundleStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretBundleStatus_To_v1beta1_SecretBundleStatus(in *v1alpha1.SecretBundleStatus, out *SecretBundleStatus, s conversion.Scope) error {
+	out.Secrets = *(*map[string]string)(unsafe.Pointer(&in.Secrets))
+	out.State = (*string)(unsafe.Pointer(in.State))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SecretBundleStatus_To_v1beta1_SecretBundleStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SecretBundleStatus_To_v1beta1_SecretBundleStatus(in *v1alpha1.SecretBundleStatus, out *SecretBundleStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretBundleStatus_To_v1beta1_SecretBundleStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretIdentifier_To_v1alpha1_SecretIdentifier(in *SecretIdentifier, out *v1alpha1.SecretIdentifier, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Vault = in.Vault
+	out.Kind = (*string)(unsafe.Pointer(in.Kind))
+	out.Reverse = in.Reverse
+	return nil
+}
+
+// Convert_v1beta1_SecretIdentifier_To_v1alpha1_SecretIdentifier is an autogenerated conversion function.
+func Convert_v1beta1_SecretIdentifier_To_v1alpha1_SecretIdentifier(in *SecretIdentifier, out *v1alpha1.SecretIdentifier, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretIdentifier_To_v1alpha1_SecretIdentifier(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretIdentifier_To_v1beta1_SecretIdentifier(in *v1alpha1.SecretIdentifier, out *SecretIdentifier, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Vault = in.Vault
+	out.Kind = (*string)(unsafe.Pointer(in.Kind))
+	out.Reverse = in.Reverse
+	return nil
+}
+
+// Convert_v1alpha1_SecretIdentifier_To_v1beta1_SecretIdentifier is an autogenerated conversion function.
+func Convert_v1alpha1_SecretIdentifier_To_v1beta1_SecretIdentifier(in *v1alpha1.SecretIdentifier, out *SecretIdentifier, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretIdentifier_To_v1beta1_SecretIdentifier(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretList_To_v1alpha1_SecretList(in *SecretList, out *v1alpha1.SecretList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.Secret)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_SecretList_To_v1alpha1_SecretList is an autogenerated conversion function.
+func Convert_v1beta1_SecretList_To_v1alpha1_SecretList(in *SecretList, out *v1alpha1.SecretList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretList_To_v1alpha1_SecretList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretList_To_v1beta1_SecretList(in *v1alpha1.SecretList, out *SecretList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Secret)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SecretList_To_v1beta1_SecretList is an autogenerated conversion function.
+func Convert_v1alpha1_SecretList_To_v1beta1_SecretList(in *v1alpha1.SecretList, out *SecretList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretList_To_v1beta1_SecretList(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretSpec_To_v1alpha1_SecretSpec(in *SecretSpec, out *v1alpha1.SecretSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_SecretIdentifier_To_v1alpha1_SecretIdentifier(&in.SecretIdentifier, &out.SecretIdentifier, s); err != nil {
+		return err
+	}
+	out.FriendlyName = (*string)(unsafe.Pointer(in.FriendlyName))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.SubscriptionID = (*string)(unsafe.Pointer(in.SubscriptionID))
+	return nil
+}
+
+// Convert_v1beta1_SecretSpec_To_v1alpha1_SecretSpec is an autogenerated conversion function.
+func Convert_v1beta1_SecretSpec_To_v1alpha1_SecretSpec(in *SecretSpec, out *v1alpha1.SecretSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretSpec_To_v1alpha1_SecretSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretSpec_To_v1beta1_SecretSpec(in *v1alpha1.SecretSpec, out *SecretSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_SecretIdentifier_To_v1beta1_SecretIdentifier(&in.SecretIdentifier, &out.SecretIdentifier, s); err != nil {
+		return err
+	}
+	out.FriendlyName = (*string)(unsafe.Pointer(in.FriendlyName))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.SubscriptionID = (*string)(unsafe.Pointer(in.SubscriptionID))
+	return nil
+}
+
+// Convert_v1alpha1_SecretSpec_To_v1beta1_SecretSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SecretSpec_To_v1beta1_SecretSpec(in *v1alpha1.SecretSpec, out *SecretSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretSpec_To_v1beta1_SecretSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretStatus_To_v1alpha1_SecretStatus(in *SecretStatus, out *v1alpha1.SecretStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SecretStatus_To_v1alpha1_SecretStatus is an autogenerated conversion function.
+func Convert_v1beta1_SecretStatus_To_v1alpha1_SecretStatus(in *SecretStatus, out *v1alpha1.SecretStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretStatus_To_v1alpha1_SecretStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretStatus_To_v1beta1_SecretStatus(in *v1alpha1.SecretStatus, out *SecretStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SecretStatus_To_v1beta1_SecretStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SecretStatus_To_v1beta1_SecretStatus(in *v1alpha1.SecretStatus, out *SecretStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretStatus_To_v1beta1_SecretStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SecurityGroup_To_v1alpha1_SecurityGroup(in *SecurityGroup, out *v1alpha1.SecurityGroup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SecurityGroupSpec_To_v1alpha1_SecurityGroupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_SecurityGroupStatus_To_v1alpha1_SecurityGroupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SecurityGroup_To_v1alpha1_SecurityGroup is an autogenerated conversion function.
+func Convert_v1beta1_SecurityGroup_To_v1alpha1_SecurityGroup(in *SecurityGroup, out *v1alpha1.SecurityGroup, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecurityGroup_To_v1beta1_SecurityGroup(in *v1alpha1.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SecurityGroupSpec_To_v1beta1_SecurityGroupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroup_To_v1beta1_SecurityGroup is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroup_To_v1beta1_SecurityGroup(in *v1alpha1.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroup_To_v1beta1_SecurityGroup(in, out, s)
+}
+
+func autoConvert_v1beta1_SecurityGroupList_To_v1alpha1_SecurityGroupList(in *SecurityGroupList, out *v1alpha1.SecurityGroupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.SecurityGroup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_SecurityGroupList_To_v1alpha1_SecurityGroupList is an autogenerated conversion function.
+func Convert_v1beta1_SecurityGroupList_To_v1alpha1_SecurityGroupList(in *SecurityGroupList, out *v1alpha1.SecurityGroupList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecurityGroupList_To_v1alpha1_SecurityGroupList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecurityGroupList_To_v1beta1_SecurityGroupList(in *v1alpha1.SecurityGroupList, out *SecurityGroupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SecurityGroup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroupList_To_v1beta1_SecurityGroupList is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroupList_To_v1beta1_SecurityGroupList(in *v1alpha1.SecurityGroupList, out *SecurityGroupList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroupList_To_v1beta1_SecurityGroupList(in, out, s)
+}
+
+func autoConvert_v1beta1_SecurityGroupSpec_To_v1alpha1_SecurityGroupSpec(in *SecurityGroupSpec, out *v1alpha1.SecurityGroupSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Rules = *(*[]v1alpha1.SecurityRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1beta1_SecurityGroupSpec_To_v1alpha1_SecurityGroupSpec is an autogenerated conversion function.
+func Convert_v1beta1_SecurityGroupSpec_To_v1alpha1_SecurityGroupSpec(in *SecurityGroupSpec, out *v1alpha1.SecurityGroupSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecurityGroupSpec_To_v1alpha1_SecurityGroupSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecurityGroupSpec_To_v1beta1_SecurityGroupSpec(in *v1alpha1.SecurityGroupSpec, out *SecurityGroupSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Rules = *(*[]SecurityRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroupSpec_To_v1beta1_SecurityGroupSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroupSpec_To_v1beta1_SecurityGroupSpec(in *v1alpha1.SecurityGroupSpec, out *SecurityGroupSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroupSpec_To_v1beta1_SecurityGroupSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SecurityGroupStatus_To_v1alpha1_SecurityGroupStatus(in *SecurityGroupStatus, out *v1alpha1.SecurityGroupStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SecurityGroupStatus_To_v1alpha1_SecurityGroupStatus is an autogenerated conversion function.
+func Convert_v1beta1_SecurityGroupStatus_To_v1alpha1_SecurityGroupStatus(in *SecurityGroupStatus, out *v1alpha1.SecurityGroupStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecurityGroupStatus_To_v1alpha1_SecurityGroupStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(in *v1alpha1.SecurityGroupStatus, out *SecurityGroupStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(in *v1alpha1.SecurityGroupStatus, out *SecurityGroupStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroupStatus_To_v1beta1_SecurityGroupStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SecurityRule_To_v1alpha1_SecurityRule(in *SecurityRule, out *v1alpha1.SecurityRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = network.SecurityRuleProtocol(in.Protocol)
+	out.SourcePortRange = (*string)(unsafe.Pointer(in.SourcePortRange))
+	out.DestinationPortRange = (*string)(unsafe.Pointer(in.DestinationPortRange))
+	out.SourceAddressPrefix = (*string)(unsafe.Pointer(in.SourceAddressPrefix))
+	out.DestinationAddressPrefix = (*string)(unsafe.Pointer(in.DestinationAddressPrefix))
+	out.Access = network.SecurityRuleAccess(in.Access)
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.Direction = network.SecurityRuleDirection(in.Direction)
+	return nil
+}
+
+// Convert_v1beta1_SecurityRule_To_v1alpha1_SecurityRule is an autogenerated conversion function.
+func Convert_v1beta1_SecurityRule_To_v1alpha1_SecurityRule(in *SecurityRule, out *v1alpha1.SecurityRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecurityRule_To_v1alpha1_SecurityRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecurityRule_To_v1beta1_SecurityRule(in *v1alpha1.SecurityRule, out *SecurityRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = network.SecurityRuleProtocol(in.Protocol)
+	out.SourcePortRange = (*string)(unsafe.Pointer(in.SourcePortRange))
+	out.DestinationPortRange = (*string)(unsafe.Pointer(in.DestinationPortRange))
+	out.SourceAddressPrefix = (*string)(unsafe.Pointer(in.SourceAddressPrefix))
+	out.DestinationAddressPrefix = (*string)(unsafe.Pointer(in.DestinationAddressPrefix))
+	out.Access = network.SecurityRuleAccess(in.Access)
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.Direction = network.SecurityRuleDirection(in.Direction)
+	return nil
+}
+
+// Convert_v1alpha1_SecurityRule_To_v1beta1_SecurityRule is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityRule_To_v1beta1_SecurityRule(in *v1alpha1.SecurityRule, out *SecurityRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityRule_To_v1beta1_SecurityRule(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusKey_To_v1alpha1_ServiceBusKey(in *ServiceBusKey, out *v1alpha1.ServiceBusKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ServiceBusKeySpec_To_v1alpha1_ServiceBusKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ServiceBusKeyStatus_To_v1alpha1_ServiceBusKeyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusKey_To_v1alpha1_ServiceBusKey is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusKey_To_v1alpha1_ServiceBusKey(in *ServiceBusKey, out *v1alpha1.ServiceBusKey, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusKey_To_v1alpha1_ServiceBusKey(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusKey_To_v1beta1_ServiceBusKey(in *v1alpha1.ServiceBusKey, out *ServiceBusKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ServiceBusKeySpec_To_v1beta1_ServiceBusKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ServiceBusKeyStatus_To_v1beta1_ServiceBusKeyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusKey_To_v1beta1_ServiceBusKey is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusKey_To_v1beta1_ServiceBusKey(in *v1alpha1.ServiceBusKey, out *ServiceBusKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusKey_To_v1beta1_ServiceBusKey(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusKeyList_To_v1alpha1_ServiceBusKeyList(in *ServiceBusKeyList, out *v1alpha1.ServiceBusKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.ServiceBusKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusKeyList_To_v1alpha1_ServiceBusKeyList is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusKeyList_To_v1alpha1_ServiceBusKeyList(in *ServiceBusKeyList, out *v1alpha1.ServiceBusKeyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusKeyList_To_v1alpha1_ServiceBusKeyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusKeyList_To_v1beta1_ServiceBusKeyList(in *v1alpha1.ServiceBusKeyList, out *ServiceBusKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ServiceBusKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusKeyList_To_v1beta1_ServiceBusKeyList is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusKeyList_To_v1beta1_ServiceBusKeyList(in *v1alpha1.ServiceBusKeyList, out *ServiceBusKeyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusKeyList_To_v1beta1_ServiceBusKeyList(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusKeySpec_To_v1alpha1_ServiceBusKeySpec(in *ServiceBusKeySpec, out *v1alpha1.ServiceBusKeySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = in.TargetSecret
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	out.PrimaryConnectionString = (*string)(unsafe.Pointer(in.PrimaryConnectionString))
+	out.SecondaryConnectionString = (*string)(unsafe.Pointer(in.SecondaryConnectionString))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusKeySpec_To_v1alpha1_ServiceBusKeySpec is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusKeySpec_To_v1alpha1_ServiceBusKeySpec(in *ServiceBusKeySpec, out *v1alpha1.ServiceBusKeySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusKeySpec_To_v1alpha1_ServiceBusKeySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusKeySpec_To_v1beta1_ServiceBusKeySpec(in *v1alpha1.ServiceBusKeySpec, out *ServiceBusKeySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = in.TargetSecret
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	out.PrimaryConnectionString = (*string)(unsafe.Pointer(in.PrimaryConnectionString))
+	out.SecondaryConnectionString = (*string)(unsafe.Pointer(in.SecondaryConnectionString))
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusKeySpec_To_v1beta1_ServiceBusKeySpec is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusKeySpec_To_v1beta1_ServiceBusKeySpec(in *v1alpha1.ServiceBusKeySpec, out *ServiceBusKeySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusKeySpec_To_v1beta1_ServiceBusKeySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusKeyStatus_To_v1alpha1_ServiceBusKeyStatus(in *ServiceBusKeyStatus, out *v1alpha1.ServiceBusKeyStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusKeyStatus_To_v1alpha1_ServiceBusKeyStatus is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusKeyStatus_To_v1alpha1_ServiceBusKeyStatus(in *ServiceBusKeyStatus, out *v1alpha1.ServiceBusKeyStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusKeyStatus_To_v1alpha1_ServiceBusKeyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusKeyStatus_To_v1beta1_ServiceBusKeyStatus(in *v1alpha1.ServiceBusKeyStatus, out *ServiceBusKeyStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusKeyStatus_To_v1beta1_ServiceBusKeyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusKeyStatus_To_v1beta1_ServiceBusKeyStatus(in *v1alpha1.ServiceBusKeyStatus, out *ServiceBusKeyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusKeyStatus_To_v1beta1_ServiceBusKeyStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusNamespace_To_v1alpha1_ServiceBusNamespace(in *ServiceBusNamespace, out *v1alpha1.ServiceBusNamespace, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ServiceBusNamespaceSpec_To_v1alpha1_ServiceBusNamespaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ServiceBusNamespaceStatus_To_v1alpha1_ServiceBusNamespaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusNamespace_To_v1alpha1_ServiceBusNamespace is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusNamespace_To_v1alpha1_ServiceBusNamespace(in *ServiceBusNamespace, out *v1alpha1.ServiceBusNamespace, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusNamespace_To_v1alpha1_ServiceBusNamespace(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusNamespace_To_v1beta1_ServiceBusNamespace(in *v1alpha1.ServiceBusNamespace, out *ServiceBusNamespace, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ServiceBusNamespaceSpec_To_v1beta1_ServiceBusNamespaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ServiceBusNamespaceStatus_To_v1beta1_ServiceBusNamespaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusNamespace_To_v1beta1_ServiceBusNamespace is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusNamespace_To_v1beta1_ServiceBusNamespace(in *v1alpha1.ServiceBusNamespace, out *ServiceBusNamespace, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusNamespace_To_v1beta1_ServiceBusNamespace(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusNamespaceList_To_v1alpha1_ServiceBusNamespaceList(in *ServiceBusNamespaceList, out *v1alpha1.ServiceBusNamespaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.ServiceBusNamespace)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusNamespaceList_To_v1alpha1_ServiceBusNamespaceList is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusNamespaceList_To_v1alpha1_ServiceBusNamespaceList(in *ServiceBusNamespaceList, out *v1alpha1.ServiceBusNamespaceList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusNamespaceList_To_v1alpha1_ServiceBusNamespaceList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusNamespaceList_To_v1beta1_ServiceBusNamespaceList(in *v1alpha1.ServiceBusNamespaceList, out *ServiceBusNamespaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ServiceBusNamespace)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusNamespaceList_To_v1beta1_ServiceBusNamespaceList is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusNamespaceList_To_v1beta1_ServiceBusNamespaceList(in *v1alpha1.ServiceBusNamespaceList, out *ServiceBusNamespaceList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusNamespaceList_To_v1beta1_ServiceBusNamespaceList(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusNamespaceSku_To_v1alpha1_ServiceBusNamespaceSku(in *ServiceBusNamespaceSku, out *v1alpha1.ServiceBusNamespaceSku, s conversion.Scope) error {
+	out.Name = v1alpha1.SkuName(in.Name)
+	out.Tier = v1alpha1.SkuName(in.Tier)
+	out.Capacity = in.Capacity
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusNamespaceSku_To_v1alpha1_ServiceBusNamespaceSku is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusNamespaceSku_To_v1alpha1_ServiceBusNamespaceSku(in *ServiceBusNamespaceSku, out *v1alpha1.ServiceBusNamespaceSku, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusNamespaceSku_To_v1alpha1_ServiceBusNamespaceSku(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusNamespaceSku_To_v1beta1_ServiceBusNamespaceSku(in *v1alpha1.ServiceBusNamespaceSku, out *ServiceBusNamespaceSku, s conversion.Scope) error {
+	out.Name = SkuName(in.Name)
+	out.Tier = SkuName(in.Tier)
+	out.Capacity = in.Capacity
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusNamespaceSku_To_v1beta1_ServiceBusNamespaceSku is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusNamespaceSku_To_v1beta1_ServiceBusNamespaceSku(in *v1alpha1.ServiceBusNamespaceSku, out *ServiceBusNamespaceSku, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusNamespaceSku_To_v1beta1_ServiceBusNamespaceSku(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusNamespaceSpec_To_v1alpha1_ServiceBusNamespaceSpec(in *ServiceBusNamespaceSpec, out *v1alpha1.ServiceBusNamespaceSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	if err := Convert_v1beta1_ServiceBusNamespaceSku_To_v1alpha1_ServiceBusNamespaceSku(&in.SKU, &out.SKU, s); err != nil {
+		return err
+	}
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	out.PrimaryConnectionString = (*string)(unsafe.Pointer(in.PrimaryConnectionString))
+	out.SecondaryConnectionString = (*string)(unsafe.Pointer(in.SecondaryConnectionString))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusNamespaceSpec_To_v1alpha1_ServiceBusNamespaceSpec is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusNamespaceSpec_To_v1alpha1_ServiceBusNamespaceSpec(in *ServiceBusNamespaceSpec, out *v1alpha1.ServiceBusNamespaceSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusNamespaceSpec_To_v1alpha1_ServiceBusNamespaceSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusNamespaceSpec_To_v1beta1_ServiceBusNamespaceSpec(in *v1alpha1.ServiceBusNamespaceSpec, out *ServiceBusNamespaceSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	if err := Convert_v1alpha1_ServiceBusNamespaceSku_To_v1beta1_ServiceBusNamespaceSku(&in.SKU, &out.SKU, s); err != nil {
+		return err
+	}
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.SecondaryKey = (*string)(unsafe.Pointer(in.SecondaryKey))
+	out.PrimaryConnectionString = (*string)(unsafe.Pointer(in.PrimaryConnectionString))
+	out.SecondaryConnectionString = (*string)(unsafe.Pointer(in.SecondaryConnectionString))
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusNamespaceSpec_To_v1beta1_ServiceBusNamespaceSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusNamespaceSpec_To_v1beta1_ServiceBusNamespaceSpec(in *v1alpha1.ServiceBusNamespaceSpec, out *ServiceBusNamespaceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusNamespaceSpec_To_v1beta1_ServiceBusNamespaceSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBusNamespaceStatus_To_v1alpha1_ServiceBusNamespaceStatus(in *ServiceBusNamespaceStatus, out *v1alpha1.ServiceBusNamespaceStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ServiceBusNamespaceStatus_To_v1alpha1_ServiceBusNamespaceStatus is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBusNamespaceStatus_To_v1alpha1_ServiceBusNamespaceStatus(in *ServiceBusNamespaceStatus, out *v1alpha1.ServiceBusNamespaceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBusNamespaceStatus_To_v1alpha1_ServiceBusNamespaceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ServiceBusNamespaceStatus_To_v1beta1_ServiceBusNamespaceStatus(in *v1alpha1.ServiceBusNamespaceStatus, out *ServiceBusNamespaceStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ServiceBusNamespaceStatus_To_v1beta1_ServiceBusNamespaceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceBusNamespaceStatus_To_v1beta1_ServiceBusNamespaceStatus(in *v1alpha1.ServiceBusNamespaceStatus, out *ServiceBusNamespaceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceBusNamespaceStatus_To_v1beta1_ServiceBusNamespaceStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageAccount_To_v1alpha1_StorageAccount(in *StorageAccount, out *v1alpha1.StorageAccount, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_StorageAccountSpec_To_v1alpha1_StorageAccountSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_StorageAccountStatus_To_v1alpha1_StorageAccountStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_StorageAccount_To_v1alpha1_StorageAccount is an autogenerated conversion function.
+func Convert_v1beta1_StorageAccount_To_v1alpha1_StorageAccount(in *StorageAccount, out *v1alpha1.StorageAccount, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageAccount_To_v1alpha1_StorageAccount(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageAccount_To_v1beta1_StorageAccount(in *v1alpha1.StorageAccount, out *StorageAccount, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_StorageAccountSpec_To_v1beta1_StorageAccountSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_StorageAccountStatus_To_v1beta1_StorageAccountStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_StorageAccount_To_v1beta1_StorageAccount is an autogenerated conversion function.
+func Convert_v1alpha1_StorageAccount_To_v1beta1_StorageAccount(in *v1alpha1.StorageAccount, out *StorageAccount, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageAccount_To_v1beta1_StorageAccount(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageAccountList_To_v1alpha1_StorageAccountList(in *StorageAccountList, out *v1alpha1.StorageAccountList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.StorageAccount)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_StorageAccountList_To_v1alpha1_StorageAccountList is an autogenerated conversion function.
+func Convert_v1beta1_StorageAccountList_To_v1alpha1_StorageAccountList(in *StorageAccountList, out *v1alpha1.StorageAccountList, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageAccountList_To_v1alpha1_StorageAccountList(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageAccountList_To_v1beta1_StorageAccountList(in *v1alpha1.StorageAccountList, out *StorageAccountList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]StorageAccount)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_StorageAccountList_To_v1beta1_StorageAccountList is an autogenerated conversion function.
+func Convert_v1alpha1_StorageAccountList_To_v1beta1_StorageAccountList(in *v1alpha1.StorageAccountList, out *StorageAccountList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageAccountList_To_v1beta1_StorageAccountList(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageAccountSpec_To_v1alpha1_StorageAccountSpec(in *StorageAccountSpec, out *v1alpha1.StorageAccountSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	return nil
+}
+
+// Convert_v1beta1_StorageAccountSpec_To_v1alpha1_StorageAccountSpec is an autogenerated conversion function.
+func Convert_v1beta1_StorageAccountSpec_To_v1alpha1_StorageAccountSpec(in *StorageAccountSpec, out *v1alpha1.StorageAccountSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageAccountSpec_To_v1alpha1_StorageAccountSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageAccountSpec_To_v1beta1_StorageAccountSpec(in *v1alpha1.StorageAccountSpec, out *StorageAccountSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	return nil
+}
+
+// Convert_v1alpha1_StorageAccountSpec_To_v1beta1_StorageAccountSpec is an autogenerated conversion function.
+func Convert_v1alpha1_StorageAccountSpec_To_v1beta1_StorageAccountSpec(in *v1alpha1.StorageAccountSpec, out *StorageAccountSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageAccountSpec_To_v1beta1_StorageAccountSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageAccountStatus_To_v1alpha1_StorageAccountStatus(in *StorageAccountStatus, out *v1alpha1.StorageAccountStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_StorageAccountStatus_To_v1alpha1_StorageAccountStatus is an autogenerated conversion function.
+func Convert_v1beta1_StorageAccountStatus_To_v1alpha1_StorageAccountStatus(in *StorageAccountStatus, out *v1alpha1.StorageAccountStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageAccountStatus_To_v1alpha1_StorageAccountStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageAccountStatus_To_v1beta1_StorageAccountStatus(in *v1alpha1.StorageAccountStatus, out *StorageAccountStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_StorageAccountStatus_To_v1beta1_StorageAccountStatus is an autogenerated conversion function.
+func Convert_v1alpha1_StorageAccountStatus_To_v1beta1_StorageAccountStatus(in *v1alpha1.StorageAccountStatus, out *StorageAccountStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageAccountStatus_To_v1beta1_StorageAccountStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageKey_To_v1alpha1_StorageKey(in *StorageKey, out *v1alpha1.StorageKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_StorageKeySpec_To_v1alpha1_StorageKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_StorageKeyStatus_To_v1alpha1_StorageKeyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_StorageKey_To_v1alpha1_StorageKey is an autogenerated conversion function.
+func Convert_v1beta1_StorageKey_To_v1alpha1_StorageKey(in *StorageKey, out *v1alpha1.StorageKey, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageKey_To_v1alpha1_StorageKey(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageKey_To_v1beta1_StorageKey(in *v1alpha1.StorageKey, out *StorageKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_StorageKeySpec_To_v1beta1_StorageKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_StorageKeyStatus_To_v1beta1_StorageKeyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_StorageKey_To_v1beta1_StorageKey is an autogenerated conversion function.
+func Convert_v1alpha1_StorageKey_To_v1beta1_StorageKey(in *v1alpha1.StorageKey, out *StorageKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageKey_To_v1beta1_StorageKey(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageKeyList_To_v1alpha1_StorageKeyList(in *StorageKeyList, out *v1alpha1.StorageKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.StorageKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_StorageKeyList_To_v1alpha1_StorageKeyList is an autogenerated conversion function.
+func Convert_v1beta1_StorageKeyList_To_v1alpha1_StorageKeyList(in *StorageKeyList, out *v1alpha1.StorageKeyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageKeyList_To_v1alpha1_StorageKeyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageKeyList_To_v1beta1_StorageKeyList(in *v1alpha1.StorageKeyList, out *StorageKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]StorageKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_StorageKeyList_To_v1beta1_StorageKeyList is an autogenerated conversion function.
+func Convert_v1alpha1_StorageKeyList_To_v1beta1_StorageKeyList(in *v1alpha1.StorageKeyList, out *StorageKeyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageKeyList_To_v1beta1_StorageKeyList(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageKeySpec_To_v1alpha1_StorageKeySpec(in *StorageKeySpec, out *v1alpha1.StorageKeySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.PrimaryConnectionString = (*string)(unsafe.Pointer(in.PrimaryConnectionString))
+	return nil
+}
+
+// Convert_v1beta1_StorageKeySpec_To_v1alpha1_StorageKeySpec is an autogenerated conversion function.
+func Convert_v1beta1_StorageKeySpec_To_v1alpha1_StorageKeySpec(in *StorageKeySpec, out *v1alpha1.StorageKeySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageKeySpec_To_v1alpha1_StorageKeySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageKeySpec_To_v1beta1_StorageKeySpec(in *v1alpha1.StorageKeySpec, out *StorageKeySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.TargetSecret = (*string)(unsafe.Pointer(in.TargetSecret))
+	out.PrimaryKey = (*string)(unsafe.Pointer(in.PrimaryKey))
+	out.PrimaryConnectionString = (*string)(unsafe.Pointer(in.PrimaryConnectionString))
+	return nil
+}
+
+// Convert_v1alpha1_StorageKeySpec_To_v1beta1_StorageKeySpec is an autogenerated conversion function.
+func Convert_v1alpha1_StorageKeySpec_To_v1beta1_StorageKeySpec(in *v1alpha1.StorageKeySpec, out *StorageKeySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageKeySpec_To_v1beta1_StorageKeySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageKeyStatus_To_v1alpha1_StorageKeyStatus(in *StorageKeyStatus, out *v1alpha1.StorageKeyStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_StorageKeyStatus_To_v1alpha1_StorageKeyStatus is an autogenerated conversion function.
+func Convert_v1beta1_StorageKeyStatus_To_v1alpha1_StorageKeyStatus(in *StorageKeyStatus, out *v1alpha1.StorageKeyStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageKeyStatus_To_v1alpha1_StorageKeyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageKeyStatus_To_v1beta1_StorageKeyStatus(in *v1alpha1.StorageKeyStatus, out *StorageKeyStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_StorageKeyStatus_To_v1beta1_StorageKeyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_StorageKeyStatus_To_v1beta1_StorageKeyStatus(in *v1alpha1.StorageKeyStatus, out *StorageKeyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageKeyStatus_To_v1beta1_StorageKeyStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_Subnet_To_v1alpha1_Subnet(in *Subnet, out *v1alpha1.Subnet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SubnetSpec_To_v1alpha1_SubnetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_SubnetStatus_To_v1alpha1_SubnetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Subnet_To_v1alpha1_Subnet is an autogenerated conversion function.
+func Convert_v1beta1_Subnet_To_v1alpha1_Subnet(in *Subnet, out *v1alpha1.Subnet, s conversion.Scope) error {
+	return autoConvert_v1beta1_Subnet_To_v1alpha1_Subnet(in, out, s)
+}
+
+func autoConvert_v1alpha1_Subnet_To_v1beta1_Subnet(in *v1alpha1.Subnet, out *Subnet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SubnetSpec_To_v1beta1_SubnetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SubnetStatus_To_v1beta1_SubnetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Subnet_To_v1beta1_Subnet is an autogenerated conversion function.
+func Convert_v1alpha1_Subnet_To_v1beta1_Subnet(in *v1alpha1.Subnet, out *Subnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Subnet_To_v1beta1_Subnet(in, out, s)
+}
+
+func autoConvert_v1beta1_SubnetList_To_v1alpha1_SubnetList(in *SubnetList, out *v1alpha1.SubnetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.Subnet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_SubnetList_To_v1alpha1_SubnetList is an autogenerated conversion function.
+func Convert_v1beta1_SubnetList_To_v1alpha1_SubnetList(in *SubnetList, out *v1alpha1.SubnetList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SubnetList_To_v1alpha1_SubnetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SubnetList_To_v1beta1_SubnetList(in *v1alpha1.SubnetList, out *SubnetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Subnet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SubnetList_To_v1beta1_SubnetList is an autogenerated conversion function.
+func Convert_v1alpha1_SubnetList_To_v1beta1_SubnetList(in *v1alpha1.SubnetList, out *SubnetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubnetList_To_v1beta1_SubnetList(in, out, s)
+}
+
+func autoConvert_v1beta1_SubnetSpec_To_v1alpha1_SubnetSpec(in *SubnetSpec, out *v1alpha1.SubnetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Network = in.Network
+	out.NetworkRef = (*v1alpha1.ObjectReference)(unsafe.Pointer(in.NetworkRef))
+	out.Subnet = in.Subnet
+	return nil
+}
+
+// Convert_v1beta1_SubnetSpec_To_v1alpha1_SubnetSpec is an autogenerated conversion function.
+func Convert_v1beta1_SubnetSpec_To_v1alpha1_SubnetSpec(in *SubnetSpec, out *v1alpha1.SubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SubnetSpec_To_v1alpha1_SubnetSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SubnetSpec_To_v1beta1_SubnetSpec(in *v1alpha1.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Network = in.Network
+	out.NetworkRef = (*ObjectReference)(unsafe.Pointer(in.NetworkRef))
+	out.Subnet = in.Subnet
+	return nil
+}
+
+// Convert_v1alpha1_SubnetSpec_To_v1beta1_SubnetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SubnetSpec_To_v1beta1_SubnetSpec(in *v1alpha1.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubnetSpec_To_v1beta1_SubnetSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SubnetStatus_To_v1alpha1_SubnetStatus(in *SubnetStatus, out *v1alpha1.SubnetStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SubnetStatus_To_v1alpha1_SubnetStatus is an autogenerated conversion function.
+func Convert_v1beta1_SubnetStatus_To_v1alpha1_SubnetStatus(in *SubnetStatus, out *v1alpha1.SubnetStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SubnetStatus_To_v1alpha1_SubnetStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SubnetStatus_To_v1beta1_SubnetStatus(in *v1alpha1.SubnetStatus, out *SubnetStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SubnetStatus_To_v1beta1_SubnetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SubnetStatus_To_v1beta1_SubnetStatus(in *v1alpha1.SubnetStatus, out *SubnetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubnetStatus_To_v1beta1_SubnetStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_TLSSecret_To_v1alpha1_TLSSecret(in *TLSSecret, out *v1alpha1.TLSSecret, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_TLSSecretSpec_To_v1alpha1_TLSSecretSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_TLSSecretStatus_To_v1alpha1_TLSSecretStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_TLSSecret_To_v1alpha1_TLSSecret is an autogenerated conversion function.
+func Convert_v1beta1_TLSSecret_To_v1alpha1_TLSSecret(in *TLSSecret, out *v1alpha1.TLSSecret, s conversion.Scope) error {
+	return autoConvert_v1beta1_TLSSecret_To_v1alpha1_TLSSecret(in, out, s)
+}
+
+func autoConvert_v1alpha1_TLSSecret_To_v1beta1_TLSSecret(in *v1alpha1.TLSSecret, out *TLSSecret, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TLSSecretSpec_To_v1beta1_TLSSecretSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TLSSecretStatus_To_v1beta1_TLSSecretStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TLSSecret_To_v1beta1_TLSSecret is an autogenerated conversion function.
+func Convert_v1alpha1_TLSSecret_To_v1beta1_TLSSecret(in *v1alpha1.TLSSecret, out *TLSSecret, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TLSSecret_To_v1beta1_TLSSecret(in, out, s)
+}
+
+func autoConvert_v1beta1_TLSSecretList_To_v1alpha1_TLSSecretList(in *TLSSecretList, out *v1alpha1.TLSSecretList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.TLSSecret)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_TLSSecretList_To_v1alpha1_TLSSecretList is an autogenerated conversion function.
+func Convert_v1beta1_TLSSecretList_To_v1alpha1_TLSSecretList(in *TLSSecretList, out *v1alpha1.TLSSecretList, s conversion.Scope) error {
+	return autoConvert_v1beta1_TLSSecretList_To_v1alpha1_TLSSecretList(in, out, s)
+}
+
+func autoConvert_v1alpha1_TLSSecretList_To_v1beta1_TLSSecretList(in *v1alpha1.TLSSecretList, out *TLSSecretList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]TLSSecret)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_TLSSecretList_To_v1beta1_TLSSecretList is an autogenerated conversion function.
+func Convert_v1alpha1_TLSSecretList_To_v1beta1_TLSSecretList(in *v1alpha1.TLSSecretList, out *TLSSecretList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TLSSecretList_To_v1beta1_TLSSecretList(in, out, s)
+}
+
+func autoConvert_v1beta1_TLSSecretSpec_To_v1alpha1_TLSSecretSpec(in *TLSSecretSpec, out *v1alpha1.TLSSecretSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Vault = in.Vault
+	out.Reverse = in.Reverse
+	return nil
+}
+
+// Convert_v1beta1_TLSSecretSpec_To_v1alpha1_TLSSecretSpec is an autogenerated conversion function.
+func Convert_v1beta1_TLSSecretSpec_To_v1alpha1_TLSSecretSpec(in *TLSSecretSpec, out *v1alpha1.TLSSecretSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_TLSSecretSpec_To_v1alpha1_TLSSecretSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_TLSSecretSpec_To_v1beta1_TLSSecretSpec(in *v1alpha1.TLSSecretSpec, out *TLSSecretSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Vault = in.Vault
+	out.Reverse = in.Reverse
+	return nil
+}
+
+// Convert_v1alpha1_TLSSecretSpec_To_v1beta1_TLSSecretSpec is an autogenerated conversion function.
+func Convert_v1alpha1_TLSSecretSpec_To_v1beta1_TLSSecretSpec(in *v1alpha1.TLSSecretSpec, out *TLSSecretSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TLSSecretSpec_To_v1beta1_TLSSecretSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_TLSSecretStatus_To_v1alpha1_TLSSecretStatus(in *TLSSecretStatus, out *v1alpha1.TLSSecretStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_TLSSecretStatus_To_v1alpha1_TLSSecretStatus is an autogenerated conversion function.
+func Convert_v1beta1_TLSSecretStatus_To_v1alpha1_TLSSecretStatus(in *TLSSecretStatus, out *v1alpha1.TLSSecretStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_TLSSecretStatus_To_v1alpha1_TLSSecretStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_TLSSecretStatus_To_v1beta1_TLSSecretStatus(in *v1alpha1.TLSSecretStatus, out *TLSSecretStatus, s conversion.Scope) error {
+	out.State = (*string)(unsafe.Pointer(in.State))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TLSSecretStatus_To_v1beta1_TLSSecretStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TLSSecretStatus_To_v1beta1_TLSSecretStatus(in *v1alpha1.TLSSecretStatus, out *TLSSecretStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TLSSecretStatus_To_v1beta1_TLSSecretStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_TrafficManager_To_v1alpha1_TrafficManager(in *TrafficManager, out *v1alpha1.TrafficManager, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_TrafficManagerSpec_To_v1alpha1_TrafficManagerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_TrafficManagerStatus_To_v1alpha1_TrafficManagerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_TrafficManager_To_v1alpha1_TrafficManager is an autogenerated conversion function.
+func Convert_v1beta1_TrafficManager_To_v1alpha1_TrafficManager(in *TrafficManager, out *v1alpha1.TrafficManager, s conversion.Scope) error {
+	return autoConvert_v1beta1_TrafficManager_To_v1alpha1_TrafficManager(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrafficManager_To_v1beta1_TrafficManager(in *v1alpha1.TrafficManager, out *TrafficManager, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TrafficManagerSpec_To_v1beta1_TrafficManagerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TrafficManagerStatus_To_v1beta1_TrafficManagerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TrafficManager_To_v1beta1_TrafficManager is an autogenerated conversion function.
+func Convert_v1alpha1_TrafficManager_To_v1beta1_TrafficManager(in *v1alpha1.TrafficManager, out *TrafficManager, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrafficManager_To_v1beta1_TrafficManager(in, out, s)
+}
+
+func autoConvert_v1beta1_TrafficManagerList_To_v1alpha1_TrafficManagerList(in *TrafficManagerList, out *v1alpha1.TrafficManagerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.TrafficManager, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_TrafficManager_To_v1alpha1_TrafficManager(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_TrafficManagerList_To_v1alpha1_TrafficManagerList is an autogenerated conversion function.
+func Convert_v1beta1_TrafficManagerList_To_v1alpha1_TrafficManagerList(in *TrafficManagerList, out *v1alpha1.TrafficManagerList, s conversion.Scope) error {
+	return autoConvert_v1beta1_TrafficManagerList_To_v1alpha1_TrafficManagerList(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrafficManagerList_To_v1beta1_TrafficManagerList(in *v1alpha1.TrafficManagerList, out *TrafficManagerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficManager, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_TrafficManager_To_v1beta1_TrafficManager(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TrafficManagerList_To_v1beta1_TrafficManagerList is an autogenerated conversion function.
+func Convert_v1alpha1_TrafficManagerList_To_v1beta1_TrafficManagerList(in *v1alpha1.TrafficManagerList, out *TrafficManagerList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrafficManagerList_To_v1beta1_TrafficManagerList(in, out, s)
+}
+
+func autoConvert_v1beta1_TrafficManagerSpec_To_v1alpha1_TrafficManagerSpec(in *TrafficManagerSpec, out *v1alpha1.TrafficManagerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SubscriptionID = in.SubscriptionID
+	out.ResourceGroup = in.ResourceGroup
+	out.ProfileStatus = in.ProfileStatus
+	out.TrafficRoutingMethod = in.TrafficRoutingMethod
+	// WARNING: in.Endpoints requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.EndpointSpec vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.EndpointSpec)
+	if err := Convert_v1beta1_DNSConfig_To_v1alpha1_DNSConfig(&in.DNSConfig, &out.DNSConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MonitorConfig_To_v1alpha1_MonitorConfig(&in.MonitorConfig, &out.MonitorConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_TrafficManagerSpec_To_v1beta1_TrafficManagerSpec(in *v1alpha1.TrafficManagerSpec, out *TrafficManagerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SubscriptionID = in.SubscriptionID
+	out.ResourceGroup = in.ResourceGroup
+	out.ProfileStatus = in.ProfileStatus
+	out.TrafficRoutingMethod = in.TrafficRoutingMethod
+	// WARNING: in.Endpoints requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.EndpointSpec vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.EndpointSpec)
+	if err := Convert_v1alpha1_DNSConfig_To_v1beta1_DNSConfig(&in.DNSConfig, &out.DNSConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_MonitorConfig_To_v1beta1_MonitorConfig(&in.MonitorConfig, &out.MonitorConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_TrafficManagerStatus_To_v1alpha1_TrafficManagerStatus(in *TrafficManagerStatus, out *v1alpha1.TrafficManagerStatus, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.FQDN = (*string)(unsafe.Pointer(in.FQDN))
+	out.ProfileStatus = in.ProfileStatus
+	out.ProfileMonitorStatus = in.ProfileMonitorStatus
+	// WARNING: in.EndpointStatus requires manual conversion: inconvertible types ([]github.com/alexeldeib/incendiary-iguana/api/v1beta1.EndpointStatus vs *[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.EndpointStatus)
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_TrafficManagerStatus_To_v1beta1_TrafficManagerStatus(in *v1alpha1.TrafficManagerStatus, out *TrafficManagerStatus, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.FQDN = (*string)(unsafe.Pointer(in.FQDN))
+	out.ProfileStatus = in.ProfileStatus
+	out.ProfileMonitorStatus = in.ProfileMonitorStatus
+	// WARNING: in.EndpointStatus requires manual conversion: inconvertible types (*[]github.com/alexeldeib/incendiary-iguana/api/v1alpha1.EndpointStatus vs []github.com/alexeldeib/incendiary-iguana/api/v1beta1.EndpointStatus)
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_VM_To_v1alpha1_VM(in *VM, out *v1alpha1.VM, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_VMSpec_To_v1alpha1_VMSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_VMStatus_To_v1alpha1_VMStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VM_To_v1alpha1_VM is an autogenerated conversion function.
+func Convert_v1beta1_VM_To_v1alpha1_VM(in *VM, out *v1alpha1.VM, s conversion.Scope) error {
+	return autoConvert_v1beta1_VM_To_v1alpha1_VM(in, out, s)
+}
+
+func autoConvert_v1alpha1_VM_To_v1beta1_VM(in *v1alpha1.VM, out *VM, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VMSpec_To_v1beta1_VMSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VMStatus_To_v1beta1_VMStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VM_To_v1beta1_VM is an autogenerated conversion function.
+func Convert_v1alpha1_VM_To_v1beta1_VM(in *v1alpha1.VM, out *VM, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VM_To_v1beta1_VM(in, out, s)
+}
+
+func autoConvert_v1beta1_VMList_To_v1alpha1_VMList(in *VMList, out *v1alpha1.VMList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.VM, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_VM_To_v1alpha1_VM(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_VMList_To_v1alpha1_VMList is an autogenerated conversion function.
+func Convert_v1beta1_VMList_To_v1alpha1_VMList(in *VMList, out *v1alpha1.VMList, s conversion.Scope) error {
+	return autoConvert_v1beta1_VMList_To_v1alpha1_VMList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMList_To_v1beta1_VMList(in *v1alpha1.VMList, out *VMList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VM, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VM_To_v1beta1_VM(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VMList_To_v1beta1_VMList is an autogenerated conversion function.
+func Convert_v1alpha1_VMList_To_v1beta1_VMList(in *v1alpha1.VMList, out *VMList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMList_To_v1beta1_VMList(in, out, s)
+}
+
+func autoConvert_v1beta1_VMScaleSet_To_v1alpha1_VMScaleSet(in *VMScaleSet, out *v1alpha1.VMScaleSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_VMScaleSetSpec_To_v1alpha1_VMScaleSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_VMScaleSetStatus_To_v1alpha1_VMScaleSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VMScaleSet_To_v1alpha1_VMScaleSet is an autogenerated conversion function.
+func Convert_v1beta1_VMScaleSet_To_v1alpha1_VMScaleSet(in *VMScaleSet, out *v1alpha1.VMScaleSet, s conversion.Scope) error {
+	return autoConvert_v1beta1_VMScaleSet_To_v1alpha1_VMScaleSet(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMScaleSet_To_v1beta1_VMScaleSet(in *v1alpha1.VMScaleSet, out *VMScaleSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VMScaleSetSpec_To_v1beta1_VMScaleSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VMScaleSetStatus_To_v1beta1_VMScaleSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VMScaleSet_To_v1beta1_VMScaleSet is an autogenerated conversion function.
+func Convert_v1alpha1_VMScaleSet_To_v1beta1_VMScaleSet(in *v1alpha1.VMScaleSet, out *VMScaleSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMScaleSet_To_v1beta1_VMScaleSet(in, out, s)
+}
+
+func autoConvert_v1beta1_VMScaleSetList_To_v1alpha1_VMScaleSetList(in *VMScaleSetList, out *v1alpha1.VMScaleSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.VMScaleSet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_VMScaleSetList_To_v1alpha1_VMScaleSetList is an autogenerated conversion function.
+func Convert_v1beta1_VMScaleSetList_To_v1alpha1_VMScaleSetList(in *VMScaleSetList, out *v1alpha1.VMScaleSetList, s conversion.Scope) error {
+	return autoConvert_v1beta1_VMScaleSetList_To_v1alpha1_VMScaleSetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMScaleSetList_To_v1beta1_VMScaleSetList(in *v1alpha1.VMScaleSetList, out *VMScaleSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]VMScaleSet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_VMScaleSetList_To_v1beta1_VMScaleSetList is an autogenerated conversion function.
+func Convert_v1alpha1_VMScaleSetList_To_v1beta1_VMScaleSetList(in *v1alpha1.VMScaleSetList, out *VMScaleSetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMScaleSetList_To_v1beta1_VMScaleSetList(in, out, s)
+}
+
+func autoConvert_v1beta1_VMScaleSetSpec_To_v1alpha1_VMScaleSetSpec(in *VMScaleSetSpec, out *v1alpha1.VMScaleSetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1beta1_VMScaleSetSpec_To_v1alpha1_VMScaleSetSpec is an autogenerated conversion function.
+func Convert_v1beta1_VMScaleSetSpec_To_v1alpha1_VMScaleSetSpec(in *VMScaleSetSpec, out *v1alpha1.VMScaleSetSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_VMScaleSetSpec_To_v1alpha1_VMScaleSetSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMScaleSetSpec_To_v1beta1_VMScaleSetSpec(in *v1alpha1.VMScaleSetSpec, out *VMScaleSetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.SubscriptionID = in.SubscriptionID
+	return nil
+}
+
+// Convert_v1alpha1_VMScaleSetSpec_To_v1beta1_VMScaleSetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VMScaleSetSpec_To_v1beta1_VMScaleSetSpec(in *v1alpha1.VMScaleSetSpec, out *VMScaleSetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMScaleSetSpec_To_v1beta1_VMScaleSetSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_VMScaleSetStatus_To_v1alpha1_VMScaleSetStatus(in *VMScaleSetStatus, out *v1alpha1.VMScaleSetStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VMScaleSetStatus_To_v1alpha1_VMScaleSetStatus is an autogenerated conversion function.
+func Convert_v1beta1_VMScaleSetStatus_To_v1alpha1_VMScaleSetStatus(in *VMScaleSetStatus, out *v1alpha1.VMScaleSetStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_VMScaleSetStatus_To_v1alpha1_VMScaleSetStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMScaleSetStatus_To_v1beta1_VMScaleSetStatus(in *v1alpha1.VMScaleSetStatus, out *VMScaleSetStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VMScaleSetStatus_To_v1beta1_VMScaleSetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VMScaleSetStatus_To_v1beta1_VMScaleSetStatus(in *v1alpha1.VMScaleSetStatus, out *VMScaleSetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMScaleSetStatus_To_v1beta1_VMScaleSetStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_VMSpec_To_v1alpha1_VMSpec(in *VMSpec, out *v1alpha1.VMSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.SKU = in.SKU
+	out.CustomData = (*string)(unsafe.Pointer(in.CustomData))
+	out.SSHPublicKey = in.SSHPublicKey
+	out.PrimaryNIC = in.PrimaryNIC
+	out.PrimaryNICRef = (*v1alpha1.ObjectReference)(unsafe.Pointer(in.PrimaryNICRef))
+	// WARNING: in.SecondaryNICs requires manual conversion: inconvertible types ([]string vs *[]string)
+	out.SecondaryNICRefs = *(*[]v1alpha1.ObjectReference)(unsafe.Pointer(&in.SecondaryNICRefs))
+	out.DiskSize = in.DiskSize
+	return nil
+}
+
+func autoConvert_v1alpha1_VMSpec_To_v1beta1_VMSpec(in *v1alpha1.VMSpec, out *VMSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.SKU = in.SKU
+	out.CustomData = (*string)(unsafe.Pointer(in.CustomData))
+	out.SSHPublicKey = in.SSHPublicKey
+	out.PrimaryNIC = in.PrimaryNIC
+	out.PrimaryNICRef = (*ObjectReference)(unsafe.Pointer(in.PrimaryNICRef))
+	// WARNING: in.SecondaryNICs requires manual conversion: inconvertible types (*[]string vs []string)
+	out.SecondaryNICRefs = *(*[]ObjectReference)(unsafe.Pointer(&in.SecondaryNICRefs))
+	out.DiskSize = in.DiskSize
+	return nil
+}
+
+func autoConvert_v1beta1_VMStatus_To_v1alpha1_VMStatus(in *VMStatus, out *v1alpha1.VMStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VMStatus_To_v1alpha1_VMStatus is an autogenerated conversion function.
+func Convert_v1beta1_VMStatus_To_v1alpha1_VMStatus(in *VMStatus, out *v1alpha1.VMStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_VMStatus_To_v1alpha1_VMStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMStatus_To_v1beta1_VMStatus(in *v1alpha1.VMStatus, out *VMStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VMStatus_To_v1beta1_VMStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VMStatus_To_v1beta1_VMStatus(in *v1alpha1.VMStatus, out *VMStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMStatus_To_v1beta1_VMStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualNetwork_To_v1alpha1_VirtualNetwork(in *VirtualNetwork, out *v1alpha1.VirtualNetwork, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_VirtualNetworkSpec_To_v1alpha1_VirtualNetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_VirtualNetworkStatus_To_v1alpha1_VirtualNetworkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualNetwork_To_v1alpha1_VirtualNetwork is an autogenerated conversion function.
+func Convert_v1beta1_VirtualNetwork_To_v1alpha1_VirtualNetwork(in *VirtualNetwork, out *v1alpha1.VirtualNetwork, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualNetwork_To_v1alpha1_VirtualNetwork(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualNetwork_To_v1beta1_VirtualNetwork(in *v1alpha1.VirtualNetwork, out *VirtualNetwork, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VirtualNetworkSpec_To_v1beta1_VirtualNetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VirtualNetworkStatus_To_v1beta1_VirtualNetworkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualNetwork_To_v1beta1_VirtualNetwork is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualNetwork_To_v1beta1_VirtualNetwork(in *v1alpha1.VirtualNetwork, out *VirtualNetwork, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualNetwork_To_v1beta1_VirtualNetwork(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualNetworkList_To_v1alpha1_VirtualNetworkList(in *VirtualNetworkList, out *v1alpha1.VirtualNetworkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.VirtualNetwork)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_VirtualNetworkList_To_v1alpha1_VirtualNetworkList is an autogenerated conversion function.
+func Convert_v1beta1_VirtualNetworkList_To_v1alpha1_VirtualNetworkList(in *VirtualNetworkList, out *v1alpha1.VirtualNetworkList, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualNetworkList_To_v1alpha1_VirtualNetworkList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualNetworkList_To_v1beta1_VirtualNetworkList(in *v1alpha1.VirtualNetworkList, out *VirtualNetworkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]VirtualNetwork)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualNetworkList_To_v1beta1_VirtualNetworkList is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualNetworkList_To_v1beta1_VirtualNetworkList(in *v1alpha1.VirtualNetworkList, out *VirtualNetworkList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualNetworkList_To_v1beta1_VirtualNetworkList(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualNetworkSpec_To_v1alpha1_VirtualNetworkSpec(in *VirtualNetworkSpec, out *v1alpha1.VirtualNetworkSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_v1beta1_VirtualNetworkSpec_To_v1alpha1_VirtualNetworkSpec is an autogenerated conversion function.
+func Convert_v1beta1_VirtualNetworkSpec_To_v1alpha1_VirtualNetworkSpec(in *VirtualNetworkSpec, out *v1alpha1.VirtualNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualNetworkSpec_To_v1alpha1_VirtualNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualNetworkSpec_To_v1beta1_VirtualNetworkSpec(in *v1alpha1.VirtualNetworkSpec, out *VirtualNetworkSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Location = in.Location
+	out.ResourceGroup = in.ResourceGroup
+	out.SubscriptionID = in.SubscriptionID
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualNetworkSpec_To_v1beta1_VirtualNetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualNetworkSpec_To_v1beta1_VirtualNetworkSpec(in *v1alpha1.VirtualNetworkSpec, out *VirtualNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualNetworkSpec_To_v1beta1_VirtualNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_VirtualNetworkStatus_To_v1alpha1_VirtualNetworkStatus(in *VirtualNetworkStatus, out *v1alpha1.VirtualNetworkStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1beta1_ResourceStatus_To_v1alpha1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VirtualNetworkStatus_To_v1alpha1_VirtualNetworkStatus is an autogenerated conversion function.
+func Convert_v1beta1_VirtualNetworkStatus_To_v1alpha1_VirtualNetworkStatus(in *VirtualNetworkStatus, out *v1alpha1.VirtualNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_VirtualNetworkStatus_To_v1alpha1_VirtualNetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualNetworkStatus_To_v1beta1_VirtualNetworkStatus(in *v1alpha1.VirtualNetworkStatus, out *VirtualNetworkStatus, s conversion.Scope) error {
+	out.ProvisioningState = (*string)(unsafe.Pointer(in.ProvisioningState))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	if err := Convert_v1alpha1_ResourceStatus_To_v1beta1_ResourceStatus(&in.ResourceStatus, &out.ResourceStatus, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VirtualNetworkStatus_To_v1beta1_VirtualNetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualNetworkStatus_To_v1beta1_VirtualNetworkStatus(in *v1alpha1.VirtualNetworkStatus, out *VirtualNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualNetworkStatus_To_v1beta1_VirtualNetworkStatus(in, out, s)
+}
@@ -14,10 +14,9 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
-	"github.com/sanity-io/litter"
 	"github.com/spf13/cobra"
-	appsv1 "k8s.io/api/apps/v1"
 	extensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -25,31 +24,18 @@ import (
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	azurev1beta1 "github.com/alexeldeib/incendiary-iguana/api/v1beta1"
 	"github.com/alexeldeib/incendiary-iguana/controllers"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/dockercfg"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/identities"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/keyvaults"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/loadbalancers"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/nics"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/redis"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/rediskeys"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/resourcegroups"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/secretbundles"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/secrets"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/servicebus"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/servicebuskey"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlservers"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/storagekeys"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/subnets"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/tlssecrets"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/trafficmanagers"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/virtualnetworks"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/vms"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azerrors"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
 	"github.com/alexeldeib/incendiary-iguana/pkg/decoder"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
+	"github.com/alexeldeib/incendiary-iguana/pkg/wirelog"
 	"github.com/alexeldeib/taskpool"
 )
 
@@ -69,6 +55,7 @@ var (
 func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = azurev1alpha1.AddToScheme(scheme)
+	_ = azurev1beta1.AddToScheme(scheme)
 	_ = extensionsv1beta1.AddToScheme(scheme)
 	ctrl.SetLogger(zap.Logger(false))
 }
@@ -90,10 +77,10 @@ func NewEnsureCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.App, "AppId", "", "app id to authenticate with")
 	cmd.Flags().StringVar(&opts.Key, "AppKey", "", "app key to authenticate with")
 	cmd.Flags().StringVar(&opts.Tenant, "AppTenant", "", "tenant id to authenticate with")
+	cmd.Flags().StringVar(&opts.ClusterID, "ClusterId", "", "cluster id recorded in ownership tags on Azure resources")
+	opts.addAuthFlags(cmd)
+	opts.addTracingFlags(cmd)
 	cmd.MarkFlagRequired("file")
-	cmd.MarkFlagRequired("AppId")
-	cmd.MarkFlagRequired("AppKey")
-	cmd.MarkFlagRequired("AppTenant")
 	return cmd
 }
 
@@ -114,27 +101,105 @@ func NewDeleteCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.App, "AppId", "", "app id to authenticate with")
 	cmd.Flags().StringVar(&opts.Key, "AppKey", "", "app key to authenticate with")
 	cmd.Flags().StringVar(&opts.Tenant, "AppTenant", "", "tenant id to authenticate with")
+	opts.addAuthFlags(cmd)
+	opts.addTracingFlags(cmd)
 	cmd.MarkFlagRequired("file")
-	cmd.MarkFlagRequired("AppId")
-	cmd.MarkFlagRequired("AppKey")
-	cmd.MarkFlagRequired("AppTenant")
 	return cmd
 }
 
 type EnsureOptions struct {
-	File   string
-	Debug  bool
-	App    string
-	Key    string
-	Tenant string
+	File                    string
+	Debug                   bool
+	App                     string
+	Key                     string
+	Tenant                  string
+	ClusterID               string
+	AuthMethod              string
+	Certificate             string
+	CertificatePassword     string
+	ManagedIdentityClientID string
+	FederatedTokenFile      string
+	AuthFile                string
+	Cloud                   string
+	TracingExporter         string
+	TracingEndpoint         string
+	WireLog                 string
+}
+
+// addTracingFlags registers the flags selecting where tinker sends spans of its tasks.
+func (opts *EnsureOptions) addTracingFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&opts.TracingExporter, "TracingExporter", tracing.ExporterNone, fmt.Sprintf("where OpenTelemetry spans of each task and its Azure requests are sent, one of %v", tracing.Exporters))
+	cmd.Flags().StringVar(&opts.TracingEndpoint, "TracingEndpoint", "", "OTLP/HTTP endpoint of the OpenTelemetry collector, defaults to OTEL_EXPORTER_OTLP_ENDPOINT or "+tracing.DefaultEndpoint)
+}
+
+// startTracing sets up the exporter selected by the tracing flags. The returned function flushes spans before tinker exits.
+func (opts *EnsureOptions) startTracing(log logr.Logger) (func(), error) {
+	tracer, err := tracing.Setup(opts.TracingExporter, "tinker", opts.TracingEndpoint, func(err error) {
+		log.Error(err, "failed to export spans")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := tracer.Shutdown(ctx); err != nil {
+			log.Error(err, "failed to flush spans")
+		}
+	}, nil
+}
+
+// addAuthFlags registers the flags selecting how tinker authenticates to Azure.
+func (opts *EnsureOptions) addAuthFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&opts.AuthMethod, "AuthMethod", "", fmt.Sprintf("method to authenticate with, one of %v, inferred from the other flags when empty", config.AuthMethods))
+	cmd.Flags().StringVar(&opts.Certificate, "AppCertificate", "", "path of a PKCS#12 client certificate to authenticate the app with instead of a key")
+	cmd.Flags().StringVar(&opts.CertificatePassword, "AppCertificatePassword", "", "password of the client certificate")
+	cmd.Flags().StringVar(&opts.ManagedIdentityClientID, "ManagedIdentityClientId", "", "client id of a user assigned managed identity, empty for the system assigned identity")
+	cmd.Flags().StringVar(&opts.FederatedTokenFile, "FederatedTokenFile", "", "path of a federated token to exchange for a token of the app with workload identity")
+	cmd.Flags().StringVar(&opts.AuthFile, "AuthFile", "", "path of an SDK auth file, as written by az ad sp create-for-rbac --sdk-auth")
+	cmd.Flags().StringVar(&opts.WireLog, "WireLog", "", fmt.Sprintf("log Azure HTTP traffic with credentials redacted, a default level and Kind=level overrides, e.g. basic,VM=body, with levels %v", wirelog.Levels))
+	cmd.Flags().StringVar(&opts.Cloud, "Cloud", "", "Azure cloud to manage resources in, one of public, usgovernment, china or german, defaults to AZURE_ENVIRONMENT")
 }
 
 func (opts *EnsureOptions) authorize() (*config.Config, error) {
-	return config.New(
+	method, err := config.ParseAuthMethod(opts.AuthMethod)
+	if err != nil {
+		return nil, err
+	}
+	cloud, err := config.ParseCloud(opts.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	wireLogger, err := wirelog.Parse(ctrl.Log.WithName("azure"), opts.WireLog)
+	if err != nil {
+		return nil, err
+	}
+	options := []config.Option{
 		config.App(opts.App),
 		config.Key(opts.Key),
 		config.Tenant(opts.Tenant),
-	)
+		config.ClusterID(opts.ClusterID),
+		config.WireLogger(wireLogger),
+	}
+	if cloud != nil {
+		options = append(options, config.Cloud(*cloud))
+	}
+	if opts.Certificate != "" {
+		options = append(options, config.CertificateFile(opts.Certificate, opts.CertificatePassword))
+	}
+	if opts.FederatedTokenFile != "" {
+		options = append(options, config.FederatedTokenFile(opts.FederatedTokenFile))
+	}
+	if opts.AuthFile != "" {
+		options = append(options, config.AuthFileLocation(opts.AuthFile))
+	}
+	if method == config.AuthManagedIdentity || opts.ManagedIdentityClientID != "" {
+		options = append(options, config.ManagedIdentity(opts.ManagedIdentityClientID))
+	}
+	if method != "" {
+		options = append(options, config.Method(method))
+	}
+	return config.New(options...)
 }
 
 func (opts *EnsureOptions) Ensure() error {
@@ -147,8 +212,13 @@ func (opts *EnsureOptions) Ensure() error {
 	if err != nil {
 		return err
 	}
-	log.WithValues("App", opts.App, "Tenant", opts.Tenant, "KeyLen", len(opts.Key)).Info("args")
-	return do(objects, configuration, Ensure, log)
+	log.WithValues("App", opts.App, "Tenant", opts.Tenant, "KeyLen", len(opts.Key), "AuthMethod", configuration.AuthMethod()).Info("args")
+	stop, err := opts.startTracing(log)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	return do(context.Background(), objects, configuration, Ensure, log)
 }
 
 func (opts *EnsureOptions) Delete() error {
@@ -161,7 +231,12 @@ func (opts *EnsureOptions) Delete() error {
 	if err != nil {
 		return err
 	}
-	return do(objects, configuration, Delete, log)
+	stop, err := opts.startTracing(log)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	return do(context.Background(), objects, configuration, Delete, log)
 }
 
 func (opts *EnsureOptions) Read(log logr.Logger) ([]runtime.Object, error) {
@@ -216,20 +291,28 @@ func (opts *EnsureOptions) Read(log logr.Logger) ([]runtime.Object, error) {
 		// }
 
 		// gvks = append(gvks, *gvk)
+		obj, err = toHub(obj)
+		if err != nil {
+			return []runtime.Object{}, err
+		}
 		objects = append(objects, obj)
 	}
 
 	if opts.Debug {
 		log.V(1).Info("dumping manifests before applying")
 		for _, obj := range objects {
-			litter.Dump(obj)
+			dump, err := wirelog.RedactObject(obj)
+			if err != nil {
+				return []runtime.Object{}, err
+			}
+			fmt.Println(dump)
 		}
 	}
 
 	return objects, nil
 }
 
-func do(objects []runtime.Object, configuration *config.Config, applyFunc func(obj runtime.Object, configuration *config.Config, log logr.Logger) error, log logr.Logger) error {
+func do(ctx context.Context, objects []runtime.Object, configuration *config.Config, applyFunc func(ctx context.Context, obj runtime.Object, configuration *config.Config, log logr.Logger) error, log logr.Logger) error {
 	// apply objects
 	tasks := []*taskpool.Task{}
 
@@ -237,7 +320,7 @@ func do(objects []runtime.Object, configuration *config.Config, applyFunc func(o
 		// If you don't do this, you will end up ranging a non-deterministic subset of the array, duplicating some elements and missing others.
 		val := objects[key] // This will get me in Go everytime.
 		t := taskpool.NewTask(func() error {
-			return applyFunc(val, configuration, log)
+			return applyFunc(ctx, val, configuration, log)
 		})
 		tasks = append(tasks, t)
 	}
@@ -265,11 +348,21 @@ func do(objects []runtime.Object, configuration *config.Config, applyFunc func(o
 	return nil
 }
 
-func Ensure(obj runtime.Object, configuration *config.Config, log logr.Logger) error {
-	var err error
+func Ensure(ctx context.Context, obj runtime.Object, configuration *config.Config, log logr.Logger) (err error) {
+	ctx, span := startTask(ctx, "ensure", obj)
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
 	log = log.WithValues("action", "ensure", "type", obj.GetObjectKind().GroupVersionKind().String())
 	log.Info("starting reconciliation")
 
+	kind, ok := kindFor(obj)
+	if !ok {
+		log.Info("nothing to do.")
+		return nil
+	}
+
 	kubeclient, err := GetKubeclient()
 	if err != nil {
 		log.Error(err, "err with kubeclient")
@@ -277,78 +370,17 @@ func Ensure(obj runtime.Object, configuration *config.Config, log logr.Logger) e
 		return err
 	}
 
-	switch obj.(type) {
-	case *appsv1.Deployment:
-		log.Info("Deployment!")
-	case *azurev1alpha1.DockerConfig:
-		client, err := dockercfg.New(configuration, &kubeclient, scheme)
-		if err != nil {
-			log.Error(err, "got error with docker new client")
-			fmt.Printf("%#+v\n", err.Error())
-			break
-		}
-		err = EnsureSync(client, obj, log)
-		if err != nil {
-			log.Error(err, "got error with docker cfg")
-			fmt.Printf("%#+v\n", err.Error())
-		}
-	case *azurev1alpha1.Identity:
-		err = EnsureSync(identities.New(configuration), obj, log)
-	case *azurev1alpha1.Keyvault:
-		err = EnsureSync(keyvaults.New(configuration), obj, log)
-	case *azurev1alpha1.LoadBalancer:
-		err = EnsureAsync(loadbalancers.New(configuration), obj, log)
-	case *azurev1alpha1.NetworkInterface:
-		err = EnsureAsync(nics.New(configuration), obj, log)
-	case *azurev1alpha1.Redis:
-		err = EnsureAsync(redis.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.ResourceGroup:
-		err = EnsureAsync(resourcegroups.New(configuration), obj, log)
-	case *azurev1alpha1.Secret:
-		client, err := secrets.New(configuration, &kubeclient, scheme)
-		if err != nil {
-			fmt.Printf("%#+v\n", err)
-		}
-		err = EnsureSync(client, obj, log)
-		if err != nil {
-			fmt.Printf("%#+v\n", err)
-		}
-	case *azurev1alpha1.SecretBundle:
-		client, err := secretbundles.New(configuration, &kubeclient, scheme)
-		if err == nil {
-			err = EnsureSync(client, obj, log)
-		}
-		if err != nil {
-			fmt.Printf("%#+v\n", err)
-		}
-	case *azurev1alpha1.ServiceBusKey:
-		err = EnsureSync(servicebuskey.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.ServiceBusNamespace:
-		err = EnsureAsync(servicebus.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.SQLServer:
-		err = EnsureSync(sqlservers.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.StorageKey:
-		err = EnsureSync(storagekeys.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.Subnet:
-		err = EnsureAsync(subnets.New(configuration), obj, log)
-	case *azurev1alpha1.RedisKey:
-		err = EnsureSync(rediskeys.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.TLSSecret:
-		client, err := tlssecrets.New(configuration, &kubeclient, scheme)
-		if err == nil {
-			err = EnsureSync(client, obj, log)
+	switch kind.Mode() {
+	case controllers.ModeAsync:
+		var client controllers.AsyncClient
+		if client, err = kind.NewAsync(configuration, &kubeclient, scheme); err == nil {
+			err = EnsureAsync(ctx, client, obj, log)
 		}
-		if err != nil {
-			fmt.Printf("%#+v\n", err)
-		}
-	case *azurev1alpha1.TrafficManager:
-		err = EnsureTrafficManager(trafficmanagers.New(configuration), obj, log)
-	case *azurev1alpha1.VirtualNetwork:
-		err = EnsureAsync(virtualnetworks.New(configuration), obj, log)
-	case *azurev1alpha1.VM:
-		err = EnsureAsync(vms.New(configuration), obj, log)
 	default:
-		log.Info("nothing to do.")
+		var client controllers.SyncClient
+		if client, err = kind.NewSync(configuration, &kubeclient, scheme); err == nil {
+			err = EnsureSync(ctx, client, obj, log)
+		}
 	}
 	if err != nil {
 		log.Info("failed to reconcile")
@@ -360,67 +392,38 @@ func Ensure(obj runtime.Object, configuration *config.Config, log logr.Logger) e
 	return nil
 }
 
-func Delete(obj runtime.Object, configuration *config.Config, log logr.Logger) error {
+func Delete(ctx context.Context, obj runtime.Object, configuration *config.Config, log logr.Logger) (err error) {
+	ctx, span := startTask(ctx, "delete", obj)
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
 	log = log.WithValues("action", "delete", "type", obj.GetObjectKind().GroupVersionKind().String())
 	log.Info("starting deletion")
 
+	kind, ok := kindFor(obj)
+	if !ok {
+		log.Info("nothing to do.")
+		return nil
+	}
+
 	kubeclient, err := GetKubeclient()
 	if err != nil {
 		fmt.Printf("%#+v\n", err)
 		return err
 	}
 
-	switch obj.(type) {
-	case *appsv1.Deployment:
-		log.Info("Deployment!")
-	case *azurev1alpha1.DockerConfig:
-		client, err := dockercfg.New(configuration, &kubeclient, scheme)
-		if err == nil {
-			err = DeleteSync(client, obj, log)
-		}
-	case *azurev1alpha1.Identity:
-		err = DeleteSync(identities.New(configuration), obj, log)
-	case *azurev1alpha1.Keyvault:
-		err = DeleteSync(keyvaults.New(configuration), obj, log)
-	case *azurev1alpha1.LoadBalancer:
-		err = DeleteAsync(loadbalancers.New(configuration), obj, log)
-	case *azurev1alpha1.NetworkInterface:
-		err = DeleteAsync(nics.New(configuration), obj, log)
-	case *azurev1alpha1.Redis:
-		err = DeleteAsync(redis.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.ResourceGroup:
-		err = DeleteAsync(resourcegroups.New(configuration), obj, log)
-	case *azurev1alpha1.Secret:
-		client, err := secrets.New(configuration, &kubeclient, scheme)
-		if err == nil {
-			err = DeleteSync(client, obj, log)
-		}
-	case *azurev1alpha1.SecretBundle:
-		client, err := secretbundles.New(configuration, &kubeclient, scheme)
-		if err == nil {
-			err = DeleteSync(client, obj, log)
-		}
-	case *azurev1alpha1.ServiceBusNamespace:
-		err = DeleteAsync(servicebus.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.SQLServer:
-		err = DeleteSync(sqlservers.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.StorageKey:
-		err = DeleteSync(storagekeys.New(configuration, &kubeclient, scheme), obj, log)
-	case *azurev1alpha1.Subnet:
-		err = DeleteAsync(subnets.New(configuration), obj, log)
-	case *azurev1alpha1.TLSSecret:
-		client, err := tlssecrets.New(configuration, &kubeclient, scheme)
-		if err == nil {
-			err = DeleteSync(client, obj, log)
+	switch kind.Mode() {
+	case controllers.ModeAsync:
+		var client controllers.AsyncClient
+		if client, err = kind.NewAsync(configuration, &kubeclient, scheme); err == nil {
+			err = DeleteAsync(ctx, client, obj, log)
 		}
-	case *azurev1alpha1.TrafficManager:
-		log.Info("Traffic Manager!")
-	case *azurev1alpha1.VirtualNetwork:
-		err = DeleteAsync(virtualnetworks.New(configuration), obj, log)
-	case *azurev1alpha1.VM:
-		err = DeleteAsync(vms.New(configuration), obj, log)
 	default:
-		log.Info("nothing to do.")
+		var client controllers.SyncClient
+		if client, err = kind.NewSync(configuration, &kubeclient, scheme); err == nil {
+			err = DeleteSync(ctx, client, obj, log)
+		}
 	}
 	if err != nil {
 		log.Info("failed to delete")
@@ -431,7 +434,44 @@ func Delete(obj runtime.Object, configuration *config.Config, log logr.Logger) e
 	return nil
 }
 
-func EnsureSync(client controllers.SyncClient, obj runtime.Object, log logr.Logger) error {
+// startTask starts the span of one tinker task, identifying the object it applies.
+func startTask(ctx context.Context, action string, obj runtime.Object) (context.Context, *tracing.Span) {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	attributes := []tracing.Attribute{tracing.String("k8s.kind", kind), tracing.String("tinker.action", action)}
+	if local, err := meta.Accessor(obj); err == nil {
+		attributes = append(attributes, tracing.String("k8s.namespace", local.GetNamespace()), tracing.String("k8s.name", local.GetName()))
+	}
+	return tracing.Start(ctx, "tinker "+action+" "+kind, tracing.SpanKindInternal, attributes...)
+}
+
+// toHub converts objects of any served API version to v1alpha1, the version understood by the Azure clients.
+func toHub(obj runtime.Object) (runtime.Object, error) {
+	convertible, ok := obj.(conversion.Convertible)
+	if !ok {
+		return obj, nil
+	}
+	gvk := azurev1alpha1.GroupVersion.WithKind(obj.GetObjectKind().GroupVersionKind().Kind)
+	hub, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	hub.GetObjectKind().SetGroupVersionKind(gvk)
+	if err := convertible.ConvertTo(hub.(conversion.Hub)); err != nil {
+		return nil, err
+	}
+	return hub, nil
+}
+
+// kindFor looks up the registration of the kind of a decoded object.
+func kindFor(obj runtime.Object) (controllers.Kind, bool) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return controllers.Kind{}, false
+	}
+	return controllers.KindFor(gvk)
+}
+
+func EnsureSync(ctx context.Context, client controllers.SyncClient, obj runtime.Object, log logr.Logger) error {
 	local, ok := obj.(metav1.Object)
 	if !ok {
 		return errors.New("failed type assertion after switching on type. check switch statement and function invocation.")
@@ -440,7 +480,7 @@ func EnsureSync(client controllers.SyncClient, obj runtime.Object, log logr.Logg
 	log = log.WithValues("type", obj.GetObjectKind().GroupVersionKind().String(), "namespace", local.GetNamespace(), "name", local.GetName())
 
 	// extract. consider keyvault and non-sub specific clients. Matrix size = 2x2 (async, sub)
-	if err := client.ForSubscription(context.Background(), obj); err != nil {
+	if err := client.ForSubscription(ctx, obj); err != nil {
 		return errors.Wrap(err, "failed to get client for subscription")
 	}
 
@@ -449,15 +489,15 @@ func EnsureSync(client controllers.SyncClient, obj runtime.Object, log logr.Logg
 	// extract this into async/sync, probably
 	return wait.ExponentialBackoff(backoff(), func() (bool, error) {
 		log.Info("reconciling")
-		err = client.Ensure(context.Background(), obj)
+		err = client.Ensure(ctx, obj)
 		if err != nil {
 			log.Error(err, "failed reconcile attempt")
 		}
-		return err == nil, nil
+		return err == nil, terminal(err)
 	})
 }
 
-func DeleteSync(client controllers.SyncClient, obj runtime.Object, log logr.Logger) error {
+func DeleteSync(ctx context.Context, client controllers.SyncClient, obj runtime.Object, log logr.Logger) error {
 	local, ok := obj.(metav1.Object)
 	if !ok {
 		return errors.New("failed type assertion after switching on type. check switch statement and function invocation.")
@@ -466,22 +506,22 @@ func DeleteSync(client controllers.SyncClient, obj runtime.Object, log logr.Logg
 	log = log.WithValues("type", obj.GetObjectKind().GroupVersionKind().String(), "namespace", local.GetNamespace(), "name", local.GetName())
 
 	// extract. consider keyvault and non-sub specific clients. Matrix size = 2x2 (async, sub)
-	if err := client.ForSubscription(context.Background(), obj); err != nil {
+	if err := client.ForSubscription(ctx, obj); err != nil {
 		return errors.Wrap(err, "failed to get client for subscription")
 	}
 
 	// extract this into async/sync, probably
 	return wait.ExponentialBackoff(backoff(), func() (done bool, err error) {
 		log.Info("reconciling")
-		err = client.Delete(context.Background(), obj)
+		err = client.Delete(ctx, obj)
 		if err != nil {
 			log.Error(err, "failed reconcile attempt")
 		}
-		return err == nil, nil
+		return err == nil, terminal(err)
 	})
 }
 
-func EnsureAsync(client controllers.AsyncClient, obj runtime.Object, log logr.Logger) error {
+func EnsureAsync(ctx context.Context, client controllers.AsyncClient, obj runtime.Object, log logr.Logger) error {
 	local, ok := obj.(metav1.Object)
 	if !ok {
 		return errors.New("failed type assertion after switching on type. check switch statement and function invocation.")
@@ -489,21 +529,21 @@ func EnsureAsync(client controllers.AsyncClient, obj runtime.Object, log logr.Lo
 
 	log = log.WithValues("type", obj.GetObjectKind().GroupVersionKind().String(), "namespace", local.GetNamespace(), "name", local.GetName())
 
-	if err := client.ForSubscription(context.Background(), obj); err != nil {
+	if err := client.ForSubscription(ctx, obj); err != nil {
 		return errors.Wrap(err, "failed to get client for subscription")
 	}
 
 	return wait.ExponentialBackoff(backoff(), func() (done bool, err error) {
 		log.Info("reconciling")
-		done, err = client.Ensure(context.Background(), obj)
+		done, err = client.Ensure(ctx, obj)
 		if err != nil {
 			log.Error(err, "failed reconcile attempt")
 		}
-		return done, nil
+		return done, terminal(err)
 	})
 }
 
-func DeleteAsync(client controllers.AsyncClient, obj runtime.Object, log logr.Logger) error {
+func DeleteAsync(ctx context.Context, client controllers.AsyncClient, obj runtime.Object, log logr.Logger) error {
 	local, ok := obj.(metav1.Object)
 	if !ok {
 		return errors.New("failed type assertion after switching on type. check switch statement and function invocation.")
@@ -511,68 +551,27 @@ func DeleteAsync(client controllers.AsyncClient, obj runtime.Object, log logr.Lo
 
 	log = log.WithValues("type", obj.GetObjectKind().GroupVersionKind().String(), "namespace", local.GetNamespace(), "name", local.GetName())
 
-	if err := client.ForSubscription(context.Background(), obj); err != nil {
+	if err := client.ForSubscription(ctx, obj); err != nil {
 		return errors.Wrap(err, "failed to get client for subscription")
 	}
 
 	return wait.ExponentialBackoff(backoff(), func() (done bool, err error) {
 		log.Info("reconciling")
-		found, err := client.Delete(context.Background(), obj)
+		found, err := client.Delete(ctx, obj)
 		if err != nil {
 			log.Error(err, "failed reconcile attempt")
 		}
-		return !found, nil
+		return !found, terminal(err)
 	})
 }
 
-func EnsureTrafficManager(client *trafficmanagers.Client, obj runtime.Object, log logr.Logger) error {
-	local, ok := obj.(*azurev1alpha1.TrafficManager)
-	if !ok {
-		return errors.New("failed type assertion after switching on type. check switch statement and function invocation.")
-	}
-
-	log = log.WithValues("type", "trafficmanager", "name", local.Spec.Name)
-
-	if err := client.ForSubscription(local.Spec.SubscriptionID); err != nil {
-		return errors.Wrap(err, "failed to get client for subscription")
+// terminal returns errors which retrying cannot fix, annotated with the Azure error code and message, and nil otherwise.
+// Returning a non-nil error from a backoff condition stops the backoff loop.
+func terminal(err error) error {
+	if !azerrors.IsTerminal(err) {
+		return nil
 	}
-
-	return wait.ExponentialBackoff(backoff(), func() (done bool, err error) {
-		log.Info("reconciling")
-		if _, err := client.Ensure(context.Background(), local); err != nil {
-			return false, errors.Wrap(err, "failed to reconcile")
-		}
-		status, err := client.GetProfileStatus(context.Background(), local)
-		log.Info("waiting for appropriate status", "status", status)
-		if err != nil {
-			return false, errors.Wrap(err, "failed to get monitor status")
-		}
-		if status == "Online" || status == "Disabled" {
-			return true, nil
-		}
-		return false, nil
-	})
-}
-
-func DeleteTrafficManager(client *trafficmanagers.Client, obj runtime.Object, log logr.Logger) error {
-	local, ok := obj.(*azurev1alpha1.TrafficManager)
-	if !ok {
-		return errors.New("failed type assertion after switching on type. check switch statement and function invocation.")
-	}
-
-	log = log.WithValues("type", "trafficmanager", "name", local.Spec.Name)
-
-	if err := client.ForSubscription(local.Spec.SubscriptionID); err != nil {
-		return errors.Wrap(err, "failed to get client for subscription")
-	}
-
-	return wait.ExponentialBackoff(backoff(), func() (done bool, err error) {
-		log.Info("deleting")
-		// n.b.: returning true *should* allow failing with an error.
-		// implementation:
-		// https://github.com/kubernetes/apimachinery/blob/461753078381c979582f217a28eb759ebee5295d/pkg/util/wait/wait.go#L290-L301
-		return true, client.Delete(context.Background(), local)
-	})
+	return errors.Wrap(azerrors.Classify(err), "not retrying")
 }
 
 func backoff() wait.Backoff {
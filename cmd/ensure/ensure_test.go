@@ -1,6 +1,7 @@
 package ensure_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -372,124 +373,124 @@ var _ = Describe("reconcile", func() {
 
 	Context("ensure", func() {
 		It("should create rg successfully", func() {
-			err := ensure.EnsureAsync(rgClient, rg, log)
+			err := ensure.EnsureAsync(context.Background(), rgClient, rg, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create vnet successfully", func() {
-			err := ensure.EnsureAsync(vnetClient, vnet, log)
+			err := ensure.EnsureAsync(context.Background(), vnetClient, vnet, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create subnet successfully", func() {
-			err := ensure.EnsureAsync(subnetClient, subnet, log)
+			err := ensure.EnsureAsync(context.Background(), subnetClient, subnet, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create sg successfully", func() {
-			err := ensure.EnsureAsync(sgClient, sg, log)
+			err := ensure.EnsureAsync(context.Background(), sgClient, sg, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create ip successfully", func() {
-			err := ensure.EnsureAsync(publicIPClient, ip, log)
+			err := ensure.EnsureAsync(context.Background(), publicIPClient, ip, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create lb successfully", func() {
-			err := ensure.EnsureAsync(loadbalancersClient, lb, log)
+			err := ensure.EnsureAsync(context.Background(), loadbalancersClient, lb, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create tm successfully", func() {
-			err := ensure.EnsureTrafficManager(tmClient, tm, log)
+			err := ensure.EnsureAsync(context.Background(), tmClient, tm, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create vault successfully", func() {
-			err := ensure.EnsureSync(vaultClient, vault, log)
+			err := ensure.EnsureSync(context.Background(), vaultClient, vault, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create secretbundle successfully", func() {
-			err := ensure.EnsureSync(secretbundlesClient, secretbundle, log)
+			err := ensure.EnsureSync(context.Background(), secretbundlesClient, secretbundle, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should create managed identity successfully", func() {
-			err := ensure.EnsureSync(identitiesClient, identity, log)
+			err := ensure.EnsureSync(context.Background(), identitiesClient, identity, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		// It("should create redis successfully", func() {
-		// 	err := ensure.EnsureAsync(redisClient, cache, log)
+		// 	err := ensure.EnsureAsync(context.Background(), redisClient, cache, log)
 		// 	Expect(err).ToNot(HaveOccurred())
 		// })
 
 		// It("should create servicebus namespace successfully", func() {
-		// 	err := ensure.EnsureAsync(sbnamespaceClient, sbnamespace, log)
+		// 	err := ensure.EnsureAsync(context.Background(), sbnamespaceClient, sbnamespace, log)
 		// 	Expect(err).ToNot(HaveOccurred())
 		// })
 	})
 
 	Context("delete", func() {
 		It("should delete servicebus namespace successfully", func() {
-			err := ensure.DeleteAsync(sbnamespaceClient, sbnamespace, log)
+			err := ensure.DeleteAsync(context.Background(), sbnamespaceClient, sbnamespace, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete redis successfully", func() {
-			err := ensure.DeleteAsync(redisClient, cache, log)
+			err := ensure.DeleteAsync(context.Background(), redisClient, cache, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete managed identity successfully", func() {
-			err := ensure.DeleteSync(identitiesClient, identity, log)
+			err := ensure.DeleteSync(context.Background(), identitiesClient, identity, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete secretbundle successfully", func() {
-			err := ensure.DeleteSync(secretbundlesClient, secretbundle, log)
+			err := ensure.DeleteSync(context.Background(), secretbundlesClient, secretbundle, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete vault successfully", func() {
-			err := ensure.DeleteSync(vaultClient, vault, log)
+			err := ensure.DeleteSync(context.Background(), vaultClient, vault, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete tm successfully", func() {
-			err := ensure.DeleteTrafficManager(tmClient, tm, log)
+			err := ensure.DeleteAsync(context.Background(), tmClient, tm, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete lb successfully", func() {
-			err := ensure.DeleteAsync(loadbalancersClient, lb, log)
+			err := ensure.DeleteAsync(context.Background(), loadbalancersClient, lb, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete ip successfully", func() {
-			err := ensure.DeleteAsync(publicIPClient, ip, log)
+			err := ensure.DeleteAsync(context.Background(), publicIPClient, ip, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete sg successfully", func() {
-			err := ensure.DeleteAsync(sgClient, sg, log)
+			err := ensure.DeleteAsync(context.Background(), sgClient, sg, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete subnet successfully", func() {
-			err := ensure.DeleteAsync(subnetClient, subnet, log)
+			err := ensure.DeleteAsync(context.Background(), subnetClient, subnet, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete vnet successfully", func() {
-			err := ensure.DeleteAsync(vnetClient, vnet, log)
+			err := ensure.DeleteAsync(context.Background(), vnetClient, vnet, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("should delete rg successfully", func() {
-			err := ensure.DeleteAsync(rgClient, rg, log)
+			err := ensure.DeleteAsync(context.Background(), rgClient, rg, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
@@ -0,0 +1,145 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azerrors"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
+)
+
+// MarkReady sets Ready and clears Reconciling and Failed on objects which support conditions.
+func MarkReady(obj runtime.Object) {
+	setConditions(obj,
+		condition(azurev1alpha1.ConditionReady, corev1.ConditionTrue, azurev1alpha1.ReasonSucceeded, "Successfully reconciled"),
+		condition(azurev1alpha1.ConditionReconciling, corev1.ConditionFalse, azurev1alpha1.ReasonSucceeded, ""),
+		condition(azurev1alpha1.ConditionFailed, corev1.ConditionFalse, azurev1alpha1.ReasonSucceeded, ""),
+	)
+}
+
+// MarkReconciling sets Reconciling and clears Ready and Failed on objects which support conditions.
+func MarkReconciling(obj runtime.Object, reason, message string) {
+	setConditions(obj,
+		condition(azurev1alpha1.ConditionReady, corev1.ConditionFalse, reason, message),
+		condition(azurev1alpha1.ConditionReconciling, corev1.ConditionTrue, reason, message),
+		condition(azurev1alpha1.ConditionFailed, corev1.ConditionFalse, reason, ""),
+	)
+}
+
+// MarkFailed sets Failed and clears Ready and Reconciling on objects which support conditions.
+func MarkFailed(obj runtime.Object, reason string, err error) {
+	setConditions(obj,
+		condition(azurev1alpha1.ConditionReady, corev1.ConditionFalse, reason, err.Error()),
+		condition(azurev1alpha1.ConditionReconciling, corev1.ConditionFalse, reason, ""),
+		condition(azurev1alpha1.ConditionFailed, corev1.ConditionTrue, reason, err.Error()),
+	)
+}
+
+// MarkDrifted records whether the Azure resource was found to differ from the spec on objects which support conditions.
+func MarkDrifted(obj runtime.Object, drifted bool) {
+	if drifted {
+		setConditions(obj, condition(azurev1alpha1.ConditionDrifted, corev1.ConditionTrue, azurev1alpha1.ReasonDriftDetected, "Azure resource no longer matches the spec"))
+		return
+	}
+	setConditions(obj, condition(azurev1alpha1.ConditionDrifted, corev1.ConditionFalse, azurev1alpha1.ReasonSucceeded, ""))
+}
+
+// MarkWaiting sets WaitingForDependency and clears Ready while a referenced object is not ready.
+func MarkWaiting(obj runtime.Object, message string) {
+	setConditions(obj,
+		condition(azurev1alpha1.ConditionWaitingForDependency, corev1.ConditionTrue, azurev1alpha1.ReasonDependencyNotReady, message),
+		condition(azurev1alpha1.ConditionReady, corev1.ConditionFalse, azurev1alpha1.ReasonDependencyNotReady, message),
+		condition(azurev1alpha1.ConditionReconciling, corev1.ConditionTrue, azurev1alpha1.ReasonDependencyNotReady, message),
+	)
+}
+
+// MarkDependenciesReady clears WaitingForDependency on objects which reference other objects.
+func MarkDependenciesReady(obj runtime.Object) {
+	if _, ok := obj.(azurev1alpha1.Dependent); !ok {
+		return
+	}
+	setConditions(obj, condition(azurev1alpha1.ConditionWaitingForDependency, corev1.ConditionFalse, azurev1alpha1.ReasonDependenciesReady, ""))
+}
+
+// MarkOwnership sets OwnershipConflict if err shows the Azure resource belongs to someone else, and clears it once Ensure succeeds.
+// Other errors leave it unchanged, since Ensure may have failed before checking ownership.
+func MarkOwnership(obj runtime.Object, err error) {
+	if clientutil.IsOwnershipConflict(err) {
+		setConditions(obj, condition(azurev1alpha1.ConditionOwnershipConflict, corev1.ConditionTrue, azurev1alpha1.ReasonOwnershipConflict, err.Error()))
+		return
+	}
+	if err == nil {
+		setConditions(obj, condition(azurev1alpha1.ConditionOwnershipConflict, corev1.ConditionFalse, azurev1alpha1.ReasonSucceeded, ""))
+	}
+}
+
+// MarkOwnershipUnchecked sets OwnershipConflict to unknown on a new object, before the controller adds its finalizer.
+// It tells new objects apart from those reconciled before ownership tags were introduced, see clientutil.CheckOwnership.
+func MarkOwnershipUnchecked(obj runtime.Object) {
+	setConditions(obj, condition(azurev1alpha1.ConditionOwnershipConflict, corev1.ConditionUnknown, azurev1alpha1.ReasonInProgress, "Ownership of the Azure resource is checked on the first reconcile"))
+}
+
+// failureReason returns the reason recorded on the Failed condition for an error returned by Ensure.
+// Azure errors are recorded by their category, e.g. QuotaExceeded.
+func failureReason(err error) string {
+	if clientutil.IsOwnershipConflict(err) {
+		return azurev1alpha1.ReasonOwnershipConflict
+	}
+	if IsOperationTimeout(err) {
+		return azurev1alpha1.ReasonOperationTimedOut
+	}
+	if category := azerrors.CategoryOf(err); category != "" && category != azerrors.Unknown {
+		return string(category)
+	}
+	return azurev1alpha1.ReasonReconcileFailed
+}
+
+// retryable drops terminal Azure errors, so the controller waits for a spec change or the resync period
+// instead of retrying a request which cannot succeed with the usual backoff.
+func retryable(err error) error {
+	if azerrors.IsTerminal(err) {
+		return nil
+	}
+	return err
+}
+
+// terminalMessage describes when a spec Azure rejected with err is tried again.
+func terminalMessage(period time.Duration, err error) string {
+	if period <= 0 {
+		return fmt.Sprintf("Not retrying until the spec changes: %s", azerrors.Classify(err).Error())
+	}
+	return fmt.Sprintf("Retrying in %s unless the spec changes: %s", period, azerrors.Classify(err).Error())
+}
+
+// markError sets Failed from an error returned by Azure, with the Azure error code and message when present.
+func markError(obj runtime.Object, err error) {
+	MarkFailed(obj, failureReason(err), azerrors.Classify(err))
+}
+
+func setConditions(obj runtime.Object, conditions ...azurev1alpha1.Condition) {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return
+	}
+	status := local.GetResourceStatus()
+	for _, c := range conditions {
+		status.Conditions.Set(c)
+	}
+}
+
+func condition(t azurev1alpha1.ConditionType, status corev1.ConditionStatus, reason, message string) azurev1alpha1.Condition {
+	return azurev1alpha1.Condition{
+		Type:    t,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+}
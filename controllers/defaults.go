@@ -0,0 +1,210 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// DefaultingWebhookPath is the path serving the DefaultingWebhook.
+const DefaultingWebhookPath = "/mutate-azure-alexeldeib-xyz-v1alpha1-defaults"
+
+// +kubebuilder:webhook:path=/mutate-azure-alexeldeib-xyz-v1alpha1-defaults,mutating=true,failurePolicy=fail,groups=azure.alexeldeib.xyz,resources=identities;keyvaults;loadbalancers;networkinterfaces;publicips;redis;rediskeys;resourcegroups;securitygroups;servicebus;servicebuskeys;sqlfirewallrules;sqlservers;storageaccounts;storagekeys;subnets;trafficmanagers;virtualnetworks;vms,verbs=create;update,versions=v1alpha1,name=mdefaults.azure.alexeldeib.xyz
+
+// inheritedField is a spec field which admission fills in from a parent when it is left empty.
+type inheritedField struct {
+	// field is the name of the string field in the spec of each kind.
+	field string
+	// fromGroup reads the value from a ResourceGroup object.
+	fromGroup func(group *azurev1alpha1.ResourceGroup) string
+	// fromProvider reads the value from the spec of a provider config.
+	fromProvider func(spec *azurev1alpha1.ProviderConfigSpec) string
+	// namespaceDefault is the namespace annotation holding the default value.
+	namespaceDefault string
+	// source is the annotation recording on the object where the value came from.
+	source string
+}
+
+var inheritedFields = []inheritedField{
+	{
+		field:            "Location",
+		fromGroup:        func(group *azurev1alpha1.ResourceGroup) string { return group.Spec.Location },
+		fromProvider:     func(spec *azurev1alpha1.ProviderConfigSpec) string { return spec.Location },
+		namespaceDefault: azurev1alpha1.DefaultLocationAnnotation,
+		source:           azurev1alpha1.LocationSourceAnnotation,
+	},
+	{
+		field:            "SubscriptionID",
+		fromGroup:        func(group *azurev1alpha1.ResourceGroup) string { return group.Spec.SubscriptionID },
+		fromProvider:     func(spec *azurev1alpha1.ProviderConfigSpec) string { return spec.SubscriptionID },
+		namespaceDefault: azurev1alpha1.DefaultSubscriptionIDAnnotation,
+		source:           azurev1alpha1.SubscriptionIDSourceAnnotation,
+	},
+}
+
+// ApplyDefaults fills in the location and subscription of obj when its spec leaves them empty, and reports whether obj changed.
+// Values are copied from the ResourceGroup object in the same namespace describing the resource group of obj,
+// then from the provider config selected by obj, falling back to the default annotations on the namespace. The source of each defaulted value is recorded in an annotation.
+func ApplyDefaults(ctx context.Context, kubeclient client.Client, obj runtime.Object) (bool, error) {
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	spec := reflect.Indirect(reflect.ValueOf(obj)).FieldByName("Spec")
+	if !spec.IsValid() || spec.Kind() != reflect.Struct {
+		return false, nil
+	}
+
+	var missing []inheritedField
+	for _, inherited := range inheritedFields {
+		if value := spec.FieldByName(inherited.field); value.IsValid() && value.Kind() == reflect.String && value.String() == "" {
+			missing = append(missing, inherited)
+		}
+	}
+	if len(missing) == 0 {
+		return false, nil
+	}
+
+	group, err := parentGroup(ctx, kubeclient, obj, spec)
+	if err != nil {
+		return false, err
+	}
+	// A provider config which does not exist yet or cannot be used only means there is nothing to copy from it.
+	// The controller reports why it cannot be used when it reconciles the object.
+	provider, err := SelectProviderConfig(ctx, kubeclient, res)
+	if err != nil && !apierrs.IsNotFound(err) && !IsInvalidProviderConfig(err) {
+		return false, err
+	}
+	var namespace corev1.Namespace
+	if res.GetNamespace() != "" {
+		if err := kubeclient.Get(ctx, types.NamespacedName{Name: res.GetNamespace()}, &namespace); client.IgnoreNotFound(err) != nil {
+			return false, err
+		}
+	}
+
+	changed := false
+	for _, inherited := range missing {
+		var value, source string
+		if group != nil && inherited.fromGroup(group) != "" {
+			value, source = inherited.fromGroup(group), fmt.Sprintf("ResourceGroup %s/%s", group.Namespace, group.Name)
+		} else if provider != nil && inherited.fromProvider(&provider.Spec) != "" {
+			value, source = inherited.fromProvider(&provider.Spec), provider.Source
+		} else if fallback := namespace.GetAnnotations()[inherited.namespaceDefault]; fallback != "" {
+			value, source = fallback, fmt.Sprintf("Namespace %s", namespace.Name)
+		} else {
+			continue
+		}
+		spec.FieldByName(inherited.field).SetString(value)
+		annotations := res.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[inherited.source] = source
+		res.SetAnnotations(annotations)
+		changed = true
+	}
+	return changed, nil
+}
+
+// parentGroup returns the ResourceGroup object in the namespace of obj describing the resource group named in its spec.
+// It returns nil when there is no such object or the name is ambiguous, e.g. the same group name in several subscriptions.
+func parentGroup(ctx context.Context, kubeclient client.Client, obj runtime.Object, spec reflect.Value) (*azurev1alpha1.ResourceGroup, error) {
+	if _, ok := obj.(*azurev1alpha1.ResourceGroup); ok {
+		return nil, nil
+	}
+	name := spec.FieldByName("ResourceGroup")
+	if !name.IsValid() || name.Kind() != reflect.String || name.String() == "" {
+		return nil, nil
+	}
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	var groups azurev1alpha1.ResourceGroupList
+	if err := kubeclient.List(ctx, &groups, client.InNamespace(res.GetNamespace())); err != nil {
+		return nil, err
+	}
+	subscription := ""
+	if value := spec.FieldByName("SubscriptionID"); value.IsValid() && value.Kind() == reflect.String {
+		subscription = value.String()
+	}
+	var found *azurev1alpha1.ResourceGroup
+	for i := range groups.Items {
+		group := &groups.Items[i]
+		if !strings.EqualFold(group.Spec.Name, name.String()) {
+			continue
+		}
+		if subscription != "" && !strings.EqualFold(group.Spec.SubscriptionID, subscription) {
+			continue
+		}
+		if found != nil {
+			return nil, nil
+		}
+		found = group
+	}
+	return found, nil
+}
+
+// DefaultingWebhook is the mutating admission webhook applying ApplyDefaults to every registered kind.
+type DefaultingWebhook struct {
+	Client  client.Client
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &DefaultingWebhook{}
+
+// InjectDecoder implements admission.DecoderInjector.
+func (w *DefaultingWebhook) InjectDecoder(decoder *admission.Decoder) error {
+	w.decoder = decoder
+	return nil
+}
+
+// Handle implements admission.Handler.
+func (w *DefaultingWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	kind, ok := KindFor(schema.GroupVersionKind(req.Kind))
+	if !ok {
+		return admission.Allowed("")
+	}
+	obj := kind.Object.DeepCopyObject()
+	if err := w.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// The object of a create request may omit its namespace, which must not show up in the patch.
+	namespace := res.GetNamespace()
+	res.SetNamespace(req.Namespace)
+	changed, err := ApplyDefaults(ctx, w.Client, obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	res.SetNamespace(namespace)
+	if !changed {
+		return admission.Allowed("")
+	}
+	marshalled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshalled)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+func defaultsNamespace() *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Annotations: map[string]string{
+			azurev1alpha1.DefaultLocationAnnotation:       "eastus",
+			azurev1alpha1.DefaultSubscriptionIDAnnotation: "namespace-sub",
+		}},
+	}
+}
+
+func defaultsGroup(name, location, subscription string) *azurev1alpha1.ResourceGroup {
+	return &azurev1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "prod"},
+		Spec:       azurev1alpha1.ResourceGroupSpec{Name: "group", Location: location, SubscriptionID: subscription},
+	}
+}
+
+func defaultsVnet(spec azurev1alpha1.VirtualNetworkSpec) *azurev1alpha1.VirtualNetwork {
+	return &azurev1alpha1.VirtualNetwork{ObjectMeta: metav1.ObjectMeta{Name: "vnet", Namespace: "prod"}, Spec: spec}
+}
+
+func TestApplyDefaultsInheritsResourceGroup(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, defaultsNamespace(), defaultsGroup("parent", "westus2", "group-sub"))
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group"})
+	changed, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+	g.Expect(obj.Spec.Location).To(Equal("westus2"))
+	g.Expect(obj.Spec.SubscriptionID).To(Equal("group-sub"))
+	g.Expect(obj.Annotations).To(HaveKeyWithValue(azurev1alpha1.LocationSourceAnnotation, "ResourceGroup prod/parent"))
+	g.Expect(obj.Annotations).To(HaveKeyWithValue(azurev1alpha1.SubscriptionIDSourceAnnotation, "ResourceGroup prod/parent"))
+}
+
+func TestApplyDefaultsFallsBackToNamespace(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, defaultsNamespace())
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group"})
+	changed, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+	g.Expect(obj.Spec.Location).To(Equal("eastus"))
+	g.Expect(obj.Spec.SubscriptionID).To(Equal("namespace-sub"))
+	g.Expect(obj.Annotations).To(HaveKeyWithValue(azurev1alpha1.LocationSourceAnnotation, "Namespace prod"))
+}
+
+func TestApplyDefaultsKeepsOwnValues(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, defaultsNamespace(), defaultsGroup("parent", "westus2", "group-sub"))
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group", Location: "centralus", SubscriptionID: "own-sub"})
+	changed, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeFalse())
+	g.Expect(obj.Spec.Location).To(Equal("centralus"))
+	g.Expect(obj.Annotations).To(BeEmpty())
+}
+
+func TestApplyDefaultsMatchesResourceGroupSubscription(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, defaultsNamespace(), defaultsGroup("parent", "westus2", "group-sub"))
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group", SubscriptionID: "other-sub"})
+	_, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(obj.Spec.Location).To(Equal("eastus"))
+}
+
+func TestApplyDefaultsAmbiguousResourceGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, defaultsNamespace(), defaultsGroup("a", "westus2", "sub-a"), defaultsGroup("b", "westus", "sub-b"))
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group"})
+	_, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(obj.Spec.Location).To(Equal("eastus"))
+	g.Expect(obj.Spec.SubscriptionID).To(Equal("namespace-sub"))
+}
+
+func TestApplyDefaultsResourceGroup(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, defaultsNamespace())
+	obj := defaultsGroup("parent", "", "")
+	changed, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+	g.Expect(obj.Spec.Location).To(Equal("eastus"))
+	g.Expect(obj.Spec.SubscriptionID).To(Equal("namespace-sub"))
+}
+
+func TestApplyDefaultsPrefersProviderConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	secretRef := corev1.SecretReference{Name: "creds"}
+	provider := &azurev1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "prod"},
+		Spec:       azurev1alpha1.ProviderConfigSpec{CredentialsSecretRef: secretRef, SubscriptionID: "provider-sub"},
+	}
+	kubeclient := newFakeClient(g, defaultsNamespace(), provider)
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group"})
+	obj.Annotations = map[string]string{azurev1alpha1.ProviderConfigAnnotation: "team"}
+	changed, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+	g.Expect(obj.Spec.SubscriptionID).To(Equal("provider-sub"))
+	g.Expect(obj.Spec.Location).To(Equal("eastus"))
+	g.Expect(obj.Annotations).To(HaveKeyWithValue(azurev1alpha1.SubscriptionIDSourceAnnotation, "ProviderConfig prod/team"))
+	g.Expect(obj.Annotations).To(HaveKeyWithValue(azurev1alpha1.LocationSourceAnnotation, "Namespace prod"))
+}
+
+func TestApplyDefaultsIgnoresMissingProviderConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, defaultsNamespace())
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group"})
+	obj.Annotations = map[string]string{azurev1alpha1.ProviderConfigAnnotation: "missing"}
+	_, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(obj.Spec.SubscriptionID).To(Equal("namespace-sub"))
+}
+
+func TestApplyDefaultsIgnoresUnusableProviderConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	provider := &azurev1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "incomplete"},
+		Spec: azurev1alpha1.ClusterProviderConfigSpec{
+			ProviderConfigSpec: azurev1alpha1.ProviderConfigSpec{CredentialsSecretRef: corev1.SecretReference{Name: "creds"}, SubscriptionID: "provider-sub"},
+			AllowedNamespaces:  []string{azurev1alpha1.AllNamespaces},
+		},
+	}
+	kubeclient := newFakeClient(g, defaultsNamespace(), provider)
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group"})
+	obj.Annotations = map[string]string{azurev1alpha1.ClusterProviderConfigAnnotation: "incomplete"}
+	_, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(obj.Spec.SubscriptionID).To(Equal("namespace-sub"))
+}
+
+func TestApplyDefaultsWithoutSource(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g)
+	obj := defaultsVnet(azurev1alpha1.VirtualNetworkSpec{Name: "vnet", ResourceGroup: "group"})
+	changed, err := ApplyDefaults(context.Background(), kubeclient, obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeFalse())
+	g.Expect(obj.Spec.Location).To(BeEmpty())
+}
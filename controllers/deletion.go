@@ -0,0 +1,45 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// GetDeletionPolicy returns the deletion policy for an object.
+// The annotation on the object takes precedence over the annotation on its namespace, which takes precedence over Delete.
+func GetDeletionPolicy(ctx context.Context, kubeclient client.Client, obj metav1.Object) (azurev1alpha1.DeletionPolicy, error) {
+	if value, ok := obj.GetAnnotations()[azurev1alpha1.DeletionPolicyAnnotation]; ok {
+		return parseDeletionPolicy(value)
+	}
+	if obj.GetNamespace() != "" {
+		var namespace corev1.Namespace
+		if err := kubeclient.Get(ctx, types.NamespacedName{Name: obj.GetNamespace()}, &namespace); client.IgnoreNotFound(err) != nil {
+			return "", err
+		}
+		if value, ok := namespace.GetAnnotations()[azurev1alpha1.DeletionPolicyAnnotation]; ok {
+			return parseDeletionPolicy(value)
+		}
+	}
+	return azurev1alpha1.DeletionPolicyDelete, nil
+}
+
+func parseDeletionPolicy(value string) (azurev1alpha1.DeletionPolicy, error) {
+	switch policy := azurev1alpha1.DeletionPolicy(value); policy {
+	case azurev1alpha1.DeletionPolicyDelete, azurev1alpha1.DeletionPolicyOrphan:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid value %q for annotation %s, must be one of %s or %s", value, azurev1alpha1.DeletionPolicyAnnotation, azurev1alpha1.DeletionPolicyDelete, azurev1alpha1.DeletionPolicyOrphan)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+func deletionGroup(annotations map[string]string) *azurev1alpha1.ResourceGroup {
+	return &azurev1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "group", Namespace: "prod", Annotations: annotations},
+	}
+}
+
+func deletionNamespace(annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Annotations: annotations},
+	}
+}
+
+func TestDeletionPolicyDefaultsToDelete(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g)
+	policy, err := GetDeletionPolicy(context.Background(), kubeclient, deletionGroup(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(Equal(azurev1alpha1.DeletionPolicyDelete))
+}
+
+func TestDeletionPolicyInheritsNamespaceDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, deletionNamespace(map[string]string{azurev1alpha1.DeletionPolicyAnnotation: "Orphan"}))
+	policy, err := GetDeletionPolicy(context.Background(), kubeclient, deletionGroup(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(Equal(azurev1alpha1.DeletionPolicyOrphan))
+}
+
+func TestDeletionPolicyPrefersObjectAnnotation(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, deletionNamespace(map[string]string{azurev1alpha1.DeletionPolicyAnnotation: "Orphan"}))
+	policy, err := GetDeletionPolicy(context.Background(), kubeclient, deletionGroup(map[string]string{azurev1alpha1.DeletionPolicyAnnotation: "Delete"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(Equal(azurev1alpha1.DeletionPolicyDelete))
+}
+
+func TestDeletionPolicyRejectsUnknownValues(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g)
+	_, err := GetDeletionPolicy(context.Background(), kubeclient, deletionGroup(map[string]string{azurev1alpha1.DeletionPolicyAnnotation: "Keep"}))
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// dependencyPollInterval is how often an object waiting on a dependency is requeued.
+const dependencyPollInterval = 15 * time.Second
+
+// ResolveDependencies fetches every object referenced by obj and passes their Azure IDs to the referencing object.
+// It returns a non-empty message describing the first dependency which is missing, not yet ready or may not be referenced.
+// Dependencies must be in the namespace of obj, so an object cannot consume Azure resources of another namespace.
+func ResolveDependencies(ctx context.Context, kubeclient client.Client, obj runtime.Object) (string, error) {
+	dependent, ok := obj.(azurev1alpha1.Dependent)
+	if !ok {
+		return "", nil
+	}
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	for _, dep := range dependent.Dependencies() {
+		key := types.NamespacedName{Name: dep.Ref.Name, Namespace: dep.Ref.Namespace}
+		if key.Namespace == "" {
+			key.Namespace = res.GetNamespace()
+		}
+		kind := reflect.Indirect(reflect.ValueOf(dep.Object)).Type().Name()
+		// Objects may only consume Azure resources of objects in their own namespace.
+		if key.Namespace != res.GetNamespace() {
+			return fmt.Sprintf("refusing to reference %s %s outside of namespace %s", kind, key, res.GetNamespace()), nil
+		}
+		if err := kubeclient.Get(ctx, key, dep.Object); err != nil {
+			if apierrs.IsNotFound(err) {
+				return fmt.Sprintf("waiting for %s %s to be created", kind, key), nil
+			}
+			return "", err
+		}
+		if accessor, ok := dep.Object.(azurev1alpha1.StatusAccessor); ok && !accessor.GetResourceStatus().Conditions.IsTrue(azurev1alpha1.ConditionReady) {
+			return fmt.Sprintf("waiting for %s %s to become ready", kind, key), nil
+		}
+		id, err := azureID(dep.Object)
+		if err != nil {
+			return "", err
+		}
+		if id == "" {
+			return fmt.Sprintf("waiting for %s %s to report an Azure ID", kind, key), nil
+		}
+		if err := dep.Resolve(id); err != nil {
+			return fmt.Sprintf("refusing to reference %s %s: %s", kind, key, err), nil
+		}
+	}
+	return "", nil
+}
+
+// azureID reads status.id from any kind in this group.
+func azureID(obj runtime.Object) (string, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	id, _, err := unstructured.NestedString(content, "status", "id")
+	return id, err
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+const dependencyVnetID = "/subscriptions/sub/resourceGroups/group/providers/Microsoft.Network/virtualNetworks/vnet"
+
+// referencingSubnet returns a subnet referencing the virtual network object named vnet.
+func referencingSubnet() *azurev1alpha1.Subnet {
+	return &azurev1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnet", Namespace: "default"},
+		Spec: azurev1alpha1.SubnetSpec{
+			Name:           "subnet",
+			ResourceGroup:  "group",
+			SubscriptionID: "sub",
+			NetworkRef:     &azurev1alpha1.ObjectReference{Name: "vnet"},
+		},
+	}
+}
+
+// referencedVnet returns a virtual network object with an Azure ID, ready if ready is true.
+func referencedVnet(namespace string, ready bool) *azurev1alpha1.VirtualNetwork {
+	vnet := &azurev1alpha1.VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "vnet", Namespace: namespace},
+	}
+	vnet.Status.ID = to.StringPtr(dependencyVnetID)
+	if ready {
+		MarkReady(vnet)
+	}
+	return vnet
+}
+
+func TestResolveDependenciesWaitsForMissing(t *testing.T) {
+	g := NewGomegaWithT(t)
+	subnet := referencingSubnet()
+	waiting, err := ResolveDependencies(context.Background(), newFakeClient(g), subnet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(waiting).To(ContainSubstring("VirtualNetwork default/vnet"))
+}
+
+func TestResolveDependenciesWaitsForReady(t *testing.T) {
+	g := NewGomegaWithT(t)
+	subnet := referencingSubnet()
+	waiting, err := ResolveDependencies(context.Background(), newFakeClient(g, referencedVnet("default", false)), subnet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(waiting).To(ContainSubstring("ready"))
+	g.Expect(subnet.Spec.Network).To(BeEmpty())
+}
+
+func TestResolveDependencies(t *testing.T) {
+	g := NewGomegaWithT(t)
+	subnet := referencingSubnet()
+	waiting, err := ResolveDependencies(context.Background(), newFakeClient(g, referencedVnet("default", true)), subnet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(waiting).To(BeEmpty())
+	g.Expect(subnet.Spec.Network).To(Equal("vnet"))
+}
+
+func TestResolveDependenciesRefusesOtherResourceGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	subnet := referencingSubnet()
+	subnet.Spec.ResourceGroup = "other"
+	waiting, err := ResolveDependencies(context.Background(), newFakeClient(g, referencedVnet("default", true)), subnet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(waiting).To(ContainSubstring("not in resource group other"))
+	g.Expect(subnet.Spec.Network).To(BeEmpty())
+}
+
+func TestResolveDependenciesRefusesOtherNamespaces(t *testing.T) {
+	g := NewGomegaWithT(t)
+	subnet := referencingSubnet()
+	subnet.Spec.NetworkRef.Namespace = "other"
+	waiting, err := ResolveDependencies(context.Background(), newFakeClient(g, referencedVnet("other", true)), subnet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(waiting).To(ContainSubstring("outside of namespace default"))
+	g.Expect(subnet.Spec.Network).To(BeEmpty())
+}
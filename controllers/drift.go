@@ -0,0 +1,109 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// DriftPolicy decides what a reconciler does when an Azure resource no longer matches its spec.
+type DriftPolicy string
+
+const (
+	// DriftPolicyEnforce reapplies the spec to Azure when drift is detected.
+	DriftPolicyEnforce DriftPolicy = "enforce"
+	// DriftPolicyReport only records drift with an event and a condition, leaving the Azure resource untouched.
+	DriftPolicyReport DriftPolicy = "report"
+)
+
+// ParseDriftPolicy validates a drift policy provided by a user. The empty string defaults to enforce.
+func ParseDriftPolicy(policy string) (DriftPolicy, error) {
+	switch DriftPolicy(policy) {
+	case "", DriftPolicyEnforce:
+		return DriftPolicyEnforce, nil
+	case DriftPolicyReport:
+		return DriftPolicyReport, nil
+	}
+	return "", fmt.Errorf("unknown drift policy %q, must be one of %q or %q", policy, DriftPolicyEnforce, DriftPolicyReport)
+}
+
+// Observer is implemented by clients which can refresh the status of an object from Azure without mutating anything.
+// Reconcilers use it instead of Ensure when the spec has not changed since it was last applied.
+type Observer interface {
+	// Observe populates the status of the object from Azure and reports whether the remote resource has drifted from the spec.
+	Observe(context.Context, runtime.Object) (bool, error)
+}
+
+// ResyncPeriods holds the interval at which ready objects are checked for drift, optionally overridden per kind.
+type ResyncPeriods struct {
+	Default time.Duration
+	PerKind map[string]time.Duration
+}
+
+// ParseResyncPeriods builds resync periods from a default and a comma separated list of Kind=duration overrides,
+// e.g. "ResourceGroup=1h,VM=5m".
+func ParseResyncPeriods(fallback time.Duration, overrides string) (ResyncPeriods, error) {
+	periods := ResyncPeriods{
+		Default: fallback,
+		PerKind: map[string]time.Duration{},
+	}
+	for _, item := range strings.Split(overrides, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 {
+			return ResyncPeriods{}, fmt.Errorf("invalid resync override %q, expected Kind=duration", item)
+		}
+		period, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return ResyncPeriods{}, fmt.Errorf("invalid resync override %q: %v", item, err)
+		}
+		periods.PerKind[parts[0]] = period
+	}
+	return periods, nil
+}
+
+// For returns the resync period for the provided kind.
+func (p ResyncPeriods) For(kind string) time.Duration {
+	if period, ok := p.PerKind[kind]; ok {
+		return period
+	}
+	return p.Default
+}
+
+// observeIfUnchanged refreshes the status of an unchanged object using a read-only call to Azure.
+// It returns true when the caller may skip Ensure, i.e. the client supports observation,
+// the spec is unchanged since it was last applied, and the remote resource either has not drifted
+// or the drift policy only asks for reporting.
+func observeIfUnchanged(ctx context.Context, log logr.Logger, recorder record.EventRecorder, policy DriftPolicy, az interface{}, local runtime.Object, hash string) (bool, error) {
+	observer, ok := az.(Observer)
+	if !ok || !IsUpToDate(local, hash) {
+		return false, nil
+	}
+	drifted, err := observer.Observe(ctx, local)
+	if err != nil {
+		return false, err
+	}
+	MarkDrifted(local, drifted)
+	if !drifted {
+		return true, nil
+	}
+	recorder.Event(local, "Warning", "DriftDetected", "Azure resource no longer matches the spec")
+	if policy == DriftPolicyReport {
+		log.Info("remote resource drifted from spec, reporting only")
+		return true, nil
+	}
+	log.Info("remote resource drifted from spec, reapplying")
+	return false, nil
+}
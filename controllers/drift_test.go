@@ -0,0 +1,159 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+type fakeObserver struct {
+	drifted bool
+	calls   int
+}
+
+func (f *fakeObserver) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	f.calls++
+	return f.drifted, nil
+}
+
+// appliedGroup returns a ready resource group applied at its current generation, and the hash of its spec.
+func appliedGroup(g *GomegaWithT) (*azurev1alpha1.ResourceGroup, string) {
+	local := &azurev1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "drift", Namespace: "default", Generation: 2},
+		Spec:       azurev1alpha1.ResourceGroupSpec{Name: "drift", Location: "westus2"},
+	}
+	hash, err := SpecHash(local)
+	g.Expect(err).ToNot(HaveOccurred())
+	MarkReady(local)
+	MarkObserved(local, hash)
+	return local, hash
+}
+
+func TestParseResyncPeriods(t *testing.T) {
+	g := NewGomegaWithT(t)
+	periods, err := ParseResyncPeriods(10*time.Minute, "ResourceGroup=1h, VM=5m")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(periods.For("ResourceGroup")).To(Equal(time.Hour))
+	g.Expect(periods.For("VM")).To(Equal(5 * time.Minute))
+	g.Expect(periods.For("Subnet")).To(Equal(10 * time.Minute))
+
+	_, err = ParseResyncPeriods(time.Minute, "ResourceGroup")
+	g.Expect(err).To(HaveOccurred())
+	_, err = ParseResyncPeriods(time.Minute, "ResourceGroup=soon")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestParseDriftPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	policy, err := ParseDriftPolicy("")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(Equal(DriftPolicyEnforce))
+	policy, err = ParseDriftPolicy("report")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(Equal(DriftPolicyReport))
+	_, err = ParseDriftPolicy("ignore")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestObserveIfUnchangedWithoutDrift(t *testing.T) {
+	g := NewGomegaWithT(t)
+	local, hash := appliedGroup(g)
+	recorder := record.NewFakeRecorder(10)
+	observer := &fakeObserver{}
+	skip, err := observeIfUnchanged(context.Background(), logf.Log, recorder, DriftPolicyEnforce, observer, local, hash)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(skip).To(BeTrue())
+	g.Expect(observer.calls).To(Equal(1))
+	g.Expect(local.Status.Conditions.IsTrue(azurev1alpha1.ConditionDrifted)).To(BeFalse())
+	g.Expect(recorder.Events).To(BeEmpty())
+}
+
+func TestObserveIfUnchangedEnforcesDrift(t *testing.T) {
+	g := NewGomegaWithT(t)
+	local, hash := appliedGroup(g)
+	recorder := record.NewFakeRecorder(10)
+	observer := &fakeObserver{drifted: true}
+	skip, err := observeIfUnchanged(context.Background(), logf.Log, recorder, DriftPolicyEnforce, observer, local, hash)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(skip).To(BeFalse())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("DriftDetected")))
+}
+
+func TestObserveIfUnchangedReportsDrift(t *testing.T) {
+	g := NewGomegaWithT(t)
+	local, hash := appliedGroup(g)
+	recorder := record.NewFakeRecorder(10)
+	observer := &fakeObserver{drifted: true}
+	skip, err := observeIfUnchanged(context.Background(), logf.Log, recorder, DriftPolicyReport, observer, local, hash)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(skip).To(BeTrue())
+	g.Expect(local.Status.Conditions.IsTrue(azurev1alpha1.ConditionDrifted)).To(BeTrue())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("DriftDetected")))
+}
+
+func TestObserveIfUnchangedSkipsChangedSpecs(t *testing.T) {
+	g := NewGomegaWithT(t)
+	local, hash := appliedGroup(g)
+	recorder := record.NewFakeRecorder(10)
+	observer := &fakeObserver{}
+	local.Spec.Location = "eastus"
+	local.Generation = 3
+	changed, err := SpecHash(local)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).ToNot(Equal(hash))
+	skip, err := observeIfUnchanged(context.Background(), logf.Log, recorder, DriftPolicyEnforce, observer, local, changed)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(skip).To(BeFalse())
+	g.Expect(observer.calls).To(BeZero())
+}
+
+func TestFailedTerminally(t *testing.T) {
+	g := NewGomegaWithT(t)
+	local, hash := appliedGroup(g)
+	MarkFailedTerminally(local, hash, historyNow)
+	g.Expect(FailedTerminally(local, hash)).To(BeTrue())
+	local.Spec.Location = "eastus"
+	changed, err := SpecHash(local)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(FailedTerminally(local, changed)).To(BeFalse())
+
+	MarkObserved(local, changed)
+	g.Expect(FailedTerminally(local, hash)).To(BeFalse())
+}
+
+func TestTerminalBackoff(t *testing.T) {
+	g := NewGomegaWithT(t)
+	local, hash := appliedGroup(g)
+	recordOutcome(local, ActionEnsure, false, quotaError(), historyNow)
+	MarkFailedTerminally(local, hash, historyNow)
+
+	wait, retry := TerminalBackoff(local, time.Hour, historyNow.Add(10*time.Minute))
+	g.Expect(retry).To(BeFalse())
+	g.Expect(wait).To(Equal(50 * time.Minute))
+
+	wait, retry = TerminalBackoff(local, time.Hour, historyNow.Add(time.Hour))
+	g.Expect(retry).To(BeTrue())
+	g.Expect(wait).To(BeZero())
+
+	// The same failure on the retry starts a new period.
+	recordOutcome(local, ActionEnsure, false, quotaError(), historyNow.Add(time.Hour))
+	MarkFailedTerminally(local, hash, historyNow.Add(time.Hour))
+	_, retry = TerminalBackoff(local, time.Hour, historyNow.Add(time.Hour+time.Minute))
+	g.Expect(retry).To(BeFalse())
+
+	wait, retry = TerminalBackoff(local, 0, historyNow.Add(24*time.Hour))
+	g.Expect(retry).To(BeFalse())
+	g.Expect(wait).To(BeZero())
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// SpecHash returns a stable hash of the spec of the provided object.
+func SpecHash(obj runtime.Object) (string, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	// encoding/json sorts map keys, so the output is deterministic.
+	data, err := json.Marshal(content["spec"])
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// MarkObserved records the generation and spec hash which were last applied to Azure.
+func MarkObserved(obj runtime.Object, hash string) {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return
+	}
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	status := local.GetResourceStatus()
+	status.ObservedGeneration = res.GetGeneration()
+	status.SpecHash = hash
+	status.FailedSpecHash = ""
+}
+
+// MarkFailedTerminally records the hash of a spec which Azure rejected with an error retrying cannot fix,
+// and restarts the wait of TerminalBackoff from now, since the history keeps the time of the first identical error.
+func MarkFailedTerminally(obj runtime.Object, hash string, now time.Time) {
+	if local, ok := obj.(azurev1alpha1.StatusAccessor); ok {
+		status := local.GetResourceStatus()
+		status.FailedSpecHash = hash
+		if status.LastError != nil {
+			status.LastError.Time = metav1.NewTime(now)
+		}
+	}
+}
+
+// FailedTerminally returns true if the spec with the provided hash was already rejected by Azure with an error retrying cannot fix.
+func FailedTerminally(obj runtime.Object, hash string) bool {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	return ok && hash != "" && local.GetResourceStatus().FailedSpecHash == hash
+}
+
+// TerminalBackoff returns how long a spec Azure rejected waits before it is tried again, counted from the last error,
+// and whether that time has passed. Fixing permissions or quota does not change the spec, so rejected specs are
+// retried once per period. A zero period disables retries until the spec changes.
+func TerminalBackoff(obj runtime.Object, period time.Duration, now time.Time) (time.Duration, bool) {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok || period <= 0 {
+		return 0, false
+	}
+	lastError := local.GetResourceStatus().LastError
+	if lastError == nil {
+		return 0, true
+	}
+	wait := lastError.Time.Add(period).Sub(now)
+	if wait <= 0 {
+		return 0, true
+	}
+	return wait, false
+}
+
+// IsUpToDate returns true if the object is ready and was last applied at its current generation and spec hash.
+func IsUpToDate(obj runtime.Object, hash string) bool {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return false
+	}
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	status := local.GetResourceStatus()
+	return status.Conditions.IsTrue(azurev1alpha1.ConditionReady) &&
+		status.ObservedGeneration == res.GetGeneration() &&
+		status.SpecHash == hash
+}
+
+// IgnoreStatusUpdates drops update events of objects of the same type as kind which only change their status,
+// such as the status writes of the reconciler itself. Changes to the spec, which bump the generation,
+// and to the annotations, labels, finalizers or deletion timestamp still trigger a reconcile.
+// Events of other types, e.g. secrets owned by an object, pass through.
+func IgnoreStatusUpdates(kind runtime.Object) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.MetaOld == nil || e.MetaNew == nil || reflect.TypeOf(e.ObjectNew) != reflect.TypeOf(kind) {
+				return true
+			}
+			return !onlyStatusChanged(e.MetaOld, e.MetaNew)
+		},
+	}
+}
+
+// onlyStatusChanged returns true if the metadata of an object shows no change besides its status.
+func onlyStatusChanged(before, after metav1.Object) bool {
+	return before.GetGeneration() == after.GetGeneration() &&
+		reflect.DeepEqual(before.GetAnnotations(), after.GetAnnotations()) &&
+		reflect.DeepEqual(before.GetLabels(), after.GetLabels()) &&
+		reflect.DeepEqual(before.GetFinalizers(), after.GetFinalizers()) &&
+		before.GetDeletionTimestamp().Equal(after.GetDeletionTimestamp())
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +31,28 @@ func Fetch(ctx context.Context, client client.Client, namespacedName types.Names
 	return nil
 }
 
+// updateStatus writes the status of obj unless it equals the status of original, the object as it was fetched.
+// Writing an unchanged status would only bump the resource version of obj.
+func updateStatus(ctx context.Context, kubeclient client.Client, original, obj runtime.Object) error {
+	if !statusChanged(original, obj) {
+		return nil
+	}
+	return kubeclient.Status().Update(ctx, obj)
+}
+
+// statusChanged returns true if the status of obj differs from the status of original.
+func statusChanged(original, obj runtime.Object) bool {
+	before, err := runtime.DefaultUnstructuredConverter.ToUnstructured(original)
+	if err != nil {
+		return true
+	}
+	after, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(before["status"], after["status"])
+}
+
 // AddFinalizerAndUpdate removes a finalizer from a runtime object and attempts to update that object in the API server.
 // It returns an error if either operation failed.
 func AddFinalizerAndUpdate(ctx context.Context, client client.Client, finalizer string, o runtime.Object) error {
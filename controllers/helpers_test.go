@@ -0,0 +1,28 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// newTestScheme returns a scheme holding the core and Azure kinds.
+func newTestScheme(g *GomegaWithT) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(azurev1alpha1.AddToScheme(scheme)).To(Succeed())
+	return scheme
+}
+
+// newFakeClient returns a fake Kubernetes client holding objs, which may be core or Azure objects.
+func newFakeClient(g *GomegaWithT, objs ...runtime.Object) client.Client {
+	return fake.NewFakeClientWithScheme(newTestScheme(g), objs...)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azerrors"
+)
+
+const (
+	// maxRecentOperations is the number of operation outcomes kept on the status of each object.
+	maxRecentOperations = 10
+	// maxErrorMessageLength bounds messages of errors without Azure details, which are often full request dumps.
+	maxErrorMessageLength = 512
+)
+
+// Actions recorded in the operation history.
+const (
+	ActionEnsure  = "Ensure"
+	ActionDelete  = "Delete"
+	ActionObserve = "Observe"
+)
+
+// Results recorded in the operation history.
+const (
+	ResultSucceeded  = "Succeeded"
+	ResultInProgress = "InProgress"
+	ResultFailed     = "Failed"
+)
+
+// recordOutcome appends the outcome of action to the operation history of obj and records its error as the last error.
+// An outcome identical to the last one, e.g. another poll of the same operation, leaves the status untouched,
+// so reconciles which make no progress do not write status and trigger themselves again.
+func recordOutcome(obj runtime.Object, action string, done bool, err error, now time.Time) {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return
+	}
+	status := local.GetResourceStatus()
+	record := azurev1alpha1.OperationRecord{
+		Action: action,
+		Result: ResultInProgress,
+		Time:   metav1.NewTime(now),
+	}
+	var failure *azurev1alpha1.AzureError
+	switch {
+	case err != nil:
+		failure = lastError(err, now)
+		record.Result = ResultFailed
+		record.Code = failure.Code
+		record.RequestID = failure.RequestID
+	case done:
+		record.Result = ResultSucceeded
+	}
+
+	if n := len(status.RecentOperations); n > 0 {
+		last := status.RecentOperations[n-1]
+		if last.Action == record.Action && last.Result == record.Result && last.Code == record.Code {
+			return
+		}
+	}
+	if failure != nil {
+		status.LastError = failure
+	}
+	status.RecentOperations = append(status.RecentOperations, record)
+	if n := len(status.RecentOperations); n > maxRecentOperations {
+		status.RecentOperations = status.RecentOperations[n-maxRecentOperations:]
+	}
+}
+
+// clearLastError forgets the last error of obj once it is ready.
+func clearLastError(obj runtime.Object) {
+	if local, ok := obj.(azurev1alpha1.StatusAccessor); ok {
+		local.GetResourceStatus().LastError = nil
+	}
+}
+
+// lastError converts err into its status representation, keeping the identifiers Azure support asks for.
+func lastError(err error, now time.Time) *azurev1alpha1.AzureError {
+	classified := azerrors.Classify(err)
+	message := classified.Message
+	if len(message) > maxErrorMessageLength {
+		message = message[:maxErrorMessageLength] + "..."
+	}
+	return &azurev1alpha1.AzureError{
+		Code:          classified.Code,
+		Category:      string(classified.Category),
+		Message:       message,
+		Target:        classified.Target,
+		RequestID:     classified.RequestID,
+		CorrelationID: classified.CorrelationID,
+		Time:          metav1.NewTime(now),
+	}
+}
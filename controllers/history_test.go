@@ -0,0 +1,125 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+var historyNow = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// quotaError returns the error of a request Azure refused for lack of quota.
+func quotaError() error {
+	resp := &http.Response{StatusCode: http.StatusConflict, Header: http.Header{
+		"X-Ms-Request-Id":             []string{"request-id"},
+		"X-Ms-Correlation-Request-Id": []string{"correlation-id"},
+	}}
+	inner := &azure.RequestError{
+		DetailedError: autorest.DetailedError{StatusCode: http.StatusConflict, Response: resp},
+		ServiceError:  &azure.ServiceError{Code: "QuotaExceeded", Message: "no cores left"},
+	}
+	return autorest.NewErrorWithError(inner, "compute.VirtualMachinesClient", "CreateOrUpdate", resp, "Failure responding to request")
+}
+
+func TestRecordOutcomeError(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := &azurev1alpha1.ResourceGroup{}
+	recordOutcome(group, ActionEnsure, false, quotaError(), historyNow)
+
+	g.Expect(group.Status.LastError).ToNot(BeNil())
+	g.Expect(group.Status.LastError.Code).To(Equal("QuotaExceeded"))
+	g.Expect(group.Status.LastError.Category).To(Equal("QuotaExceeded"))
+	g.Expect(group.Status.LastError.Message).To(Equal("no cores left"))
+	g.Expect(group.Status.LastError.RequestID).To(Equal("request-id"))
+	g.Expect(group.Status.LastError.CorrelationID).To(Equal("correlation-id"))
+	g.Expect(group.Status.LastError.Time.Time).To(Equal(historyNow))
+	g.Expect(group.Status.RecentOperations).To(HaveLen(1))
+	g.Expect(group.Status.RecentOperations[0].Result).To(Equal(ResultFailed))
+	g.Expect(group.Status.RecentOperations[0].RequestID).To(Equal("request-id"))
+
+	clearLastError(group)
+	g.Expect(group.Status.LastError).To(BeNil())
+}
+
+func TestRecordOutcomeRepeatedError(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := &azurev1alpha1.ResourceGroup{}
+	recordOutcome(group, ActionEnsure, false, quotaError(), historyNow)
+	before := group.DeepCopy()
+	recordOutcome(group, ActionEnsure, false, quotaError(), historyNow.Add(time.Minute))
+	g.Expect(group.Status).To(Equal(before.Status))
+}
+
+func TestRecordOutcomeTruncatesMessages(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := &azurev1alpha1.ResourceGroup{}
+	recordOutcome(group, ActionEnsure, false, errors.New(strings.Repeat("x", 2*maxErrorMessageLength)), historyNow)
+	g.Expect(len(group.Status.LastError.Message)).To(BeNumerically("<", 2*maxErrorMessageLength))
+}
+
+func TestRecordOutcomeHistory(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := &azurev1alpha1.ResourceGroup{}
+	recordOutcome(group, ActionEnsure, false, nil, historyNow)
+	before := group.DeepCopy()
+	recordOutcome(group, ActionEnsure, false, nil, historyNow.Add(time.Minute))
+	g.Expect(group.Status).To(Equal(before.Status))
+
+	recordOutcome(group, ActionEnsure, true, nil, historyNow)
+	g.Expect(group.Status.RecentOperations).To(HaveLen(2))
+	g.Expect(group.Status.RecentOperations[1].Result).To(Equal(ResultSucceeded))
+
+	for i := 0; i < maxRecentOperations; i++ {
+		recordOutcome(group, ActionDelete, i%2 == 0, nil, historyNow)
+	}
+	g.Expect(group.Status.RecentOperations).To(HaveLen(maxRecentOperations))
+	g.Expect(group.Status.RecentOperations[0].Action).To(Equal(ActionDelete))
+}
+
+func TestStatusChanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := &azurev1alpha1.ResourceGroup{}
+	group.Spec.Name = "group"
+	original := group.DeepCopyObject()
+	g.Expect(statusChanged(original, group)).To(BeFalse())
+
+	MarkReady(group)
+	g.Expect(statusChanged(original, group)).To(BeTrue())
+}
+
+func TestIgnoreStatusUpdates(t *testing.T) {
+	g := NewGomegaWithT(t)
+	before := &azurev1alpha1.ResourceGroup{}
+	before.Generation = 1
+	after := before.DeepCopy()
+	MarkReady(after)
+	predicate := IgnoreStatusUpdates(&azurev1alpha1.ResourceGroup{})
+	update := func(old, new *azurev1alpha1.ResourceGroup) bool {
+		return predicate.Update(event.UpdateEvent{MetaOld: old, ObjectOld: old, MetaNew: new, ObjectNew: new})
+	}
+	g.Expect(update(before, after)).To(BeFalse())
+
+	after.Generation = 2
+	g.Expect(update(before, after)).To(BeTrue())
+
+	after = before.DeepCopy()
+	after.Annotations = map[string]string{azurev1alpha1.PausedAnnotation: "true"}
+	g.Expect(update(before, after)).To(BeTrue())
+
+	after = before.DeepCopy()
+	after.Finalizers = []string{finalizerName}
+	g.Expect(update(before, after)).To(BeTrue())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
+)
+
+// KindReconciler reconciles objects of a single kind by delegating to either a SyncReconciler or an AsyncReconciler.
+type KindReconciler struct {
+	// Object is an empty instance of the reconciled kind. It is copied for every request.
+	Object runtime.Object
+	// Owns lists Kubernetes objects created on behalf of the kind. Changes to them trigger a reconcile of their owner.
+	Owns []runtime.Object
+	// Sync reconciles kinds whose Azure operations complete in a single call. Exactly one of Sync and Async is set.
+	Sync *SyncReconciler
+	// Async reconciles kinds which require long running operations. Exactly one of Sync and Async is set.
+	Async *AsyncReconciler
+	// Shard selects the objects reconciled by this manager. Objects of other shards are ignored.
+	Shard Shard
+}
+
+func (r *KindReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	local := r.Object.DeepCopyObject()
+	kubeclient := r.client()
+	// Requests for objects of other shards still arrive through owned objects and dependencies.
+	if err := kubeclient.Get(context.Background(), req.NamespacedName, local); err == nil {
+		if res, err := meta.Accessor(local); err == nil && !r.Shard.Owns(res) {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	kind := ""
+	if gvk, err := apiutil.GVKForObject(r.Object, r.scheme()); err == nil {
+		kind = gvk.Kind
+	}
+	ctx, span := tracing.Start(context.Background(), "Reconcile "+kind, tracing.SpanKindInternal,
+		tracing.String("k8s.kind", kind),
+		tracing.String("k8s.namespace", req.Namespace),
+		tracing.String("k8s.name", req.Name),
+	)
+	defer span.End()
+
+	var result ctrl.Result
+	var err error
+	if r.Sync != nil {
+		result, err = r.Sync.Reconcile(ctx, req, local)
+	} else {
+		result, err = r.Async.Reconcile(ctx, req, local)
+	}
+	span.SetAttributes(tracing.Bool("reconcile.requeue", result.Requeue || result.RequeueAfter > 0))
+	span.RecordError(err)
+	return result, err
+}
+
+func (r *KindReconciler) client() client.Client {
+	if r.Sync != nil {
+		return r.Sync.Client
+	}
+	return r.Async.Client
+}
+
+func (r *KindReconciler) scheme() *runtime.Scheme {
+	if r.Sync != nil {
+		return r.Sync.Scheme
+	}
+	return r.Async.Scheme
+}
+
+func (r *KindReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if (r.Sync == nil) == (r.Async == nil) {
+		return errors.New("exactly one of sync or async reconciler must be set")
+	}
+	concurrency := 0
+	if r.Sync != nil {
+		concurrency = r.Sync.MaxConcurrentReconciles
+	} else {
+		concurrency = r.Async.MaxConcurrentReconciles
+	}
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(r.Object).
+		WithEventFilter(r.Shard.Predicate(r.Object)).
+		WithEventFilter(IgnoreStatusUpdates(r.Object))
+	for _, owned := range r.Owns {
+		builder = builder.Owns(owned)
+	}
+	return builder.
+		WithOptions(controller.Options{MaxConcurrentReconciles: concurrency}).
+		Complete(r)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+)
+
+// ReadinessState summarizes the conditions of an object into the state reported by the objects gauge.
+func ReadinessState(obj runtime.Object) string {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return metrics.StateUnknown
+	}
+	conditions := local.GetResourceStatus().Conditions
+	switch {
+	case conditions.IsTrue(azurev1alpha1.ConditionPaused):
+		return metrics.StatePaused
+	case conditions.IsTrue(azurev1alpha1.ConditionReady):
+		return metrics.StateReady
+	case conditions.IsTrue(azurev1alpha1.ConditionFailed):
+		return metrics.StateFailed
+	case conditions.IsTrue(azurev1alpha1.ConditionWaitingForDependency):
+		return metrics.StateWaiting
+	case conditions.IsTrue(azurev1alpha1.ConditionReconciling):
+		return metrics.StateReconciling
+	default:
+		return metrics.StateUnknown
+	}
+}
+
+// recordReadiness updates the objects gauge with the current state of obj.
+func recordReadiness(kind string, key types.NamespacedName, obj runtime.Object) {
+	metrics.SetState(kind, key.String(), ReadinessState(obj))
+}
+
+// provisioningSince returns when obj started waiting on a long running operation, or the zero time if it is not waiting.
+func provisioningSince(obj runtime.Object) time.Time {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return time.Time{}
+	}
+	reconciling := local.GetResourceStatus().Conditions.Get(azurev1alpha1.ConditionReconciling)
+	if reconciling == nil || !local.GetResourceStatus().Conditions.IsTrue(azurev1alpha1.ConditionReconciling) || reconciling.Reason != azurev1alpha1.ReasonInProgress {
+		return time.Time{}
+	}
+	return reconciling.LastTransitionTime.Time
+}
+
+// observeLRO records the completion time of a long running operation which started at since.
+func observeLRO(kind string, since time.Time) {
+	if since.IsZero() {
+		return
+	}
+	metrics.LRODuration.WithLabelValues(kind).Observe(time.Since(since).Seconds())
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// DefaultOperationTimeout is how long a long running Azure operation may run before it is abandoned.
+const DefaultOperationTimeout = time.Hour
+
+// operationPollInterval is how often a pending long running Azure operation is polled.
+const operationPollInterval = 15 * time.Second
+
+// OperationTimeoutError is returned when a long running Azure operation has not finished within the timeout.
+type OperationTimeoutError struct {
+	Operation azurev1alpha1.Operation
+	Timeout   time.Duration
+}
+
+func (e *OperationTimeoutError) Error() string {
+	return fmt.Sprintf("%s operation started at %s did not finish within %s", e.Operation.Method, e.Operation.StartTime.UTC().Format(time.RFC3339), e.Timeout)
+}
+
+// IsOperationTimeout returns true if the error is an OperationTimeoutError.
+func IsOperationTimeout(err error) bool {
+	_, ok := err.(*OperationTimeoutError)
+	return ok
+}
+
+// pendingOperation returns the long running operation obj waits on, or nil.
+func pendingOperation(obj runtime.Object) *azurev1alpha1.Operation {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return nil
+	}
+	return local.GetResourceStatus().Operation
+}
+
+// operationMessage describes what obj is waiting on for the Reconciling condition.
+// It only changes when another operation starts, so polling does not rewrite the status.
+func operationMessage(obj runtime.Object) string {
+	operation := pendingOperation(obj)
+	if operation == nil {
+		return "Waiting for resource to finish provisioning"
+	}
+	return fmt.Sprintf("Waiting for %s operation started at %s", operation.Method, operation.StartTime.UTC().Format(time.RFC3339))
+}
+
+// expireOperation abandons the operation of obj once it has run longer than timeout, so the next pass starts over.
+// It returns an OperationTimeoutError for abandoned operations. A zero timeout never expires operations.
+func expireOperation(obj runtime.Object, timeout time.Duration, now time.Time) error {
+	operation := pendingOperation(obj)
+	if operation == nil || timeout <= 0 || now.Sub(operation.StartTime.Time) < timeout {
+		return nil
+	}
+	obj.(azurev1alpha1.StatusAccessor).GetResourceStatus().Operation = nil
+	return &OperationTimeoutError{Operation: *operation, Timeout: timeout}
+}
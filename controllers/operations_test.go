@@ -0,0 +1,51 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+var operationsNow = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// pendingGroup returns a resource group waiting on an operation started age ago.
+func pendingGroup(age time.Duration) *azurev1alpha1.ResourceGroup {
+	group := &azurev1alpha1.ResourceGroup{}
+	group.Status.Operation = &azurev1alpha1.Operation{
+		Method:     "PUT",
+		PollingURL: "https://management.azure.com/operation",
+		StartTime:  metav1.NewTime(operationsNow.Add(-age)),
+	}
+	return group
+}
+
+func TestOperationMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(operationMessage(pendingGroup(5 * time.Minute))).To(Equal("Waiting for PUT operation started at 2019-10-01T11:55:00Z"))
+	g.Expect(operationMessage(&azurev1alpha1.ResourceGroup{})).To(Equal("Waiting for resource to finish provisioning"))
+}
+
+func TestExpireOperationWithinTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := pendingGroup(30 * time.Minute)
+	g.Expect(expireOperation(group, time.Hour, operationsNow)).To(Succeed())
+	g.Expect(group.Status.Operation).ToNot(BeNil())
+	g.Expect(expireOperation(pendingGroup(48*time.Hour), 0, operationsNow)).To(Succeed())
+}
+
+func TestExpireOperationPastTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := pendingGroup(2 * time.Hour)
+	err := expireOperation(group, time.Hour, operationsNow)
+	g.Expect(IsOperationTimeout(err)).To(BeTrue())
+	g.Expect(failureReason(err)).To(Equal(azurev1alpha1.ReasonOperationTimedOut))
+	g.Expect(group.Status.Operation).To(BeNil())
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// IsPaused returns true if the object carries the pause annotation.
+// A paused object which is being deleted is no longer considered paused once the force delete annotation is set.
+func IsPaused(obj metav1.Object) bool {
+	annotations := obj.GetAnnotations()
+	if annotations[azurev1alpha1.PausedAnnotation] != "true" {
+		return false
+	}
+	if !obj.GetDeletionTimestamp().IsZero() && annotations[azurev1alpha1.ForceDeleteAnnotation] == "true" {
+		return false
+	}
+	return true
+}
+
+// MarkPaused records whether reconciliation is paused on objects which support conditions.
+// It returns true if the paused state changed, so callers only emit events on transitions.
+func MarkPaused(obj runtime.Object, paused bool) bool {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return false
+	}
+	wasPaused := local.GetResourceStatus().Conditions.IsTrue(azurev1alpha1.ConditionPaused)
+	if paused {
+		setConditions(obj, condition(azurev1alpha1.ConditionPaused, corev1.ConditionTrue, azurev1alpha1.ReasonPaused, "Reconciliation is paused by annotation "+azurev1alpha1.PausedAnnotation))
+	} else {
+		setConditions(obj, condition(azurev1alpha1.ConditionPaused, corev1.ConditionFalse, azurev1alpha1.ReasonResumed, ""))
+	}
+	return wasPaused != paused
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// pausedGroup returns a resource group carrying the pause annotation.
+func pausedGroup() *azurev1alpha1.ResourceGroup {
+	return &azurev1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "group",
+			Namespace:   "default",
+			Annotations: map[string]string{azurev1alpha1.PausedAnnotation: "true"},
+		},
+	}
+}
+
+func TestIsPaused(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := pausedGroup()
+	g.Expect(IsPaused(group)).To(BeTrue())
+	group.Annotations[azurev1alpha1.PausedAnnotation] = "false"
+	g.Expect(IsPaused(group)).To(BeFalse())
+}
+
+func TestIsPausedBlocksDeletionUntilForced(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := pausedGroup()
+	now := metav1.Now()
+	group.DeletionTimestamp = &now
+	g.Expect(IsPaused(group)).To(BeTrue())
+	group.Annotations[azurev1alpha1.ForceDeleteAnnotation] = "true"
+	g.Expect(IsPaused(group)).To(BeFalse())
+}
+
+func TestMarkPaused(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group := pausedGroup()
+	g.Expect(MarkPaused(group, true)).To(BeTrue())
+	g.Expect(MarkPaused(group, true)).To(BeFalse())
+	g.Expect(group.Status.Conditions.IsTrue(azurev1alpha1.ConditionPaused)).To(BeTrue())
+	g.Expect(MarkPaused(group, false)).To(BeTrue())
+	g.Expect(group.Status.Conditions.IsTrue(azurev1alpha1.ConditionPaused)).To(BeFalse())
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=providerconfigs;clusterproviderconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// SelectedProviderConfig is the provider config an object selects, directly or through its namespace.
+type SelectedProviderConfig struct {
+	// Source names the provider config, e.g. "ProviderConfig team-a/default" or "ClusterProviderConfig prod".
+	Source string
+	// Spec is the spec of the provider config, with the namespace of the credentials Secret filled in.
+	Spec azurev1alpha1.ProviderConfigSpec
+	// ResourceVersion is the resource version of the provider config.
+	ResourceVersion string
+}
+
+// InvalidProviderConfigError is returned when an object selects a provider config which it may not use,
+// or which is incomplete.
+type InvalidProviderConfigError struct {
+	Source string
+	Reason string
+}
+
+func (e *InvalidProviderConfigError) Error() string {
+	return fmt.Sprintf("%s %s", e.Source, e.Reason)
+}
+
+// IsInvalidProviderConfig returns true if the error is an InvalidProviderConfigError.
+func IsInvalidProviderConfig(err error) bool {
+	_, ok := err.(*InvalidProviderConfigError)
+	return ok
+}
+
+// SelectProviderConfig returns the provider config selected by obj, or nil when it selects none.
+// The annotations on the object take precedence over the annotations on its namespace.
+func SelectProviderConfig(ctx context.Context, kubeclient client.Client, obj metav1.Object) (*SelectedProviderConfig, error) {
+	annotations := obj.GetAnnotations()
+	if !selectsProviderConfig(annotations) && obj.GetNamespace() != "" {
+		var namespace corev1.Namespace
+		if err := kubeclient.Get(ctx, types.NamespacedName{Name: obj.GetNamespace()}, &namespace); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		annotations = namespace.GetAnnotations()
+	}
+
+	if name := annotations[azurev1alpha1.ProviderConfigAnnotation]; name != "" {
+		var provider azurev1alpha1.ProviderConfig
+		if err := kubeclient.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}, &provider); err != nil {
+			return nil, err
+		}
+		// A namespaced provider config may only use credentials from its own namespace,
+		// otherwise any namespace could borrow the identity of another.
+		spec := provider.Spec
+		if spec.CredentialsSecretRef.Namespace == "" {
+			spec.CredentialsSecretRef.Namespace = provider.Namespace
+		}
+		source := fmt.Sprintf("ProviderConfig %s/%s", provider.Namespace, provider.Name)
+		if spec.CredentialsSecretRef.Namespace != provider.Namespace {
+			return nil, &InvalidProviderConfigError{Source: source, Reason: "must reference a credentials secret in its own namespace"}
+		}
+		return &SelectedProviderConfig{
+			Source:          source,
+			Spec:            spec,
+			ResourceVersion: provider.ResourceVersion,
+		}, nil
+	}
+
+	if name := annotations[azurev1alpha1.ClusterProviderConfigAnnotation]; name != "" {
+		var provider azurev1alpha1.ClusterProviderConfig
+		if err := kubeclient.Get(ctx, types.NamespacedName{Name: name}, &provider); err != nil {
+			return nil, err
+		}
+		source := fmt.Sprintf("ClusterProviderConfig %s", provider.Name)
+		if !provider.Spec.Allows(obj.GetNamespace()) {
+			return nil, &InvalidProviderConfigError{Source: source, Reason: fmt.Sprintf("does not allow objects in namespace %s", obj.GetNamespace())}
+		}
+		if provider.Spec.CredentialsSecretRef.Namespace == "" {
+			return nil, &InvalidProviderConfigError{Source: source, Reason: "must set the namespace of its credentials secret"}
+		}
+		return &SelectedProviderConfig{
+			Source:          source,
+			Spec:            provider.Spec.ProviderConfigSpec,
+			ResourceVersion: provider.ResourceVersion,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func selectsProviderConfig(annotations map[string]string) bool {
+	return annotations[azurev1alpha1.ProviderConfigAnnotation] != "" || annotations[azurev1alpha1.ClusterProviderConfigAnnotation] != ""
+}
+
+// Provider is the Azure configuration shared by every object selecting the same provider config.
+type Provider struct {
+	// Name identifies the provider config, e.g. "ProviderConfig team-a/default". It is empty for the default configuration.
+	Name string
+	// Version changes whenever the provider config or its credentials secret change.
+	Version string
+	// Config authenticates with the credentials of the provider config.
+	Config *config.Config
+}
+
+// DefaultCredentialsRefresh is how long the credentials secret of a provider config is trusted before it is read again.
+const DefaultCredentialsRefresh = time.Minute
+
+// Providers builds the Azure configuration of each provider config from its credentials secret.
+// Configurations are cached until the provider config or the secret change, so clients share tokens.
+type Providers struct {
+	Client client.Client
+	// Default is the configuration of objects selecting no provider config, i.e. the credentials of the manager.
+	Default *config.Config
+	// Options are applied to the configuration of every provider config, e.g. the cluster ID and rate limiter.
+	Options []config.Option
+	// CredentialsRefresh bounds how long rotated credentials go unnoticed. Defaults to DefaultCredentialsRefresh.
+	CredentialsRefresh time.Duration
+
+	mu        sync.Mutex
+	providers map[string]cachedProvider
+}
+
+// cachedProvider is a Provider along with the provider config it was built for and when its secret was last read.
+type cachedProvider struct {
+	Provider
+	configVersion string
+	readAt        time.Time
+}
+
+// For returns the Azure configuration for the provider config selected by obj.
+func (p *Providers) For(ctx context.Context, obj runtime.Object) (Provider, error) {
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return Provider{}, err
+	}
+	selected, err := SelectProviderConfig(ctx, p.Client, res)
+	if err != nil {
+		return Provider{}, err
+	}
+	if selected == nil {
+		return Provider{Config: p.Default}, nil
+	}
+
+	refresh := p.CredentialsRefresh
+	if refresh == 0 {
+		refresh = DefaultCredentialsRefresh
+	}
+	p.mu.Lock()
+	cached, ok := p.providers[selected.Source]
+	p.mu.Unlock()
+	// The secret is only read again once the provider config changes or the cached credentials are due for a refresh.
+	if ok && cached.configVersion == selected.ResourceVersion && time.Since(cached.readAt) < refresh {
+		return cached.Provider, nil
+	}
+
+	ref := selected.Spec.CredentialsSecretRef
+	var secret corev1.Secret
+	readAt := time.Now()
+	if err := p.Client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &secret); err != nil {
+		return Provider{}, err
+	}
+	version := selected.ResourceVersion + "/" + secret.ResourceVersion
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.providers == nil {
+		p.providers = map[string]cachedProvider{}
+	}
+	if cached, ok := p.providers[selected.Source]; ok && cached.Version == version {
+		cached.readAt = readAt
+		p.providers[selected.Source] = cached
+		return cached.Provider, nil
+	}
+	credentials, err := credentialOptions(&secret)
+	if err != nil {
+		return Provider{}, fmt.Errorf("invalid credentials for %s: %v", selected.Source, err)
+	}
+	configuration, err := config.New(append(append([]config.Option{}, p.Options...), credentials...)...)
+	if err != nil {
+		return Provider{}, err
+	}
+	provider := Provider{Name: selected.Source, Version: version, Config: configuration}
+	p.providers[selected.Source] = cachedProvider{Provider: provider, configVersion: selected.ResourceVersion, readAt: readAt}
+	return provider, nil
+}
+
+// credentialOptions reads the service principal credentials from a provider config secret.
+func credentialOptions(secret *corev1.Secret) ([]config.Option, error) {
+	clientID := string(secret.Data[azurev1alpha1.CredentialsClientIDKey])
+	tenantID := string(secret.Data[azurev1alpha1.CredentialsTenantIDKey])
+	if clientID == "" || tenantID == "" {
+		return nil, fmt.Errorf("secret %s/%s must contain %s and %s", secret.Namespace, secret.Name, azurev1alpha1.CredentialsClientIDKey, azurev1alpha1.CredentialsTenantIDKey)
+	}
+	opts := []config.Option{config.App(clientID), config.Tenant(tenantID)}
+	if certificate := secret.Data[azurev1alpha1.CredentialsClientCertificateKey]; len(certificate) > 0 {
+		password := string(secret.Data[azurev1alpha1.CredentialsClientCertificatePasswordKey])
+		return append(opts, config.Certificate(certificate, password)), nil
+	}
+	if key := string(secret.Data[azurev1alpha1.CredentialsClientSecretKey]); key != "" {
+		return append(opts, config.Key(key)), nil
+	}
+	return nil, fmt.Errorf("secret %s/%s must contain %s or %s", secret.Namespace, secret.Name, azurev1alpha1.CredentialsClientSecretKey, azurev1alpha1.CredentialsClientCertificateKey)
+}
+
+// ProviderClients holds the Azure client of one kind for each provider config, constructed on first use.
+type ProviderClients struct {
+	providers *Providers
+	create    func(*config.Config) (interface{}, error)
+
+	mu      sync.Mutex
+	clients map[string]providerClient
+}
+
+// providerClient is the Azure client built for one version of a provider config.
+type providerClient struct {
+	version string
+	client  interface{}
+}
+
+// NewProviderClients returns the clients of a kind for each provider config.
+// Objects selecting no provider config use defaultClient, and create builds the client for any other configuration.
+func NewProviderClients(providers *Providers, defaultClient interface{}, create func(*config.Config) (interface{}, error)) *ProviderClients {
+	return &ProviderClients{
+		providers: providers,
+		create:    create,
+		clients:   map[string]providerClient{"": {client: defaultClient}},
+	}
+}
+
+// For returns the Azure client for the provider config selected by obj.
+func (c *ProviderClients) For(ctx context.Context, obj runtime.Object) (interface{}, error) {
+	provider, err := c.providers.For(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.clients[provider.Name]; ok && cached.version == provider.Version {
+		return cached.client, nil
+	}
+	az, err := c.create(provider.Config)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[provider.Name] = providerClient{version: provider.Version, client: az}
+	return az, nil
+}
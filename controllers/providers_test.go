@@ -0,0 +1,203 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+func providerNamespace(annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team", Annotations: annotations}}
+}
+
+func providerCredentials(namespace, name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Data: map[string][]byte{
+			azurev1alpha1.CredentialsClientIDKey:     []byte("app"),
+			azurev1alpha1.CredentialsClientSecretKey: []byte("key"),
+			azurev1alpha1.CredentialsTenantIDKey:     []byte("tenant"),
+		},
+	}
+}
+
+func newProviderConfig(name string, ref corev1.SecretReference) *azurev1alpha1.ProviderConfig {
+	return &azurev1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: name},
+		Spec:       azurev1alpha1.ProviderConfigSpec{CredentialsSecretRef: ref, SubscriptionID: name + "-sub", Location: "westus2"},
+	}
+}
+
+func newClusterProviderConfig(name string, ref corev1.SecretReference) *azurev1alpha1.ClusterProviderConfig {
+	return &azurev1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: azurev1alpha1.ClusterProviderConfigSpec{
+			ProviderConfigSpec: azurev1alpha1.ProviderConfigSpec{CredentialsSecretRef: ref, SubscriptionID: name + "-sub"},
+			AllowedNamespaces:  []string{"team"},
+		},
+	}
+}
+
+func providerGroup(annotations map[string]string) *azurev1alpha1.ResourceGroup {
+	return &azurev1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "group", Annotations: annotations},
+		Spec:       azurev1alpha1.ResourceGroupSpec{Name: "group"},
+	}
+}
+
+func TestSelectProviderConfigWithoutAnnotations(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, providerNamespace(nil))
+	selected, err := SelectProviderConfig(context.Background(), kubeclient, providerGroup(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selected).To(BeNil())
+}
+
+func TestSelectProviderConfigPrefersObjectAnnotation(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g,
+		providerNamespace(map[string]string{azurev1alpha1.ProviderConfigAnnotation: "fallback"}),
+		newProviderConfig("fallback", corev1.SecretReference{Name: "creds"}),
+		newProviderConfig("own", corev1.SecretReference{Name: "creds"}),
+	)
+	selected, err := SelectProviderConfig(context.Background(), kubeclient, providerGroup(map[string]string{azurev1alpha1.ProviderConfigAnnotation: "own"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selected.Source).To(Equal("ProviderConfig team/own"))
+	g.Expect(selected.Spec.CredentialsSecretRef.Namespace).To(Equal("team"))
+
+	selected, err = SelectProviderConfig(context.Background(), kubeclient, providerGroup(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selected.Source).To(Equal("ProviderConfig team/fallback"))
+}
+
+func TestSelectClusterProviderConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g,
+		providerNamespace(map[string]string{azurev1alpha1.ClusterProviderConfigAnnotation: "prod"}),
+		newClusterProviderConfig("prod", corev1.SecretReference{Namespace: "system", Name: "creds"}),
+	)
+	selected, err := SelectProviderConfig(context.Background(), kubeclient, providerGroup(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selected.Source).To(Equal("ClusterProviderConfig prod"))
+	g.Expect(selected.Spec.SubscriptionID).To(Equal("prod-sub"))
+}
+
+func TestSelectProviderConfigRejectsBorrowedCredentials(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g,
+		providerNamespace(nil),
+		newProviderConfig("borrowed", corev1.SecretReference{Namespace: "other", Name: "creds"}),
+		newClusterProviderConfig("unscoped", corev1.SecretReference{Name: "creds"}),
+	)
+	_, err := SelectProviderConfig(context.Background(), kubeclient, providerGroup(map[string]string{azurev1alpha1.ProviderConfigAnnotation: "borrowed"}))
+	g.Expect(IsInvalidProviderConfig(err)).To(BeTrue())
+	_, err = SelectProviderConfig(context.Background(), kubeclient, providerGroup(map[string]string{azurev1alpha1.ClusterProviderConfigAnnotation: "unscoped"}))
+	g.Expect(IsInvalidProviderConfig(err)).To(BeTrue())
+}
+
+func TestSelectClusterProviderConfigAllowedNamespaces(t *testing.T) {
+	g := NewGomegaWithT(t)
+	restricted := newClusterProviderConfig("restricted", corev1.SecretReference{Namespace: "system", Name: "creds"})
+	restricted.Spec.AllowedNamespaces = []string{"other"}
+	everywhere := newClusterProviderConfig("everywhere", corev1.SecretReference{Namespace: "system", Name: "creds"})
+	everywhere.Spec.AllowedNamespaces = []string{azurev1alpha1.AllNamespaces}
+	unlisted := newClusterProviderConfig("unlisted", corev1.SecretReference{Namespace: "system", Name: "creds"})
+	unlisted.Spec.AllowedNamespaces = nil
+	kubeclient := newFakeClient(g, providerNamespace(nil), restricted, everywhere, unlisted)
+
+	_, err := SelectProviderConfig(context.Background(), kubeclient, providerGroup(map[string]string{azurev1alpha1.ClusterProviderConfigAnnotation: "restricted"}))
+	g.Expect(err).To(MatchError(ContainSubstring("does not allow objects in namespace team")))
+	_, err = SelectProviderConfig(context.Background(), kubeclient, providerGroup(map[string]string{azurev1alpha1.ClusterProviderConfigAnnotation: "unlisted"}))
+	g.Expect(err).To(HaveOccurred())
+	selected, err := SelectProviderConfig(context.Background(), kubeclient, providerGroup(map[string]string{azurev1alpha1.ClusterProviderConfigAnnotation: "everywhere"}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selected.Source).To(Equal("ClusterProviderConfig everywhere"))
+}
+
+func TestSelectProviderConfigMissing(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, providerNamespace(nil))
+	_, err := SelectProviderConfig(context.Background(), kubeclient, providerGroup(map[string]string{azurev1alpha1.ProviderConfigAnnotation: "missing"}))
+	g.Expect(apierrs.IsNotFound(err)).To(BeTrue())
+}
+
+func TestProvidersCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+	// The fake client does not bump resource versions like the API server does.
+	secret := providerCredentials("team", "creds")
+	secret.ResourceVersion = "1"
+	kubeclient := newFakeClient(g, providerNamespace(nil), secret, newProviderConfig("own", corev1.SecretReference{Name: "creds"}))
+	defaultConfig := &config.Config{}
+	providers := &Providers{Client: kubeclient, Default: defaultConfig}
+	obj := providerGroup(map[string]string{azurev1alpha1.ProviderConfigAnnotation: "own"})
+
+	provider, err := providers.For(context.Background(), providerGroup(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(provider.Name).To(BeEmpty())
+	g.Expect(provider.Config).To(BeIdenticalTo(defaultConfig))
+
+	first, err := providers.For(context.Background(), obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(first.Name).To(Equal("ProviderConfig team/own"))
+	g.Expect(first.Config).ToNot(BeIdenticalTo(defaultConfig))
+	second, err := providers.For(context.Background(), obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(second.Config).To(BeIdenticalTo(first.Config))
+
+	secret.Data[azurev1alpha1.CredentialsClientSecretKey] = []byte("rotated")
+	secret.ResourceVersion = "2"
+	g.Expect(kubeclient.Update(context.Background(), secret)).To(Succeed())
+	stale, err := providers.For(context.Background(), obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(stale.Config).To(BeIdenticalTo(first.Config))
+
+	providers.CredentialsRefresh = time.Nanosecond
+	rotated, err := providers.For(context.Background(), obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rotated.Version).ToNot(Equal(first.Version))
+	g.Expect(rotated.Config).ToNot(BeIdenticalTo(first.Config))
+}
+
+func TestProvidersRequireClientCredentials(t *testing.T) {
+	g := NewGomegaWithT(t)
+	secret := providerCredentials("team", "creds")
+	delete(secret.Data, azurev1alpha1.CredentialsClientSecretKey)
+	kubeclient := newFakeClient(g, providerNamespace(nil), secret, newProviderConfig("own", corev1.SecretReference{Name: "creds"}))
+	providers := &Providers{Client: kubeclient}
+	_, err := providers.For(context.Background(), providerGroup(map[string]string{azurev1alpha1.ProviderConfigAnnotation: "own"}))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestProviderClients(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kubeclient := newFakeClient(g, providerNamespace(nil), providerCredentials("team", "creds"), newProviderConfig("own", corev1.SecretReference{Name: "creds"}))
+	built := 0
+	clients := NewProviderClients(&Providers{Client: kubeclient}, "default", func(*config.Config) (interface{}, error) {
+		built++
+		return "own", nil
+	})
+
+	az, err := clients.For(context.Background(), providerGroup(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(az).To(Equal("default"))
+
+	obj := providerGroup(map[string]string{azurev1alpha1.ProviderConfigAnnotation: "own"})
+	for i := 0; i < 2; i++ {
+		az, err = clients.For(context.Background(), obj)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(az).To(Equal("own"))
+	}
+	g.Expect(built).To(Equal(1))
+}
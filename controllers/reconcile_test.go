@@ -0,0 +1,154 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azfake"
+)
+
+// These tests run whole reconciles of registered kinds against the azfake emulator and a fake Kubernetes client,
+// so unlike the envtest suite they need neither a control plane nor Azure credentials.
+
+const (
+	reconcileSubscription = "00000000-0000-0000-0000-000000000000"
+	reconcileGroupID      = "/subscriptions/" + reconcileSubscription + "/resourceGroups/group"
+)
+
+// reconcileAttempts bounds how many reconciles an object may take to become ready or be deleted.
+const reconcileAttempts = 10
+
+// newKindReconciler registers the emulator and a fake Kubernetes client holding objs with the reconciler of kind.
+func newKindReconciler(g *GomegaWithT, server *azfake.Server, kind string, objs ...runtime.Object) (*KindReconciler, client.Client) {
+	scheme := newTestScheme(g)
+	kubeclient := fake.NewFakeClientWithScheme(scheme, objs...)
+
+	registration, ok := KindFor(azurev1alpha1.GroupVersion.WithKind(kind))
+	g.Expect(ok).To(BeTrue())
+	configuration, err := server.Config()
+	g.Expect(err).ToNot(HaveOccurred())
+	reconciler, err := registration.NewReconciler(configuration, kubeclient, Options{
+		Log:      logf.Log,
+		Recorder: record.NewFakeRecorder(100),
+		Scheme:   scheme,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	return reconciler, kubeclient
+}
+
+// reconcileUntil reconciles the object named key, fetching it into obj after each pass, until done returns true.
+func reconcileUntil(g *GomegaWithT, reconciler *KindReconciler, kubeclient client.Client, key types.NamespacedName, obj runtime.Object, done func() bool) {
+	for i := 0; i < reconcileAttempts; i++ {
+		_, err := reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+		g.Expect(err).ToNot(HaveOccurred())
+		// The fake client decodes into obj, which would keep fields the reconcile dropped, e.g. removed finalizers.
+		value := reflect.ValueOf(obj).Elem()
+		value.Set(reflect.Zero(value.Type()))
+		g.Expect(kubeclient.Get(context.Background(), key, obj)).To(Succeed())
+		if done() {
+			return
+		}
+	}
+	g.Expect(done()).To(BeTrue(), "%s not done after %d reconciles", key, reconcileAttempts)
+}
+
+// markDeleted sets the deletion timestamp on obj, since the fake client removes objects at once instead.
+func markDeleted(g *GomegaWithT, kubeclient client.Client, obj runtime.Object, res metav1.Object) {
+	now := metav1.NewTime(time.Now())
+	res.SetDeletionTimestamp(&now)
+	g.Expect(kubeclient.Update(context.Background(), obj)).To(Succeed())
+}
+
+func TestAsyncReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+	server := azfake.NewServer()
+	defer server.Close()
+
+	key := types.NamespacedName{Namespace: "default", Name: "group"}
+	reconciler, kubeclient := newKindReconciler(g, server, "ResourceGroup", &azurev1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name, Generation: 1},
+		Spec: azurev1alpha1.ResourceGroupSpec{
+			Name:           "group",
+			Location:       "westus2",
+			SubscriptionID: reconcileSubscription,
+		},
+	})
+
+	local := &azurev1alpha1.ResourceGroup{}
+	reconcileUntil(g, reconciler, kubeclient, key, local, func() bool {
+		return local.Status.Conditions.IsTrue(azurev1alpha1.ConditionReady)
+	})
+	g.Expect(local.Finalizers).To(ContainElement(azurev1alpha1.Finalizer))
+	g.Expect(local.Status.ID).To(Equal(to.StringPtr(reconcileGroupID)))
+	g.Expect(local.Status.Conditions.Get(azurev1alpha1.ConditionOwnershipConflict).Status).To(Equal(corev1.ConditionFalse))
+	var remote resources.Group
+	found, err := server.Resource(reconcileGroupID, &remote)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+
+	markDeleted(g, kubeclient, local, local)
+	reconcileUntil(g, reconciler, kubeclient, key, local, func() bool {
+		return len(local.Finalizers) == 0
+	})
+	found, err = server.Resource(reconcileGroupID, &remote)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+}
+
+func TestSyncReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+	server := azfake.NewServer()
+	defer server.Close()
+	g.Expect(server.Seed(reconcileGroupID, resources.Group{Location: to.StringPtr("westus2")})).To(Succeed())
+
+	vaultID := reconcileGroupID + "/providers/Microsoft.KeyVault/vaults/vault"
+	key := types.NamespacedName{Namespace: "default", Name: "vault"}
+	reconciler, kubeclient := newKindReconciler(g, server, "Keyvault", &azurev1alpha1.Keyvault{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name, Generation: 1},
+		Spec: azurev1alpha1.KeyvaultSpec{
+			Name:           "vault",
+			Location:       "westus2",
+			ResourceGroup:  "group",
+			SubscriptionID: reconcileSubscription,
+			TenantID:       "11111111-1111-1111-1111-111111111111",
+		},
+	})
+
+	local := &azurev1alpha1.Keyvault{}
+	reconcileUntil(g, reconciler, kubeclient, key, local, func() bool {
+		return local.Status.Conditions.IsTrue(azurev1alpha1.ConditionReady)
+	})
+	g.Expect(local.Finalizers).To(ContainElement(azurev1alpha1.Finalizer))
+	remote := map[string]interface{}{}
+	found, err := server.Resource(vaultID, &remote)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+
+	markDeleted(g, kubeclient, local, local)
+	reconcileUntil(g, reconciler, kubeclient, key, local, func() bool {
+		return len(local.Finalizers) == 0
+	})
+	found, err = server.Resource(vaultID, &remote)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+}
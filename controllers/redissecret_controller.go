@@ -1,34 +0,0 @@
-/*
-Copyright 2019 Alexander Eldeib.
-*/
-
-package controllers
-
-import (
-	corev1 "k8s.io/api/core/v1"
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-
-	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
-)
-
-// RedisReconciler reconciles a Redis object
-type RedisSecretReconciler struct {
-	Reconciler *AsyncReconciler
-}
-
-// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=redis,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=redis/status,verbs=get;update;patch
-
-// Reconcile reconciles a user request for a Redis cache against Azure.
-func (r *RedisSecretReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	return r.Reconciler.Reconcile(req, &azurev1alpha1.Redis{})
-}
-
-func (r *RedisSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&azurev1alpha1.RedisKey{}).
-		Owns(&corev1.Secret{}).
-		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
-		Complete(r)
-}
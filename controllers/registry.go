@@ -0,0 +1,463 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/dockercfg"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/identities"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/keyvaults"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/loadbalancers"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/nics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/publicips"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/redis"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/rediskeys"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/resourcegroups"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/secretbundles"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/secrets"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/securitygroups"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/servicebus"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/servicebuskey"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlfirewallrules"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlservers"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/storageaccounts"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/storagekeys"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/subnets"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/tlssecrets"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/trafficmanagers"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/virtualnetworks"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/vms"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=dockerconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=dockerconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=identities,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=identities/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=keyvaults,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=keyvaults/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=loadbalancers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=loadbalancers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=networkinterfaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=networkinterfaces/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=publicips,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=publicips/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=redis,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=redis/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=rediskeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=rediskeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=resourcegroups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=resourcegroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=secrets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=secretbundles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=secretbundles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=securitygroups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=securitygroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=servicebus,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=servicebus/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=servicebuskeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=servicebuskeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=sqlfirewallrules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=sqlfirewallrules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=sqlservers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=sqlservers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=storageaccounts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=storageaccounts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=storagekeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=storagekeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=subnets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=subnets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=tlssecrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=tlssecrets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=trafficmanagers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=trafficmanagers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=virtualnetworks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=virtualnetworks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=vms,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=vms/status,verbs=get;update;patch
+
+// Mode describes how the Azure client of a kind completes its operations.
+type Mode string
+
+const (
+	// ModeSync clients finish every operation within a single call.
+	ModeSync Mode = "Sync"
+	// ModeAsync clients start long running operations and report whether they are done on every call.
+	ModeAsync Mode = "Async"
+)
+
+// SyncClientFunc constructs the Azure client of a kind reconciled synchronously.
+type SyncClientFunc func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error)
+
+// AsyncClientFunc constructs the Azure client of a kind reconciled with long running operations.
+type AsyncClientFunc func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (AsyncClient, error)
+
+// Kind describes how objects of one kind are reconciled against Azure.
+// It is shared by the manager and the tinker CLI, so supporting a new kind only requires registering it here.
+type Kind struct {
+	schema.GroupVersionKind
+	// Object is an empty instance of the kind.
+	Object runtime.Object
+	// Owns lists Kubernetes objects the client creates on behalf of the kind, e.g. secrets holding access keys.
+	Owns []runtime.Object
+	// NewSync is set for kinds reconciled synchronously.
+	NewSync SyncClientFunc
+	// NewAsync is set for kinds reconciled with long running operations.
+	NewAsync AsyncClientFunc
+}
+
+// Mode returns whether the kind is reconciled synchronously or with long running operations.
+func (k Kind) Mode() Mode {
+	if k.NewAsync != nil {
+		return ModeAsync
+	}
+	return ModeSync
+}
+
+// Options holds the settings shared by the reconcilers of every kind.
+type Options struct {
+	Log      logr.Logger
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
+	// Resync holds the interval at which ready objects are checked against Azure for drift.
+	Resync ResyncPeriods
+	// DriftPolicy decides whether detected drift is corrected or only reported.
+	DriftPolicy DriftPolicy
+	// MaxConcurrentReconciles is the number of objects of each kind reconciled in parallel.
+	MaxConcurrentReconciles int
+	// Providers, when set, lets objects select the credentials of a provider config instead of the manager configuration.
+	Providers *Providers
+	// Shard selects the objects reconciled by this manager.
+	Shard Shard
+	// OperationTimeout is how long long running Azure operations are polled before they are abandoned.
+	OperationTimeout time.Duration
+}
+
+// NewReconciler constructs the Azure client of the kind and returns a reconciler for it.
+func (k Kind) NewReconciler(configuration *config.Config, kubeclient client.Client, opts Options) (*KindReconciler, error) {
+	reconciler := &KindReconciler{
+		Object: k.Object,
+		Owns:   k.Owns,
+		Shard:  opts.Shard,
+	}
+	resync := opts.Resync.For(k.Kind)
+	if k.Mode() == ModeAsync {
+		az, err := k.NewAsync(configuration, &kubeclient, opts.Scheme)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.checkDriftPolicy(opts.DriftPolicy, az); err != nil {
+			return nil, err
+		}
+		reconciler.Async = &AsyncReconciler{
+			Client:                  kubeclient,
+			Az:                      az,
+			Providers:               k.providerClients(opts, az, kubeclient),
+			Log:                     opts.Log,
+			Recorder:                opts.Recorder,
+			Scheme:                  opts.Scheme,
+			ResyncPeriod:            resync,
+			DriftPolicy:             opts.DriftPolicy,
+			MaxConcurrentReconciles: opts.MaxConcurrentReconciles,
+			OperationTimeout:        opts.OperationTimeout,
+		}
+		return reconciler, nil
+	}
+	az, err := k.NewSync(configuration, &kubeclient, opts.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkDriftPolicy(opts.DriftPolicy, az); err != nil {
+		return nil, err
+	}
+	reconciler.Sync = &SyncReconciler{
+		Client:                  kubeclient,
+		Az:                      az,
+		Providers:               k.providerClients(opts, az, kubeclient),
+		Log:                     opts.Log,
+		Recorder:                opts.Recorder,
+		Scheme:                  opts.Scheme,
+		ResyncPeriod:            resync,
+		DriftPolicy:             opts.DriftPolicy,
+		MaxConcurrentReconciles: opts.MaxConcurrentReconciles,
+	}
+	return reconciler, nil
+}
+
+// checkDriftPolicy rejects the report policy for a client which cannot observe its kind,
+// since it would otherwise keep reapplying the spec instead of only reporting drift.
+func (k Kind) checkDriftPolicy(policy DriftPolicy, az interface{}) error {
+	if _, ok := az.(Observer); !ok && policy == DriftPolicyReport {
+		return fmt.Errorf("drift policy %q requires the client of %s to implement Observe", policy, k.Kind)
+	}
+	return nil
+}
+
+// providerClients constructs the client of the kind for each provider config, or returns nil when they are disabled.
+func (k Kind) providerClients(opts Options, defaultClient interface{}, kubeclient client.Client) *ProviderClients {
+	if opts.Providers == nil {
+		return nil
+	}
+	return NewProviderClients(opts.Providers, defaultClient, func(configuration *config.Config) (interface{}, error) {
+		if k.Mode() == ModeAsync {
+			return k.NewAsync(configuration, &kubeclient, opts.Scheme)
+		}
+		return k.NewSync(configuration, &kubeclient, opts.Scheme)
+	})
+}
+
+// SetupWebhookWithManager serves the admission webhooks implemented by the API type of the kind, if any,
+// and the conversion webhook shared by every kind served in more than one version.
+func (k Kind) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(k.Object).Complete()
+}
+
+// Kinds returns every supported kind ordered by name.
+func Kinds() []Kind {
+	return append([]Kind{}, kinds...)
+}
+
+// KindFor returns the registration of a kind, reporting whether the kind is supported.
+func KindFor(gvk schema.GroupVersionKind) (Kind, bool) {
+	for _, kind := range kinds {
+		if kind.GroupVersionKind == gvk {
+			return kind, true
+		}
+	}
+	return Kind{}, false
+}
+
+var secretOwner = []runtime.Object{&corev1.Secret{}}
+
+// kinds is the single registry of supported kinds, kept sorted by name.
+var kinds = []Kind{
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("DockerConfig"),
+		Object:           &azurev1alpha1.DockerConfig{},
+		Owns:             secretOwner,
+		NewSync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error) {
+			az, err := dockercfg.New(configuration, kubeclient, scheme)
+			if err != nil {
+				return nil, err
+			}
+			return az, nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("Identity"),
+		Object:           &azurev1alpha1.Identity{},
+		NewSync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (SyncClient, error) {
+			return identities.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("Keyvault"),
+		Object:           &azurev1alpha1.Keyvault{},
+		NewSync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (SyncClient, error) {
+			return keyvaults.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("LoadBalancer"),
+		Object:           &azurev1alpha1.LoadBalancer{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return loadbalancers.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("NetworkInterface"),
+		Object:           &azurev1alpha1.NetworkInterface{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return nics.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("PublicIP"),
+		Object:           &azurev1alpha1.PublicIP{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return publicips.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("Redis"),
+		Object:           &azurev1alpha1.Redis{},
+		Owns:             secretOwner,
+		NewAsync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (AsyncClient, error) {
+			return redis.New(configuration, kubeclient, scheme), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("RedisKey"),
+		Object:           &azurev1alpha1.RedisKey{},
+		Owns:             secretOwner,
+		NewSync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error) {
+			return rediskeys.New(configuration, kubeclient, scheme), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("ResourceGroup"),
+		Object:           &azurev1alpha1.ResourceGroup{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return resourcegroups.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("SQLFirewallRule"),
+		Object:           &azurev1alpha1.SQLFirewallRule{},
+		NewSync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (SyncClient, error) {
+			return sqlfirewallrules.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("SQLServer"),
+		Object:           &azurev1alpha1.SQLServer{},
+		Owns:             secretOwner,
+		NewAsync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (AsyncClient, error) {
+			return sqlservers.New(configuration, kubeclient, scheme), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("Secret"),
+		Object:           &azurev1alpha1.Secret{},
+		Owns:             secretOwner,
+		NewSync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error) {
+			az, err := secrets.New(configuration, kubeclient, scheme)
+			if err != nil {
+				return nil, err
+			}
+			return az, nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("SecretBundle"),
+		Object:           &azurev1alpha1.SecretBundle{},
+		Owns:             secretOwner,
+		NewSync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error) {
+			az, err := secretbundles.New(configuration, kubeclient, scheme)
+			if err != nil {
+				return nil, err
+			}
+			return az, nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("SecurityGroup"),
+		Object:           &azurev1alpha1.SecurityGroup{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return securitygroups.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("ServiceBusKey"),
+		Object:           &azurev1alpha1.ServiceBusKey{},
+		Owns:             secretOwner,
+		NewSync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error) {
+			return servicebuskey.New(configuration, kubeclient, scheme), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("ServiceBusNamespace"),
+		Object:           &azurev1alpha1.ServiceBusNamespace{},
+		Owns:             secretOwner,
+		NewAsync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (AsyncClient, error) {
+			return servicebus.New(configuration, kubeclient, scheme), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("StorageAccount"),
+		Object:           &azurev1alpha1.StorageAccount{},
+		Owns:             secretOwner,
+		NewAsync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (AsyncClient, error) {
+			return storageaccounts.New(configuration, kubeclient, scheme), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("StorageKey"),
+		Object:           &azurev1alpha1.StorageKey{},
+		Owns:             secretOwner,
+		NewSync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error) {
+			return storagekeys.New(configuration, kubeclient, scheme), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("Subnet"),
+		Object:           &azurev1alpha1.Subnet{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return subnets.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("TLSSecret"),
+		Object:           &azurev1alpha1.TLSSecret{},
+		Owns:             secretOwner,
+		NewSync: func(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) (SyncClient, error) {
+			az, err := tlssecrets.New(configuration, kubeclient, scheme)
+			if err != nil {
+				return nil, err
+			}
+			return az, nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("TrafficManager"),
+		Object:           &azurev1alpha1.TrafficManager{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return trafficmanagers.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("VirtualNetwork"),
+		Object:           &azurev1alpha1.VirtualNetwork{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return virtualnetworks.New(configuration), nil
+		},
+	},
+	{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("VM"),
+		Object:           &azurev1alpha1.VM{},
+		NewAsync: func(configuration *config.Config, _ *client.Client, _ *runtime.Scheme) (AsyncClient, error) {
+			return vms.New(configuration), nil
+		},
+	},
+}
+
+// The client of every kind observes it, so unchanged objects are checked for drift without writing to Azure.
+var (
+	_ Observer = &dockercfg.Client{}
+	_ Observer = &identities.Client{}
+	_ Observer = &keyvaults.Client{}
+	_ Observer = &loadbalancers.Client{}
+	_ Observer = &nics.Client{}
+	_ Observer = &publicips.Client{}
+	_ Observer = &redis.Client{}
+	_ Observer = &rediskeys.Client{}
+	_ Observer = &resourcegroups.Client{}
+	_ Observer = &secretbundles.Client{}
+	_ Observer = &secrets.Client{}
+	_ Observer = &securitygroups.Client{}
+	_ Observer = &servicebus.Client{}
+	_ Observer = &servicebuskey.Client{}
+	_ Observer = &sqlfirewallrules.Client{}
+	_ Observer = &sqlservers.Client{}
+	_ Observer = &storageaccounts.Client{}
+	_ Observer = &storagekeys.Client{}
+	_ Observer = &subnets.Client{}
+	_ Observer = &tlssecrets.Client{}
+	_ Observer = &trafficmanagers.Client{}
+	_ Observer = &virtualnetworks.Client{}
+	_ Observer = &vms.Client{}
+)
@@ -0,0 +1,77 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+// blindClient is a sync client which cannot observe its kind.
+type blindClient struct{}
+
+func (blindClient) ForSubscription(context.Context, runtime.Object) error { return nil }
+func (blindClient) Ensure(context.Context, runtime.Object) error          { return nil }
+func (blindClient) Delete(context.Context, runtime.Object) error          { return nil }
+
+func newRegistryScheme(g *GomegaWithT) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(azurev1alpha1.AddToScheme(scheme)).To(Succeed())
+	return scheme
+}
+
+func TestKindsGroupVersionKind(t *testing.T) {
+	g := NewGomegaWithT(t)
+	registryScheme := newRegistryScheme(g)
+	for _, kind := range Kinds() {
+		gvk, err := apiutil.GVKForObject(kind.Object, registryScheme)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(gvk).To(Equal(kind.GroupVersionKind))
+	}
+}
+
+func TestKindsClientConstructors(t *testing.T) {
+	g := NewGomegaWithT(t)
+	for _, kind := range Kinds() {
+		g.Expect(kind.NewSync == nil).ToNot(Equal(kind.NewAsync == nil), kind.Kind)
+	}
+}
+
+func TestKindsCoverAPIGroup(t *testing.T) {
+	g := NewGomegaWithT(t)
+	registryScheme := newRegistryScheme(g)
+	for gvk, t := range registryScheme.AllKnownTypes() {
+		// Only top level kinds carry a status, lists and options do not. VMScaleSet has no Azure client yet.
+		if _, ok := reflect.New(t).Interface().(azurev1alpha1.StatusAccessor); !ok || gvk.Kind == "VMScaleSet" {
+			continue
+		}
+		_, ok := KindFor(gvk)
+		g.Expect(ok).To(BeTrue(), gvk.Kind)
+	}
+}
+
+func TestKindNewReconcilerRequiresObserver(t *testing.T) {
+	g := NewGomegaWithT(t)
+	kind := Kind{
+		GroupVersionKind: azurev1alpha1.GroupVersion.WithKind("ResourceGroup"),
+		Object:           &azurev1alpha1.ResourceGroup{},
+		NewSync: func(*config.Config, *client.Client, *runtime.Scheme) (SyncClient, error) {
+			return blindClient{}, nil
+		},
+	}
+	_, err := kind.NewReconciler(&config.Config{}, nil, Options{DriftPolicy: DriftPolicyReport})
+	g.Expect(err).To(HaveOccurred())
+	_, err = kind.NewReconciler(&config.Config{}, nil, Options{DriftPolicy: DriftPolicyEnforce})
+	g.Expect(err).ToNot(HaveOccurred())
+}
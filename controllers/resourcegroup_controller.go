@@ -1,33 +0,0 @@
-/*
-Copyright 2019 Alexander Eldeib.
-*/
-
-package controllers
-
-import (
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-
-	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
-)
-
-// ResourceGroupReconciler reconciles a ResourceGroup object
-type ResourceGroupReconciler struct {
-	Reconciler *AsyncReconciler
-}
-
-// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=resourcegroups,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=azure.alexeldeib.xyz,resources=resourcegroups/status,verbs=get;update;patch
-
-// Reconcile reconciles a user request for a Resource Group against Azure.
-func (r *ResourceGroupReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	return r.Reconciler.Reconcile(req, &azurev1alpha1.ResourceGroup{})
-}
-
-// SetupWithManager sets up this controller for use.
-func (r *ResourceGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&azurev1alpha1.ResourceGroup{}).
-		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
-		Complete(r)
-}
@@ -0,0 +1,285 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// Shard selects the objects reconciled by one manager, so several managers may run side by side,
+// e.g. with production and non-production credentials, or one per team.
+// The zero value reconciles every object without a controller class.
+type Shard struct {
+	// Class must match the controller class annotation of an object. Empty matches objects without the annotation.
+	Class string
+	// Selector must match the labels of an object. Nil matches every object.
+	Selector labels.Selector
+	// Namespaces must contain the namespace of an object. Empty matches every namespace.
+	Namespaces []string
+}
+
+// ParseShard builds the shard of a manager from its flags.
+// The selector uses the kubectl label selector syntax and namespaces is a comma separated list.
+func ParseShard(class, selector, namespaces string) (Shard, error) {
+	if class != "" {
+		if errs := validation.IsDNS1123Label(class); len(errs) > 0 {
+			return Shard{}, fmt.Errorf("invalid controller class %q: %s", class, strings.Join(errs, ", "))
+		}
+	}
+	shard := Shard{Class: class}
+	if selector != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return Shard{}, err
+		}
+		shard.Selector = parsed
+	}
+	for _, namespace := range strings.Split(namespaces, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			shard.Namespaces = append(shard.Namespaces, namespace)
+		}
+	}
+	sort.Strings(shard.Namespaces)
+	return shard, nil
+}
+
+// LeaderElectionID returns the name of the lock held by the leader of the shard.
+// Managers of different shards elect leaders independently, while replicas of one manager share a lock.
+// The zero shard keeps base, so existing deployments keep their lock.
+func (s Shard) LeaderElectionID(base string) string {
+	id := base
+	if s.Class != "" {
+		id += "-" + s.Class
+	}
+	if s.Selector == nil && len(s.Namespaces) == 0 {
+		return id
+	}
+	hash := fnv.New32a()
+	if s.Selector != nil {
+		hash.Write([]byte(s.Selector.String()))
+	}
+	hash.Write([]byte("/" + strings.Join(s.Namespaces, ",")))
+	return fmt.Sprintf("%s-%08x", id, hash.Sum32())
+}
+
+// Owns returns true if obj belongs to the shard.
+func (s Shard) Owns(obj metav1.Object) bool {
+	if obj.GetAnnotations()[azurev1alpha1.ControllerClassAnnotation] != s.Class {
+		return false
+	}
+	if s.Selector != nil && !s.Selector.Matches(labels.Set(obj.GetLabels())) {
+		return false
+	}
+	if len(s.Namespaces) == 0 {
+		return true
+	}
+	for _, namespace := range s.Namespaces {
+		if obj.GetNamespace() == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Predicate drops the events of objects of the same type as kind which belong to another shard.
+// Events of other types, e.g. secrets owned by an object, pass through and are checked after mapping to their owner.
+func (s Shard) Predicate(kind runtime.Object) predicate.Funcs {
+	owns := func(meta metav1.Object, obj runtime.Object) bool {
+		if meta == nil || obj == nil {
+			return true
+		}
+		// Typed objects from the cache carry no type meta, so compare their Go types.
+		if reflect.TypeOf(obj) != reflect.TypeOf(kind) {
+			return true
+		}
+		return s.Owns(meta)
+	}
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return owns(e.Meta, e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return owns(e.Meta, e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return owns(e.MetaNew, e.ObjectNew) },
+		GenericFunc: func(e event.GenericEvent) bool { return owns(e.Meta, e.Object) },
+	}
+}
+
+// NewCache returns the cache of a manager for the shard, which only watches the namespaces of the shard.
+// Cluster scoped objects, e.g. namespaces and cluster provider configs, are cached across the cluster,
+// and namespaced objects outside the shard, e.g. credentials of a cluster provider config, are read from the API server.
+// It returns nil for shards spanning every namespace, which selects the default cache of the manager.
+func (s Shard) NewCache() cache.NewCacheFunc {
+	if len(s.Namespaces) == 0 {
+		return nil
+	}
+	return func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+		if opts.Scheme == nil {
+			opts.Scheme = scheme.Scheme
+		}
+		if opts.Mapper == nil {
+			mapper, err := apiutil.NewDiscoveryRESTMapper(config)
+			if err != nil {
+				return nil, err
+			}
+			opts.Mapper = mapper
+		}
+		namespaced, err := cache.MultiNamespacedCacheBuilder(s.Namespaces)(config, opts)
+		if err != nil {
+			return nil, err
+		}
+		opts.Namespace = ""
+		cluster, err := cache.New(config, opts)
+		if err != nil {
+			return nil, err
+		}
+		direct, err := client.New(config, client.Options{Scheme: opts.Scheme, Mapper: opts.Mapper})
+		if err != nil {
+			return nil, err
+		}
+		return newShardCache(s.Namespaces, namespaced, cluster, direct, opts.Scheme, opts.Mapper), nil
+	}
+}
+
+// shardCache routes reads between a cache of the shard namespaces, a cache of cluster scoped objects,
+// and the API server for namespaced objects outside the shard.
+type shardCache struct {
+	cache.Cache
+	namespaces map[string]bool
+	cluster    cache.Cache
+	direct     client.Reader
+	scheme     *runtime.Scheme
+	mapper     meta.RESTMapper
+}
+
+func newShardCache(namespaces []string, namespaced, cluster cache.Cache, direct client.Reader, scheme *runtime.Scheme, mapper meta.RESTMapper) *shardCache {
+	c := &shardCache{
+		Cache:      namespaced,
+		namespaces: map[string]bool{},
+		cluster:    cluster,
+		direct:     direct,
+		scheme:     scheme,
+		mapper:     mapper,
+	}
+	for _, namespace := range namespaces {
+		c.namespaces[namespace] = true
+	}
+	return c
+}
+
+// clusterScoped returns true if obj, or the items of obj if it is a list, are not namespaced.
+func (c *shardCache) clusterScoped(obj runtime.Object) (bool, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return false, err
+	}
+	if meta.IsListType(obj) {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+	return c.clusterScopedKind(gvk)
+}
+
+func (c *shardCache) clusterScopedKind(gvk schema.GroupVersionKind) (bool, error) {
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, err
+	}
+	return mapping.Scope.Name() == meta.RESTScopeNameRoot, nil
+}
+
+func (c *shardCache) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	clusterScoped, err := c.clusterScoped(obj)
+	switch {
+	case err != nil:
+		return err
+	case clusterScoped:
+		return c.cluster.Get(ctx, key, obj)
+	case c.namespaces[key.Namespace]:
+		return c.Cache.Get(ctx, key, obj)
+	}
+	return c.direct.Get(ctx, key, obj)
+}
+
+func (c *shardCache) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	clusterScoped, err := c.clusterScoped(list)
+	if err != nil {
+		return err
+	}
+	if clusterScoped {
+		return c.cluster.List(ctx, list, opts...)
+	}
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if listOpts.Namespace == "" || c.namespaces[listOpts.Namespace] {
+		return c.Cache.List(ctx, list, opts...)
+	}
+	return c.direct.List(ctx, list, opts...)
+}
+
+func (c *shardCache) GetInformer(obj runtime.Object) (cache.Informer, error) {
+	clusterScoped, err := c.clusterScoped(obj)
+	if err != nil {
+		return nil, err
+	}
+	if clusterScoped {
+		return c.cluster.GetInformer(obj)
+	}
+	return c.Cache.GetInformer(obj)
+}
+
+func (c *shardCache) GetInformerForKind(gvk schema.GroupVersionKind) (cache.Informer, error) {
+	clusterScoped, err := c.clusterScopedKind(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if clusterScoped {
+		return c.cluster.GetInformerForKind(gvk)
+	}
+	return c.Cache.GetInformerForKind(gvk)
+}
+
+func (c *shardCache) IndexField(obj runtime.Object, field string, extractValue client.IndexerFunc) error {
+	clusterScoped, err := c.clusterScoped(obj)
+	if err != nil {
+		return err
+	}
+	if clusterScoped {
+		return c.cluster.IndexField(obj, field, extractValue)
+	}
+	return c.Cache.IndexField(obj, field, extractValue)
+}
+
+func (c *shardCache) Start(stopCh <-chan struct{}) error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- c.cluster.Start(stopCh)
+	}()
+	if err := c.Cache.Start(stopCh); err != nil {
+		return err
+	}
+	return <-errs
+}
+
+func (c *shardCache) WaitForCacheSync(stop <-chan struct{}) bool {
+	return c.cluster.WaitForCacheSync(stop) && c.Cache.WaitForCacheSync(stop)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+func shardedGroup(namespace string, labels, annotations map[string]string) *azurev1alpha1.ResourceGroup {
+	return &azurev1alpha1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "group", Labels: labels, Annotations: annotations},
+	}
+}
+
+func controllerClass(name string) map[string]string {
+	return map[string]string{azurev1alpha1.ControllerClassAnnotation: name}
+}
+
+func TestShardOwnsUnclassedByDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+	var shard Shard
+	g.Expect(shard.Owns(shardedGroup("team", nil, nil))).To(BeTrue())
+	g.Expect(shard.Owns(shardedGroup("team", nil, controllerClass("prod")))).To(BeFalse())
+}
+
+func TestShardOwns(t *testing.T) {
+	g := NewGomegaWithT(t)
+	shard, err := ParseShard("prod", "team=payments,env!=dev", "payments, billing,")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(shard.Namespaces).To(Equal([]string{"billing", "payments"}))
+
+	labels := map[string]string{"team": "payments"}
+	g.Expect(shard.Owns(shardedGroup("payments", labels, controllerClass("prod")))).To(BeTrue())
+	g.Expect(shard.Owns(shardedGroup("payments", labels, nil))).To(BeFalse())
+	g.Expect(shard.Owns(shardedGroup("payments", labels, controllerClass("nonprod")))).To(BeFalse())
+	g.Expect(shard.Owns(shardedGroup("payments", map[string]string{"team": "payments", "env": "dev"}, controllerClass("prod")))).To(BeFalse())
+	g.Expect(shard.Owns(shardedGroup("payments", nil, controllerClass("prod")))).To(BeFalse())
+	g.Expect(shard.Owns(shardedGroup("default", labels, controllerClass("prod")))).To(BeFalse())
+}
+
+func TestParseShardRejectsInvalidFlags(t *testing.T) {
+	g := NewGomegaWithT(t)
+	_, err := ParseShard("Not A Class", "", "")
+	g.Expect(err).To(HaveOccurred())
+	_, err = ParseShard("", "team in (", "")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestShardLeaderElectionID(t *testing.T) {
+	g := NewGomegaWithT(t)
+	var unsharded Shard
+	g.Expect(unsharded.LeaderElectionID("lock")).To(Equal("lock"))
+
+	prod, err := ParseShard("prod", "", "")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(prod.LeaderElectionID("lock")).To(Equal("lock-prod"))
+
+	payments, err := ParseShard("", "", "payments")
+	g.Expect(err).ToNot(HaveOccurred())
+	billing, err := ParseShard("", "", "billing")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(payments.LeaderElectionID("lock")).To(HavePrefix("lock-"))
+	g.Expect(payments.LeaderElectionID("lock")).ToNot(Equal(billing.LeaderElectionID("lock")))
+
+	reordered, err := ParseShard("", "", "payments,billing")
+	g.Expect(err).ToNot(HaveOccurred())
+	ordered, err := ParseShard("", "", "billing,payments")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reordered.LeaderElectionID("lock")).To(Equal(ordered.LeaderElectionID("lock")))
+}
+
+func TestShardPredicate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	shard, err := ParseShard("prod", "", "")
+	g.Expect(err).ToNot(HaveOccurred())
+	p := shard.Predicate(&azurev1alpha1.ResourceGroup{})
+
+	other := shardedGroup("team", nil, nil)
+	own := shardedGroup("team", nil, controllerClass("prod"))
+	g.Expect(p.Create(event.CreateEvent{Meta: other, Object: other})).To(BeFalse())
+	g.Expect(p.Create(event.CreateEvent{Meta: own, Object: own})).To(BeTrue())
+	g.Expect(p.Update(event.UpdateEvent{MetaOld: own, ObjectOld: own, MetaNew: other, ObjectNew: other})).To(BeFalse())
+	g.Expect(p.Update(event.UpdateEvent{MetaOld: other, ObjectOld: other, MetaNew: own, ObjectNew: own})).To(BeTrue())
+	g.Expect(p.Delete(event.DeleteEvent{Meta: other, Object: other})).To(BeFalse())
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "owned"}}
+	g.Expect(p.Create(event.CreateEvent{Meta: secret, Object: secret})).To(BeTrue())
+}
+
+// readCache serves reads from the objects it was created with and hands out fake informers.
+type readCache struct {
+	informertest.FakeInformers
+	reader client.Client
+}
+
+func newReadCache(objs ...runtime.Object) *readCache {
+	return &readCache{reader: fake.NewFakeClientWithScheme(scheme.Scheme, objs...)}
+}
+
+func (c *readCache) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	return c.reader.Get(ctx, key, obj)
+}
+
+func (c *readCache) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+func TestShardCacheOnlyForNamespaces(t *testing.T) {
+	g := NewGomegaWithT(t)
+	var unsharded Shard
+	g.Expect(unsharded.NewCache()).To(BeNil())
+	shard, err := ParseShard("", "", "payments")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(shard.NewCache()).ToNot(BeNil())
+}
+
+func TestShardCacheRoutesReads(t *testing.T) {
+	g := NewGomegaWithT(t)
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+
+	secret := func(namespace string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "creds"}}
+	}
+	namespaced := newReadCache(secret("payments"))
+	cluster := newReadCache(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments"}})
+	direct := newReadCache(secret("kube-system"))
+	c := newShardCache([]string{"payments"}, namespaced, cluster, direct.reader, scheme.Scheme, mapper)
+
+	ctx := context.Background()
+	g.Expect(c.Get(ctx, types.NamespacedName{Namespace: "payments", Name: "creds"}, &corev1.Secret{})).To(Succeed())
+	g.Expect(c.Get(ctx, types.NamespacedName{Namespace: "kube-system", Name: "creds"}, &corev1.Secret{})).To(Succeed())
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "payments"}, &corev1.Namespace{})).To(Succeed())
+
+	var secrets corev1.SecretList
+	g.Expect(c.List(ctx, &secrets)).To(Succeed())
+	g.Expect(secrets.Items).To(HaveLen(1))
+	g.Expect(secrets.Items[0].Namespace).To(Equal("payments"))
+	g.Expect(c.List(ctx, &secrets, client.InNamespace("kube-system"))).To(Succeed())
+	g.Expect(secrets.Items).To(HaveLen(1))
+	g.Expect(secrets.Items[0].Namespace).To(Equal("kube-system"))
+
+	_, err := c.GetInformer(&corev1.Namespace{})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = c.GetInformer(&corev1.Secret{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cluster.InformersByGVK).To(HaveKey(corev1.SchemeGroupVersion.WithKind("Namespace")))
+	g.Expect(cluster.InformersByGVK).ToNot(HaveKey(corev1.SchemeGroupVersion.WithKind("Secret")))
+	g.Expect(namespaced.InformersByGVK).To(HaveKey(corev1.SchemeGroupVersion.WithKind("Secret")))
+}
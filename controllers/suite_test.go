@@ -32,8 +32,6 @@ import (
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/resourcegroups"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlfirewallrules"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlservers"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
 	// +kubebuilder:scaffold:imports
 )
@@ -93,33 +91,19 @@ var _ = BeforeSuite(func(done Done) {
 	log := logf.Log.WithName("testmanager")
 	recorder := mgr.GetEventRecorderFor("testmanager")
 
-	By("creating reconciler")
-	Expect((&ResourceGroupReconciler{
-		Reconciler: &AsyncReconciler{
-			Client:   k8sClient,
-			Az:       resourcegroups.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-		},
-	}).SetupWithManager(mgr)).NotTo(HaveOccurred())
-
-	Expect((&SQLServerReconciler{
-		Reconciler: &SyncReconciler{
-			Client:   k8sClient,
-			Az:       sqlservers.New(configuration, &k8sClient, mgr.GetScheme()),
-			Log:      log,
-			Recorder: recorder,
-		},
-	}).SetupWithManager(mgr)).NotTo(HaveOccurred())
-
-	Expect((&SQLFirewallRuleReconciler{
-		Reconciler: &SyncReconciler{
-			Client:   k8sClient,
-			Az:       sqlfirewallrules.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-		},
-	}).SetupWithManager(mgr)).NotTo(HaveOccurred())
+	By("creating reconcilers")
+	opts := Options{
+		Log:      log,
+		Recorder: recorder,
+		Scheme:   mgr.GetScheme(),
+	}
+	for _, name := range []string{"ResourceGroup", "SQLServer", "SQLFirewallRule"} {
+		kind, ok := KindFor(azurev1alpha1.GroupVersion.WithKind(name))
+		Expect(ok).To(BeTrue())
+		reconciler, err := kind.NewReconciler(configuration, k8sClient, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reconciler.SetupWithManager(mgr)).NotTo(HaveOccurred())
+	}
 
 	By("starting the manager")
 	go func() {
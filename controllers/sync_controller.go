@@ -7,19 +7,25 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	multierror "github.com/hashicorp/go-multierror"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azerrors"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
 )
 
 const (
-	finalizerName string = "azure.alexeldeib.xyz/finalizer"
+	finalizerName string = azurev1alpha1.Finalizer
 )
 
 type SyncClient interface {
@@ -31,15 +37,21 @@ type SyncClient interface {
 // SyncReconciler is a generic reconciler for Azure resources which run fast, synchronous operations.
 type SyncReconciler struct {
 	client.Client
-	Az       SyncClient
-	Log      logr.Logger
-	Recorder record.EventRecorder
-	Scheme   *runtime.Scheme
+	Az SyncClient
+	// Providers, when set, selects the Azure client for the provider config of each object instead of Az.
+	Providers *ProviderClients
+	Log       logr.Logger
+	Recorder  record.EventRecorder
+	Scheme    *runtime.Scheme
+	// ResyncPeriod is the interval at which ready objects are checked against Azure for drift. Zero disables resync.
+	ResyncPeriod time.Duration
+	// DriftPolicy decides whether detected drift is corrected or only reported.
+	DriftPolicy DriftPolicy
+	// MaxConcurrentReconciles is the number of objects of this kind reconciled in parallel. Zero means one.
+	MaxConcurrentReconciles int
 }
 
-func (r *SyncReconciler) Reconcile(req ctrl.Request, local runtime.Object) (ctrl.Result, error) {
-	ctx := context.Background()
-
+func (r *SyncReconciler) Reconcile(ctx context.Context, req ctrl.Request, local runtime.Object) (ctrl.Result, error) {
 	gvk, err := apiutil.GVKForObject(local, r.Scheme)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -49,27 +61,75 @@ func (r *SyncReconciler) Reconcile(req ctrl.Request, local runtime.Object) (ctrl
 
 	if err := r.Get(ctx, req.NamespacedName, local); err != nil {
 		log.Info("error during fetch from api server")
+		if apierrs.IsNotFound(err) {
+			metrics.Forget(gvk.Kind, req.NamespacedName.String())
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-
-	if err := r.Az.ForSubscription(ctx, local); err != nil {
-		return ctrl.Result{}, err
-	}
+	defer recordReadiness(gvk.Kind, req.NamespacedName, local)
+	original := local.DeepCopyObject()
 
 	res, convertErr := meta.Accessor(local)
 	if convertErr != nil {
 		return ctrl.Result{}, convertErr
 	}
 
+	if IsPaused(res) {
+		log.Info("reconciliation paused")
+		if MarkPaused(local, true) {
+			r.Recorder.Event(local, "Normal", "Paused", "Reconciliation is paused, Azure will not be called")
+		}
+		return ctrl.Result{}, updateStatus(ctx, r.Client, original, local)
+	}
+	if MarkPaused(local, false) {
+		r.Recorder.Event(local, "Normal", "Resumed", "Reconciliation is resumed")
+	}
+
+	az, err := r.azFor(ctx, local)
+	if err != nil {
+		log.Error(err, "provider config err")
+		MarkFailed(local, azurev1alpha1.ReasonProviderConfigError, err)
+		r.Recorder.Event(local, "Warning", "ProviderConfigError", fmt.Sprintf("Failed to load provider config: %s", err.Error()))
+		final := multierror.Append(err, updateStatus(ctx, r.Client, original, local))
+		return ctrl.Result{}, final.ErrorOrNil()
+	}
+
+	if err := az.ForSubscription(ctx, local); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if res.GetDeletionTimestamp().IsZero() {
 		if !HasFinalizer(res, finalizerName) {
+			// The condition is written first, so an object never carries the finalizer without it.
+			MarkOwnershipUnchecked(local)
+			if err := updateStatus(ctx, r.Client, original, local); err != nil {
+				return ctrl.Result{}, err
+			}
 			AddFinalizer(res, finalizerName)
 			r.Recorder.Event(local, "Normal", "Added", "Object finalizer is added")
 			return ctrl.Result{}, r.Update(ctx, local)
 		}
 	} else {
 		if HasFinalizer(res, finalizerName) {
-			final := multierror.Append(r.Az.Delete(ctx, local), r.Status().Update(ctx, local))
+			policy, err := GetDeletionPolicy(ctx, r.Client, res)
+			if err != nil {
+				r.Recorder.Event(local, "Warning", "FailedDelete", fmt.Sprintf("Failed to delete resource: %s", err.Error()))
+				return ctrl.Result{}, err
+			}
+			if policy == azurev1alpha1.DeletionPolicyOrphan {
+				log.Info("orphaning azure resource")
+				r.Recorder.Event(local, "Normal", "Orphaned", "Removed object without deleting the Azure resource")
+				RemoveFinalizer(res, finalizerName)
+				return ctrl.Result{}, r.Update(ctx, local)
+			}
+			deleteErr := az.Delete(ctx, local)
+			recordOutcome(local, ActionDelete, true, deleteErr, time.Now())
+			if deleteErr != nil {
+				MarkFailed(local, azurev1alpha1.ReasonDeleteFailed, deleteErr)
+			} else {
+				MarkReconciling(local, azurev1alpha1.ReasonDeleting, "Deleting resource")
+			}
+			final := multierror.Append(deleteErr, updateStatus(ctx, r.Client, original, local))
 			if err := final.ErrorOrNil(); err != nil {
 				r.Recorder.Event(local, "Warning", "FailedDelete", fmt.Sprintf("Failed to delete resource: %s", err.Error()))
 				return ctrl.Result{}, err
@@ -80,16 +140,75 @@ func (r *SyncReconciler) Reconcile(req ctrl.Request, local runtime.Object) (ctrl
 		}
 		return ctrl.Result{}, nil
 	}
-	ensureErr := r.Az.Ensure(ctx, local)
+	waiting, err := ResolveDependencies(ctx, r.Client, local)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if waiting != "" {
+		log.Info(waiting)
+		MarkWaiting(local, waiting)
+		return ctrl.Result{RequeueAfter: dependencyPollInterval}, updateStatus(ctx, r.Client, original, local)
+	}
+	MarkDependenciesReady(local)
+
+	hash, err := SpecHash(local)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	unchanged, observeErr := observeIfUnchanged(ctx, log, r.Recorder, r.DriftPolicy, az, local, hash)
+	if observeErr != nil {
+		log.Error(observeErr, "observe err")
+		markError(local, observeErr)
+		recordOutcome(local, ActionObserve, false, observeErr, time.Now())
+	}
+	if unchanged || observeErr != nil {
+		final := multierror.Append(retryable(observeErr), updateStatus(ctx, r.Client, original, local))
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}, final.ErrorOrNil()
+	}
+
+	if FailedTerminally(local, hash) {
+		if wait, retry := TerminalBackoff(local, r.ResyncPeriod, time.Now()); !retry {
+			log.Info("spec was rejected by Azure, not retrying until it changes or the resync period passes")
+			return ctrl.Result{RequeueAfter: wait}, updateStatus(ctx, r.Client, original, local)
+		}
+		log.Info("retrying spec which was rejected by Azure")
+	}
+
+	ensureErr := az.Ensure(ctx, local)
+	recordOutcome(local, ActionEnsure, true, ensureErr, time.Now())
+	MarkOwnership(local, ensureErr)
 	if ensureErr != nil {
 		log.Error(ensureErr, "ensure err")
+		markError(local, ensureErr)
+	} else {
+		MarkReady(local)
+		clearLastError(local)
+		MarkDrifted(local, false)
+		MarkObserved(local, hash)
 	}
 	log.Info("successfully reconciled")
-	final := multierror.Append(ensureErr, r.Status().Update(ctx, local))
+	if azerrors.IsTerminal(ensureErr) {
+		MarkFailedTerminally(local, hash, time.Now())
+		r.Recorder.Event(local, "Warning", "TerminalError", terminalMessage(r.ResyncPeriod, ensureErr))
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}, updateStatus(ctx, r.Client, original, local)
+	}
+	final := multierror.Append(ensureErr, updateStatus(ctx, r.Client, original, local))
 	err = final.ErrorOrNil()
 	if err != nil {
 		r.Recorder.Event(local, "Warning", "FailedReconcile", fmt.Sprintf("Failed to reconcile resource: %s", err.Error()))
 	}
 	r.Recorder.Event(local, "Normal", "Reconciled", "Successfully reconciled")
-	return ctrl.Result{}, err
+	return ctrl.Result{RequeueAfter: r.ResyncPeriod}, err
+}
+
+// azFor returns the Azure client for the provider config selected by obj.
+func (r *SyncReconciler) azFor(ctx context.Context, obj runtime.Object) (SyncClient, error) {
+	if r.Providers == nil {
+		return r.Az, nil
+	}
+	az, err := r.Providers.For(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return az.(SyncClient), nil
 }
@@ -1,5 +0,0 @@
-/*
-Copyright 2019 Alexander Eldeib.
-*/
-
-package controllers
@@ -5,36 +5,27 @@ Copyright 2019 Alexander Eldeib.
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"math/rand"
 	"os"
 	"time"
 
-	"github.com/sanity-io/litter"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	azurev1beta1 "github.com/alexeldeib/incendiary-iguana/api/v1beta1"
 	"github.com/alexeldeib/incendiary-iguana/controllers"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/keyvaults"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/nics"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/publicips"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/redis"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/resourcegroups"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/secrets"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/securitygroups"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/servicebus"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlfirewallrules"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlservers"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/subnets"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/tlssecrets"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/trafficmanagers"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/virtualnetworks"
-	"github.com/alexeldeib/incendiary-iguana/pkg/clients/vms"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/ratelimit"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
+	"github.com/alexeldeib/incendiary-iguana/pkg/wirelog"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -46,6 +37,7 @@ var (
 func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = azurev1alpha1.AddToScheme(scheme)
+	_ = azurev1beta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -53,258 +45,195 @@ func main() {
 	rand.Seed(time.Now().Unix())
 	var metricsAddr string
 	var enableLeaderElection bool
+	var resyncPeriod time.Duration
+	var resyncOverrides string
+	var driftPolicyName string
+	var clusterID string
+	var maxConcurrentReconciles int
+	var azureQPS float64
+	var azureBurst int
+	var enableWebhooks bool
+	var authMethodName string
+	var cloudName string
+	var controllerClass string
+	var selector string
+	var namespaces string
+	var operationTimeout time.Duration
+	var tracingExporter string
+	var tracingEndpoint string
+	var wireLog string
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&resyncPeriod, "resync-period", 10*time.Minute,
+		"The interval at which ready objects are checked against Azure for drift. Zero disables periodic resync.")
+	flag.StringVar(&resyncOverrides, "resync-period-overrides", "",
+		"Comma separated Kind=duration pairs overriding the resync period per kind, e.g. ResourceGroup=1h,VM=5m.")
+	flag.StringVar(&clusterID, "cluster-id", "",
+		"The identifier of this cluster, recorded in ownership tags on Azure resources.")
+	flag.StringVar(&driftPolicyName, "drift-policy", string(controllers.DriftPolicyEnforce),
+		"What to do when an Azure resource drifts from its spec: enforce reapplies the spec, report only emits an event and sets the Drifted condition.")
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 15,
+		"The number of objects of each kind reconciled in parallel.")
+	flag.Float64Var(&azureQPS, "azure-qps", ratelimit.DefaultQPS,
+		"The steady state rate of requests sent to Azure Resource Manager per subscription.")
+	flag.IntVar(&azureBurst, "azure-burst", ratelimit.DefaultBurst,
+		"The number of requests which may be sent to Azure Resource Manager at once per subscription.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Serve the admission webhooks validating objects and defaulting their location and subscription, and the conversion webhook between API versions. Requires a serving certificate in /tmp/k8s-webhook-server/serving-certs.")
+	flag.StringVar(&authMethodName, "auth-method", "",
+		fmt.Sprintf("The method the manager authenticates to Azure with, one of %v. Credentials are read from the AZURE_* environment variables used by the Azure SDKs, and the method is inferred from them when empty.", config.AuthMethods))
+
+	flag.StringVar(&cloudName, "cloud", "",
+		"The Azure cloud to manage resources in, one of public, usgovernment, china or german. Defaults to the cloud named by AZURE_ENVIRONMENT, or the public cloud.")
+	flag.StringVar(&controllerClass, "controller-class", "",
+		"Only reconcile objects whose "+azurev1alpha1.ControllerClassAnnotation+" annotation matches. When empty, only objects without the annotation are reconciled.")
+	flag.StringVar(&selector, "selector", "",
+		"Only reconcile objects whose labels match this label selector, e.g. team=payments,env!=prod.")
+	flag.StringVar(&namespaces, "namespaces", "",
+		"Comma separated namespaces whose objects are reconciled and watched. When empty, objects in every namespace are reconciled.")
+	flag.DurationVar(&operationTimeout, "operation-timeout", controllers.DefaultOperationTimeout,
+		"How long a long running Azure operation is polled before it is abandoned and started again. Zero waits forever.")
+	flag.StringVar(&tracingExporter, "tracing-exporter", tracing.ExporterNone,
+		fmt.Sprintf("Where OpenTelemetry spans of reconciles and Azure requests are sent, one of %v.", tracing.Exporters))
+	flag.StringVar(&tracingEndpoint, "tracing-endpoint", "",
+		"The OTLP/HTTP endpoint of the OpenTelemetry collector spans are sent to. Defaults to OTEL_EXPORTER_OTLP_ENDPOINT, or "+tracing.DefaultEndpoint+".")
+	flag.StringVar(&wireLog, "wire-log", "",
+		fmt.Sprintf("Log the HTTP traffic of Azure clients with credentials redacted. A comma separated default level and Kind=level overrides, e.g. basic,VM=body. Levels are %v.", wirelog.Levels))
 
 	flag.Parse()
 
 	ctrl.SetLogger(zap.Logger(false))
 
-	configuration, err := config.New()
+	resync, err := controllers.ParseResyncPeriods(resyncPeriod, resyncOverrides)
 	if err != nil {
-		setupLog.Error(err, "failed to detect any authorizer")
-	}
-
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:             scheme,
-		MetricsBindAddress: metricsAddr,
-		LeaderElection:     enableLeaderElection,
-	})
-
-	if err != nil {
-		setupLog.Error(err, "unable to start manager")
+		setupLog.Error(err, "invalid resync period overrides")
 		os.Exit(1)
 	}
 
-	log := ctrl.Log.WithName("incendiaryiguana")
-	recorder := mgr.GetEventRecorderFor("incendiaryiguana")
-	client := mgr.GetClient()
-
-	// Global client initialization
-	secretsclient, err := secrets.New(configuration, &client, scheme)
+	driftPolicy, err := controllers.ParseDriftPolicy(driftPolicyName)
 	if err != nil {
-		setupLog.Error(err, "failed to initialize keyvault secret client")
+		setupLog.Error(err, "invalid drift policy")
 		os.Exit(1)
 	}
 
-	tlssecretsclient, err := tlssecrets.New(configuration, &client, scheme)
+	authMethod, err := config.ParseAuthMethod(authMethodName)
 	if err != nil {
-		setupLog.Error(err, "failed to initialize keyvault tlssecret client")
+		setupLog.Error(err, "invalid auth method")
 		os.Exit(1)
 	}
 
-	// TODO(ace): handle this in a loop.
-	if err = (&controllers.ResourceGroupReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       resourcegroups.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ResourceGroup")
-		os.Exit(1)
-	}
-
-	if err = (&controllers.KeyvaultReconciler{
-		Reconciler: &controllers.SyncReconciler{
-			Client:   client,
-			Az:       keyvaults.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Keyvault")
-		os.Exit(1)
-	}
-
-	if err = (&controllers.SecretReconciler{
-		Reconciler: &controllers.SyncReconciler{
-			Client:   client,
-			Az:       tlssecretsclient,
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Secret")
-		os.Exit(1)
-	}
-
-	if err = (&controllers.TLSSecretReconciler{
-		Reconciler: &controllers.SyncReconciler{
-			Client:   client,
-			Az:       tlssecretsclient,
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "TLSSecret")
+	shard, err := controllers.ParseShard(controllerClass, selector, namespaces)
+	if err != nil {
+		setupLog.Error(err, "invalid selector")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.SecretBundleReconciler{
-		Reconciler: &controllers.SyncReconciler{
-			Client:   client,
-			Az:       secretsclient,
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "SecretBundle")
+	cloud, err := config.ParseCloud(cloudName)
+	if err != nil {
+		setupLog.Error(err, "invalid cloud")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.VirtualNetworkReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       virtualnetworks.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "VirtualNetwork")
+	tracer, err := tracing.Setup(tracingExporter, "incendiary-iguana", tracingEndpoint, func(err error) {
+		setupLog.Error(err, "failed to export spans")
+	})
+	if err != nil {
+		setupLog.Error(err, "invalid tracing exporter")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.SubnetReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       subnets.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Subnet")
+	wireLogger, err := wirelog.Parse(ctrl.Log.WithName("azure"), wireLog)
+	if err != nil {
+		setupLog.Error(err, "invalid wire log levels")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.SecurityGroupReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       securitygroups.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "SecurityGroup")
-		os.Exit(1)
+	// Provider configs share the cluster ID, the cloud and the rate limiter of the manager configuration,
+	// which keeps the requests to each subscription within one budget whichever identity sends them.
+	configOptions := []config.Option{
+		config.ClusterID(clusterID),
+		config.RateLimiter(ratelimit.New(ratelimit.QPS(azureQPS), ratelimit.Burst(azureBurst))),
+		config.WireLogger(wireLogger),
 	}
-
-	if err = (&controllers.PublicIPReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       publicips.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "PublicIP")
-		os.Exit(1)
+	if cloud != nil {
+		configOptions = append(configOptions, config.Cloud(*cloud))
 	}
-
-	if err = (&controllers.NetworkInterfaceReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       nics.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "NetworkInterface")
-		os.Exit(1)
+	configuration, err := config.New(append(configOptions, config.Environment(), config.Method(authMethod))...)
+	if err != nil {
+		setupLog.Error(err, "failed to detect any authorizer")
 	}
 
-	if err = (&controllers.TrafficManagerReconciler{
-		Client:                client,
-		Log:                   ctrl.Log.WithName("controllers").WithName("TrafficManager"),
-		TrafficManagersClient: trafficmanagers.New(configuration),
-		Recorder:              recorder,
-	}).SetupWithManager(mgr); err != nil {
-		litter.Dump(err)
-		setupLog.Error(err, "unable to create controller", "controller", "TrafficManager")
-		os.Exit(1)
-	}
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:             scheme,
+		MetricsBindAddress: metricsAddr,
+		LeaderElection:     enableLeaderElection,
+		// Managers without a class keep the default lock of controller-runtime, so upgrades do not elect a second leader.
+		LeaderElectionID: shard.LeaderElectionID("controller-leader-election-helper"),
+		// Managers of a shard with namespaces only watch those namespaces.
+		NewCache: shard.NewCache(),
+	})
 
-	if err = (&controllers.RedisReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       redis.New(configuration, &client, scheme),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Redis")
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.ServiceBusNamespaceReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       servicebus.New(configuration, &client, scheme),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ServiceBusNamespace")
-		os.Exit(1)
-	}
+	log := ctrl.Log.WithName("incendiaryiguana")
+	recorder := mgr.GetEventRecorderFor("incendiaryiguana")
+	client := mgr.GetClient()
 
-	if err = (&controllers.VMReconciler{
-		Reconciler: &controllers.AsyncReconciler{
-			Client:   client,
-			Az:       vms.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
+	opts := controllers.Options{
+		Log:                     log,
+		Recorder:                recorder,
+		Scheme:                  scheme,
+		Resync:                  resync,
+		DriftPolicy:             driftPolicy,
+		MaxConcurrentReconciles: maxConcurrentReconciles,
+		Shard:                   shard,
+		OperationTimeout:        operationTimeout,
+		Providers: &controllers.Providers{
+			Client:  client,
+			Default: configuration,
+			Options: configOptions,
 		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "VM")
-		os.Exit(1)
 	}
 
-	if err = (&controllers.SQLServerReconciler{
-		Reconciler: &controllers.SyncReconciler{
-			Client:   client,
-			Az:       sqlservers.New(configuration, &client, scheme),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "SQLServer")
-		os.Exit(1)
+	for _, kind := range controllers.Kinds() {
+		reconciler, err := kind.NewReconciler(configuration, client, opts)
+		if err != nil {
+			setupLog.Error(err, "failed to initialize azure client", "controller", kind.Kind)
+			os.Exit(1)
+		}
+		if err = reconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", kind.Kind)
+			os.Exit(1)
+		}
+		if !enableWebhooks {
+			continue
+		}
+		if err = kind.SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", kind.Kind)
+			os.Exit(1)
+		}
 	}
 
-	if err = (&controllers.SQLFirewallRuleReconciler{
-		Reconciler: &controllers.SyncReconciler{
-			Client:   client,
-			Az:       sqlfirewallrules.New(configuration),
-			Log:      log,
-			Recorder: recorder,
-			Scheme:   scheme,
-		},
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "SQLFirewallRule")
-		os.Exit(1)
+	if enableWebhooks {
+		mgr.GetWebhookServer().Register(controllers.DefaultingWebhookPath, &webhook.Admission{
+			Handler: &controllers.DefaultingWebhook{Client: client},
+		})
 	}
 
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(ctrl.SetupSignalHandler())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := tracer.Shutdown(ctx); err != nil {
+		setupLog.Error(err, "failed to flush spans")
+	}
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
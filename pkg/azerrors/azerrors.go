@@ -0,0 +1,296 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package azerrors
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+)
+
+// Category groups Azure errors by how a caller should react to them.
+type Category string
+
+const (
+	// NotFound means the resource or one of its parents does not exist.
+	NotFound Category = "NotFound"
+	// Conflict means the resource is in a state which does not allow the operation, e.g. another operation is in progress.
+	Conflict Category = "Conflict"
+	// Throttled means Azure rejected the request because of request rate limits.
+	Throttled Category = "Throttled"
+	// QuotaExceeded means the subscription has no quota left for the requested resource.
+	QuotaExceeded Category = "QuotaExceeded"
+	// InvalidParameter means the request itself is invalid, e.g. an unknown SKU or location.
+	InvalidParameter Category = "InvalidParameter"
+	// AuthorizationFailed means the principal is not allowed to perform the operation.
+	AuthorizationFailed Category = "AuthorizationFailed"
+	// Transient means a server side or network failure which is likely to succeed on retry.
+	Transient Category = "Transient"
+	// Unknown is any error which could not be classified.
+	Unknown Category = "Unknown"
+)
+
+// Azure error codes mapped to categories. Codes not listed here are classified by HTTP status code.
+var codes = map[string]Category{
+	"NotFound":                            NotFound,
+	"ResourceNotFound":                    NotFound,
+	"ResourceGroupNotFound":               NotFound,
+	"ParentResourceNotFound":              NotFound,
+	"SubscriptionNotFound":                NotFound,
+	"Conflict":                            Conflict,
+	"AnotherOperationInProgress":          Conflict,
+	"OperationNotAllowedOnResource":       Conflict,
+	"InUseSubnetCannotBeDeleted":          Conflict,
+	"TooManyRequests":                     Throttled,
+	"SubscriptionRequestsThrottled":       Throttled,
+	"TenantRequestsThrottled":             Throttled,
+	"QuotaExceeded":                       QuotaExceeded,
+	"PublicIPCountLimitReached":           QuotaExceeded,
+	"InvalidParameter":                    InvalidParameter,
+	"InvalidRequestContent":               InvalidParameter,
+	"InvalidRequestFormat":                InvalidParameter,
+	"InvalidResourceLocation":             InvalidParameter,
+	"InvalidResourceName":                 InvalidParameter,
+	"InvalidTemplate":                     InvalidParameter,
+	"LocationNotAvailableForResourceType": InvalidParameter,
+	"SkuNotAvailable":                     InvalidParameter,
+	"BadRequest":                          InvalidParameter,
+	"MissingSubscriptionRegistration":     InvalidParameter,
+	"AuthorizationFailed":                 AuthorizationFailed,
+	"LinkedAuthorizationFailed":           AuthorizationFailed,
+	"AuthenticationFailed":                AuthorizationFailed,
+	"InvalidAuthenticationToken":          AuthorizationFailed,
+	"InvalidAuthenticationTokenTenant":    AuthorizationFailed,
+	"Forbidden":                           AuthorizationFailed,
+	"InternalServerError":                 Transient,
+	"InternalError":                       Transient,
+	"RetryableError":                      Transient,
+	"ServiceUnavailable":                  Transient,
+	"GatewayTimeout":                      Transient,
+}
+
+// Error is a classified Azure error.
+type Error struct {
+	// Category is the classification of the error.
+	Category Category
+	// StatusCode is the HTTP status code of the failed request, or zero if no response was received.
+	StatusCode int
+	// Code is the error code returned by Azure, if any.
+	Code string
+	// Message is the error message returned by Azure, or the message of the original error.
+	Message string
+	// Target is the part of the request the error refers to, if Azure reported one.
+	Target string
+	// RequestID is the x-ms-request-id of the failed request, if known.
+	RequestID string
+	// CorrelationID is the x-ms-correlation-request-id of the failed request, if known.
+	// Azure support uses it to find every request made on behalf of one operation.
+	CorrelationID string
+	// Err is the original error.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Terminal returns true if retrying the same request cannot succeed without a change to the spec or the environment.
+// Bad requests without a known code are not terminal, since Azure returns them for many transient failures.
+func (e *Error) Terminal() bool {
+	switch e.Category {
+	case InvalidParameter, QuotaExceeded, AuthorizationFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Classify unwraps autorest and Azure errors and returns their category, code and message.
+// It returns nil for a nil error and an Unknown error for anything which carries no Azure details.
+func Classify(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	if classified, ok := err.(*Error); ok {
+		return classified
+	}
+	result := &Error{Err: err, Message: err.Error()}
+	unwrap(err, result)
+	result.Category = categorize(result, err)
+	return result
+}
+
+// CategoryOf returns the category of err, or the empty category for a nil error.
+func CategoryOf(err error) Category {
+	if err == nil {
+		return ""
+	}
+	return Classify(err).Category
+}
+
+// IsTerminal returns true if err should not be retried without a change to the spec or the environment.
+func IsTerminal(err error) bool {
+	if err == nil {
+		return false
+	}
+	return Classify(err).Terminal()
+}
+
+// IsNotFound returns true if err means the Azure resource does not exist.
+func IsNotFound(err error) bool {
+	return CategoryOf(err) == NotFound
+}
+
+// unwrap walks through wrapped errors and fills in details from the innermost Azure error.
+func unwrap(err error, result *Error) {
+	for err != nil {
+		switch e := err.(type) {
+		case *azure.RequestError:
+			setRequest(result, e.RequestID, e.ServiceError)
+			setStatus(result, e.DetailedError.StatusCode)
+			setResponse(result, e.DetailedError.Response)
+			err = e.DetailedError.Original
+			continue
+		case azure.RequestError:
+			setRequest(result, e.RequestID, e.ServiceError)
+			setStatus(result, e.DetailedError.StatusCode)
+			setResponse(result, e.DetailedError.Response)
+			err = e.DetailedError.Original
+			continue
+		case *azure.ServiceError:
+			setService(result, e)
+			return
+		case azure.ServiceError:
+			setService(result, &e)
+			return
+		case *autorest.DetailedError:
+			detailed(result, *e)
+			err = e.Original
+			continue
+		case autorest.DetailedError:
+			detailed(result, e)
+			err = e.Original
+			continue
+		}
+		cause := errors.Cause(err)
+		if cause == err {
+			return
+		}
+		err = cause
+	}
+}
+
+func detailed(result *Error, e autorest.DetailedError) {
+	setStatus(result, e.StatusCode)
+	setResponse(result, e.Response)
+	if result.Code == "" && len(e.ServiceError) > 0 {
+		var body struct {
+			Error *azure.ServiceError `json:"error"`
+		}
+		if json.Unmarshal(e.ServiceError, &body) == nil && body.Error != nil {
+			setService(result, body.Error)
+		}
+	}
+}
+
+func setResponse(result *Error, resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	if result.RequestID == "" {
+		result.RequestID = resp.Header.Get("x-ms-request-id")
+	}
+	if result.CorrelationID == "" {
+		result.CorrelationID = resp.Header.Get("x-ms-correlation-request-id")
+	}
+}
+
+func setRequest(result *Error, requestID string, se *azure.ServiceError) {
+	if result.RequestID == "" {
+		result.RequestID = requestID
+	}
+	if se != nil {
+		setService(result, se)
+	}
+}
+
+func setService(result *Error, se *azure.ServiceError) {
+	if se == nil || se.Code == "" || result.Code != "" {
+		return
+	}
+	result.Code = se.Code
+	result.Message = se.Message
+	if se.Target != nil {
+		result.Target = *se.Target
+	}
+}
+
+func setStatus(result *Error, status interface{}) {
+	if result.StatusCode != 0 {
+		return
+	}
+	if code, ok := status.(int); ok {
+		result.StatusCode = code
+	}
+}
+
+func categorize(result *Error, err error) Category {
+	if category, ok := codes[result.Code]; ok {
+		return category
+	}
+	if strings.Contains(result.Code, "Quota") {
+		return QuotaExceeded
+	}
+	switch {
+	case result.StatusCode == http.StatusNotFound:
+		return NotFound
+	case result.StatusCode == http.StatusConflict:
+		return Conflict
+	case result.StatusCode == http.StatusTooManyRequests:
+		return Throttled
+	case result.StatusCode == http.StatusUnauthorized || result.StatusCode == http.StatusForbidden:
+		return AuthorizationFailed
+	case result.StatusCode >= http.StatusInternalServerError:
+		return Transient
+	case isNetworkError(err):
+		return Transient
+	default:
+		return Unknown
+	}
+}
+
+// isNetworkError returns true if err or one of the errors it wraps is a network or URL error.
+func isNetworkError(err error) bool {
+	for err != nil {
+		switch e := err.(type) {
+		case *url.Error:
+			return true
+		case net.Error:
+			return true
+		case autorest.DetailedError:
+			err = e.Original
+			continue
+		case *autorest.DetailedError:
+			err = e.Original
+			continue
+		}
+		cause := errors.Cause(err)
+		if cause == err {
+			return false
+		}
+		err = cause
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package azerrors_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/azerrors"
+)
+
+// requestError builds an error shaped like the ones returned by SDK clients for a failed response.
+func requestError(status int, code, message string) error {
+	resp := &http.Response{StatusCode: status, Header: http.Header{
+		"X-Ms-Request-Id":             []string{"request-id"},
+		"X-Ms-Correlation-Request-Id": []string{"correlation-id"},
+	}}
+	inner := &azure.RequestError{
+		DetailedError: autorest.DetailedError{StatusCode: status, Response: resp},
+		ServiceError:  &azure.ServiceError{Code: code, Message: message, Target: to.StringPtr("sku")},
+		RequestID:     "request-id",
+	}
+	return autorest.NewErrorWithError(inner, "resources.GroupsClient", "Get", resp, "Failure responding to request")
+}
+
+var _ = Describe("azerrors", func() {
+
+	DescribeTable("should classify Azure responses",
+		func(err error, category azerrors.Category, terminal bool) {
+			Expect(azerrors.CategoryOf(err)).To(Equal(category))
+			Expect(azerrors.IsTerminal(err)).To(Equal(terminal))
+		},
+		Entry("not found", requestError(http.StatusNotFound, "ResourceGroupNotFound", "missing"), azerrors.NotFound, false),
+		Entry("conflict", requestError(http.StatusConflict, "AnotherOperationInProgress", "busy"), azerrors.Conflict, false),
+		Entry("throttled", requestError(http.StatusTooManyRequests, "SubscriptionRequestsThrottled", "slow down"), azerrors.Throttled, false),
+		Entry("quota", requestError(http.StatusConflict, "QuotaExceeded", "no cores left"), azerrors.QuotaExceeded, true),
+		Entry("quota by code suffix", requestError(http.StatusBadRequest, "RegionalCoreQuota", "no cores left"), azerrors.QuotaExceeded, true),
+		Entry("invalid sku", requestError(http.StatusBadRequest, "SkuNotAvailable", "bad sku"), azerrors.InvalidParameter, true),
+		Entry("unknown bad request", requestError(http.StatusBadRequest, "SomethingNew", "bad"), azerrors.Unknown, false),
+		Entry("operation not allowed", requestError(http.StatusBadRequest, "OperationNotAllowed", "try later"), azerrors.Unknown, false),
+		Entry("authorization", requestError(http.StatusForbidden, "AuthorizationFailed", "denied"), azerrors.AuthorizationFailed, true),
+		Entry("server error", requestError(http.StatusInternalServerError, "", ""), azerrors.Transient, false),
+		Entry("network error", autorest.NewErrorWithError(&url.Error{Op: "Get", URL: "https://management.azure.com", Err: errors.New("connection reset")}, "resources.GroupsClient", "Get", nil, "Failure sending request"), azerrors.Transient, false),
+		Entry("plain error", errors.New("boom"), azerrors.Unknown, false),
+	)
+
+	It("should surface the Azure code, message, target, request and correlation IDs", func() {
+		classified := azerrors.Classify(pkgerrors.Wrap(requestError(http.StatusBadRequest, "InvalidParameter", "location is invalid"), "failed to ensure"))
+		Expect(classified.Code).To(Equal("InvalidParameter"))
+		Expect(classified.Message).To(Equal("location is invalid"))
+		Expect(classified.Target).To(Equal("sku"))
+		Expect(classified.RequestID).To(Equal("request-id"))
+		Expect(classified.CorrelationID).To(Equal("correlation-id"))
+		Expect(classified.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(classified.Error()).To(Equal("InvalidParameter: location is invalid"))
+	})
+
+	It("should classify failed long running operations", func() {
+		err := autorest.NewErrorWithError(&azure.ServiceError{Code: "SkuNotAvailable", Message: "sold out"}, "compute.VirtualMachinesCreateOrUpdateFuture", "Result", nil, "Failure polling")
+		Expect(azerrors.CategoryOf(err)).To(Equal(azerrors.InvalidParameter))
+		Expect(azerrors.Classify(err).Message).To(Equal("sold out"))
+	})
+
+	It("should ignore nil errors", func() {
+		Expect(azerrors.Classify(nil)).To(BeNil())
+		Expect(azerrors.IsTerminal(nil)).To(BeFalse())
+	})
+})
@@ -0,0 +1,562 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package azfake
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	stateSucceeded = "Succeeded"
+	stateFailed    = "Failed"
+	stateCreating  = "Creating"
+	stateUpdating  = "Updating"
+	stateDeleting  = "Deleting"
+	stateRunning   = "InProgress"
+
+	resourceGroupType  = "Microsoft.Resources/resourceGroups"
+	trafficManagerType = "Microsoft.Network/trafficManagerProfiles"
+)
+
+// behavior describes which requests for a resource type start long running operations in Azure.
+type behavior struct {
+	asyncPut, asyncPatch, asyncDelete bool
+}
+
+// behaviors lists the resource types which answer some requests synchronously, keyed by lower case type.
+// Every other type starts long running operations for all writes.
+var behaviors = map[string]behavior{
+	"microsoft.resources/resourcegroups":               {asyncDelete: true},
+	"microsoft.managedidentity/userassignedidentities": {},
+	"microsoft.keyvault/vaults":                        {},
+	"microsoft.network/trafficmanagerprofiles":         {},
+	"microsoft.sql/servers/firewallrules":              {},
+	"microsoft.storage/storageaccounts":                {asyncPut: true},
+}
+
+func behaviorOf(typ string) behavior {
+	if b, ok := behaviors[strings.ToLower(typ)]; ok {
+		return b
+	}
+	return behavior{asyncPut: true, asyncPatch: true, asyncDelete: true}
+}
+
+// writeOnly lists properties Azure accepts but never returns.
+var writeOnly = map[string]bool{
+	"administratorLoginPassword": true,
+	"adminPassword":              true,
+}
+
+// resource is a resource stored by the emulator.
+type resource struct {
+	target
+	body map[string]interface{}
+	// pending is the long running operation currently changing the resource.
+	pending *operation
+}
+
+// operation is a long running operation started by a PUT, PATCH or DELETE.
+type operation struct {
+	id        string
+	method    string
+	key       string
+	remaining int
+	status    string
+	fault     *Fault
+}
+
+// target is a resource addressed by a request path.
+type target struct {
+	id   string
+	typ  string
+	name string
+	// parent is the ID of the parent resource, or of the resource group of top level resources.
+	parent string
+	// group is the ID of the resource group holding the resource, if any.
+	group string
+	// trailing is the segment following the resource, i.e. an action or the type of listed child resources.
+	trailing string
+}
+
+func (t target) key() string {
+	return strings.ToLower(t.id)
+}
+
+// parse splits a request path into the resource it addresses, e.g.
+// /subscriptions/id/resourceGroups/group/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet.
+// Paths with a trailing segment address an action or a collection of the resource.
+func parse(path string) (target, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	n := len(segments)
+	if n < 3 || !strings.EqualFold(segments[0], "subscriptions") {
+		return target{}, false
+	}
+	// IDs are returned in the casing of Azure, whatever the casing of the request.
+	segments[0] = "subscriptions"
+	if strings.EqualFold(segments[2], "resourceGroups") {
+		segments[2] = "resourceGroups"
+	}
+	id := func(i int) string {
+		return "/" + strings.Join(segments[:i], "/")
+	}
+	var t target
+	i := 2
+	if strings.EqualFold(segments[i], "resourceGroups") {
+		if n == 3 {
+			return target{id: id(2), trailing: segments[2]}, true
+		}
+		t = target{id: id(4), typ: resourceGroupType, name: segments[3], group: id(4)}
+		if n == 5 {
+			t.trailing = segments[4]
+		}
+		if n <= 5 {
+			return t, true
+		}
+		i = 4
+	}
+	if !strings.EqualFold(segments[i], "providers") || n < i+3 {
+		return target{}, false
+	}
+	segments[i] = "providers"
+	scope := i
+	typ := segments[i+1]
+	resource := target{group: t.group}
+	parent := t.group
+	for i += 2; i+1 < n; i += 2 {
+		typ += "/" + segments[i]
+		resource.parent = parent
+		resource.id = id(i + 2)
+		resource.typ = typ
+		resource.name = segments[i+1]
+		parent = resource.id
+	}
+	if resource.id == "" {
+		// A collection of top level resources, e.g. the SKUs of a provider.
+		return target{id: id(scope), group: t.group, trailing: strings.Join(segments[scope:], "/")}, true
+	}
+	if i < n {
+		resource.trailing = segments[i]
+	}
+	return resource, true
+}
+
+// store saves body as the resource addressed by t, replacing any previous version.
+func (s *Server) store(t target, body map[string]interface{}) *resource {
+	stripWriteOnly(body)
+	body["id"] = t.id
+	body["name"] = t.name
+	body["type"] = t.typ
+	if strings.EqualFold(t.typ, trafficManagerType) {
+		setMonitorStatus(body)
+	}
+	stored, ok := s.resources[t.key()]
+	if !ok {
+		stored = &resource{target: t}
+		s.resources[t.key()] = stored
+	}
+	stored.body = body
+	return stored
+}
+
+// remove deletes the resource stored at key and every resource below it.
+func (s *Server) remove(key string) {
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, path string) {
+	t, ok := parse(path)
+	if !ok {
+		writeError(w, http.StatusNotFound, "InvalidResourceType", fmt.Sprintf("The path %q is not served by the emulator.", path))
+		return
+	}
+	if t.trailing != "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, path)
+		case http.MethodPost:
+			s.action(w, t)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %s is not allowed on %q.", r.Method, path))
+		}
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, t)
+	case http.MethodPut, http.MethodPatch:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+			return
+		}
+		body, err := decode(data)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("The request content was invalid and could not be deserialized: %v", err))
+			return
+		}
+		if r.Method == http.MethodPut {
+			s.put(w, r, t, body)
+		} else {
+			s.patch(w, r, t, body)
+		}
+	case http.MethodDelete:
+		s.delete(w, r, t)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %s is not allowed on %q.", r.Method, path))
+	}
+}
+
+// notFound writes the error Azure returns for a missing resource, or for its missing resource group.
+func (s *Server) notFound(w http.ResponseWriter, t target) {
+	if t.group != "" {
+		if _, ok := s.resources[strings.ToLower(t.group)]; !ok || t.typ == resourceGroupType {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", groupName(t.group)))
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s/%s' under resource group '%s' was not found.", t.typ, t.name, groupName(t.group)))
+}
+
+func (s *Server) get(w http.ResponseWriter, t target) {
+	stored, ok := s.resources[t.key()]
+	if !ok {
+		s.notFound(w, t)
+		return
+	}
+	s.poll(stored.pending)
+	if stored, ok = s.resources[t.key()]; !ok {
+		s.notFound(w, t)
+		return
+	}
+	writeJSON(w, http.StatusOK, stored.body)
+}
+
+// writable checks a resource may be written, i.e. its parents exist and no operation is changing it.
+func (s *Server) writable(w http.ResponseWriter, t target, stored *resource) bool {
+	if t.parent != "" {
+		if parent, ok := s.resources[strings.ToLower(t.parent)]; !ok {
+			if t.parent == t.group {
+				writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", groupName(t.group)))
+			} else {
+				writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", t.parent))
+			}
+			return false
+		} else if parent.pending != nil && parent.pending.method == http.MethodDelete {
+			writeError(w, http.StatusConflict, "ResourceGroupBeingDeleted", fmt.Sprintf("The resource '%s' is being deleted.", t.parent))
+			return false
+		}
+	}
+	if stored != nil && stored.pending != nil {
+		writeError(w, http.StatusConflict, "AnotherOperationInProgress", fmt.Sprintf("Another operation on the resource '%s' is in progress.", t.id))
+		return false
+	}
+	return true
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, t target, body map[string]interface{}) {
+	stored := s.resources[t.key()]
+	if !s.writable(w, t, stored) {
+		return
+	}
+	status, state := http.StatusCreated, stateCreating
+	if stored != nil {
+		status, state = http.StatusOK, stateUpdating
+	}
+	stored = s.store(t, body)
+	s.write(w, r, stored, status, state, behaviorOf(t.typ).asyncPut)
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, t target, body map[string]interface{}) {
+	stored := s.resources[t.key()]
+	if stored == nil {
+		s.notFound(w, t)
+		return
+	}
+	if !s.writable(w, t, stored) {
+		return
+	}
+	merged := merge(stored.body, body)
+	stored = s.store(t, merged)
+	s.write(w, r, stored, http.StatusOK, stateUpdating, behaviorOf(t.typ).asyncPatch)
+}
+
+// write finishes a PUT or PATCH, starting a long running operation when the type is asynchronous.
+func (s *Server) write(w http.ResponseWriter, r *http.Request, stored *resource, status int, state string, async bool) {
+	if !async {
+		setProvisioningState(stored.body, stateSucceeded)
+		writeJSON(w, status, stored.body)
+		return
+	}
+	setProvisioningState(stored.body, state)
+	op := s.start(r, stored)
+	if op.status == stateRunning {
+		w.Header().Set("Azure-AsyncOperation", s.operationURL(r, op))
+		w.Header().Set("Retry-After", "1")
+	}
+	writeJSON(w, status, stored.body)
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, t target) {
+	stored := s.resources[t.key()]
+	if stored == nil {
+		if t.typ == resourceGroupType {
+			s.notFound(w, t)
+			return
+		}
+		writeJSON(w, http.StatusNoContent, nil)
+		return
+	}
+	if stored.pending != nil {
+		if stored.pending.method == http.MethodDelete {
+			w.Header().Set("Azure-AsyncOperation", s.operationURL(r, stored.pending))
+			writeJSON(w, http.StatusAccepted, nil)
+			return
+		}
+		writeError(w, http.StatusConflict, "AnotherOperationInProgress", fmt.Sprintf("Another operation on the resource '%s' is in progress.", t.id))
+		return
+	}
+	if !behaviorOf(t.typ).asyncDelete {
+		s.remove(t.key())
+		writeJSON(w, http.StatusOK, nil)
+		return
+	}
+	setProvisioningState(stored.body, stateDeleting)
+	op := s.start(r, stored)
+	if op.status != stateRunning {
+		writeJSON(w, http.StatusOK, nil)
+		return
+	}
+	w.Header().Set("Azure-AsyncOperation", s.operationURL(r, op))
+	w.Header().Set("Retry-After", "1")
+	writeJSON(w, http.StatusAccepted, nil)
+}
+
+// start begins a long running operation changing stored, which finishes at once when the emulator takes no polls.
+func (s *Server) start(r *http.Request, stored *resource) *operation {
+	op := &operation{
+		id:        s.newID(),
+		method:    r.Method,
+		key:       stored.key(),
+		remaining: s.polls,
+		status:    stateRunning,
+		fault:     s.fault(r.Method, stored.key(), true),
+	}
+	s.operations[op.id] = op
+	stored.pending = op
+	if op.remaining <= 0 {
+		s.finish(op)
+	}
+	return op
+}
+
+// poll advances op, finishing it once it has been polled often enough.
+func (s *Server) poll(op *operation) {
+	if op == nil || op.status != stateRunning {
+		return
+	}
+	op.remaining--
+	if op.remaining <= 0 {
+		s.finish(op)
+	}
+}
+
+// finish completes op, applying its outcome to the resource it changes.
+func (s *Server) finish(op *operation) {
+	stored, ok := s.resources[op.key]
+	if ok {
+		stored.pending = nil
+	}
+	if op.fault != nil {
+		op.status = stateFailed
+		if ok {
+			setProvisioningState(stored.body, stateFailed)
+		}
+		return
+	}
+	op.status = stateSucceeded
+	if !ok {
+		return
+	}
+	if op.method == http.MethodDelete {
+		s.remove(op.key)
+		return
+	}
+	setProvisioningState(stored.body, stateSucceeded)
+}
+
+func (s *Server) operationURL(r *http.Request, op *operation) string {
+	return fmt.Sprintf("http://%s/operations/%s", r.Host, op.id)
+}
+
+func (s *Server) serveOperation(w http.ResponseWriter, id string) {
+	op, ok := s.operations[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The operation '%s' was not found.", id))
+		return
+	}
+	s.poll(op)
+	status := map[string]interface{}{
+		"name":   op.id,
+		"status": op.status,
+	}
+	if op.fault != nil && op.status == stateFailed {
+		status["error"] = serviceError{Code: op.fault.Code, Message: op.fault.Message}
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// list writes the resources directly below path, e.g. the subnets of a virtual network.
+func (s *Server) list(w http.ResponseWriter, path string) {
+	prefix := strings.ToLower(path) + "/"
+	var keys []string
+	for key := range s.resources {
+		if strings.HasPrefix(key, prefix) && !strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	values := []interface{}{}
+	for _, key := range keys {
+		values = append(values, s.resources[key].body)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"value": values})
+}
+
+// action serves POST requests for actions on a resource, e.g. listKeys.
+func (s *Server) action(w http.ResponseWriter, t target) {
+	if !strings.EqualFold(t.trailing, "listKeys") {
+		writeError(w, http.StatusNotFound, "InvalidResourceType", fmt.Sprintf("The action '%s' is not served by the emulator.", t.trailing))
+		return
+	}
+	_, ok := s.resources[t.key()]
+	// Service Bus namespaces come with a root authorization rule which is not created separately.
+	if !ok && strings.EqualFold(t.typ, "Microsoft.ServiceBus/namespaces/AuthorizationRules") && strings.EqualFold(t.name, "RootManageSharedAccessKey") {
+		_, ok = s.resources[strings.ToLower(t.parent)]
+	}
+	if !ok {
+		s.notFound(w, t)
+		return
+	}
+	key := func(name string) string {
+		sum := sha256.Sum256([]byte(t.key() + "/" + name))
+		return base64.StdEncoding.EncodeToString(sum[:])
+	}
+	switch strings.ToLower(t.typ) {
+	case "microsoft.storage/storageaccounts":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"keys": []map[string]string{
+				{"keyName": "key1", "value": key("key1"), "permissions": "FULL"},
+				{"keyName": "key2", "value": key("key2"), "permissions": "FULL"},
+			},
+		})
+	case "microsoft.cache/redis":
+		writeJSON(w, http.StatusOK, map[string]string{"primaryKey": key("primary"), "secondaryKey": key("secondary")})
+	case "microsoft.servicebus/namespaces/authorizationrules":
+		namespace := t.parent[strings.LastIndex(t.parent, "/")+1:]
+		connectionString := func(name string) string {
+			return fmt.Sprintf("Endpoint=sb://%s.servicebus.windows.net/;SharedAccessKeyName=%s;SharedAccessKey=%s", namespace, t.name, key(name))
+		}
+		writeJSON(w, http.StatusOK, map[string]string{
+			"primaryConnectionString":   connectionString("primary"),
+			"secondaryConnectionString": connectionString("secondary"),
+			"primaryKey":                key("primary"),
+			"secondaryKey":              key("secondary"),
+			"keyName":                   t.name,
+		})
+	default:
+		writeError(w, http.StatusNotFound, "InvalidResourceType", fmt.Sprintf("The emulator does not list keys of %s.", t.typ))
+	}
+}
+
+func groupName(group string) string {
+	return group[strings.LastIndex(group, "/")+1:]
+}
+
+func provisioningState(body map[string]interface{}) string {
+	properties, _ := body["properties"].(map[string]interface{})
+	state, _ := properties["provisioningState"].(string)
+	return state
+}
+
+func setProvisioningState(body map[string]interface{}, state string) {
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = map[string]interface{}{}
+		body["properties"] = properties
+	}
+	properties["provisioningState"] = state
+}
+
+// setMonitorStatus sets the monitor status Azure computes for a Traffic Manager profile, as if every endpoint were healthy:
+// Disabled for disabled profiles, Inactive for profiles without enabled endpoints, and Online otherwise.
+func setMonitorStatus(body map[string]interface{}) {
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	monitor, ok := properties["monitorConfig"].(map[string]interface{})
+	if !ok {
+		monitor = map[string]interface{}{}
+		properties["monitorConfig"] = monitor
+	}
+	status := "Inactive"
+	endpoints, _ := properties["endpoints"].([]interface{})
+	for _, endpoint := range endpoints {
+		endpointProperties, _ := endpoint.(map[string]interface{})["properties"].(map[string]interface{})
+		if endpointProperties["endpointStatus"] != "Disabled" {
+			status = "Online"
+		}
+	}
+	if properties["profileStatus"] == "Disabled" {
+		status = "Disabled"
+	}
+	monitor["profileMonitorStatus"] = status
+}
+
+// merge applies a PATCH body to a resource. Objects are merged recursively, except tags which are replaced.
+func merge(base, patch map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range patch {
+		baseObject, baseOK := merged[k].(map[string]interface{})
+		patchObject, patchOK := v.(map[string]interface{})
+		if baseOK && patchOK && k != "tags" {
+			merged[k] = merge(baseObject, patchObject)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// stripWriteOnly removes the properties Azure never returns from a resource.
+func stripWriteOnly(v interface{}) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if writeOnly[k] {
+				delete(value, k)
+				continue
+			}
+			stripWriteOnly(field)
+		}
+	case []interface{}:
+		for _, item := range value {
+			stripWriteOnly(item)
+		}
+	}
+}
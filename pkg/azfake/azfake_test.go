@@ -0,0 +1,361 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package azfake_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azerrors"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azfake"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/resourcegroups"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/secrets"
+)
+
+const (
+	subscription = "00000000-0000-0000-0000-000000000000"
+	groupID      = "/subscriptions/" + subscription + "/resourceGroups/group"
+	vnetID       = groupID + "/providers/Microsoft.Network/virtualNetworks/vnet"
+)
+
+var _ = Describe("azfake", func() {
+
+	var (
+		ctx    context.Context
+		server *azfake.Server
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = azfake.NewServer()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	groups := func() resources.GroupsClient {
+		client := resources.NewGroupsClientWithBaseURI(server.URL, subscription)
+		client.Authorizer = server.Authorizer()
+		return client
+	}
+
+	vnets := func() network.VirtualNetworksClient {
+		client := network.NewVirtualNetworksClientWithBaseURI(server.URL, subscription)
+		client.Authorizer = server.Authorizer()
+		return client
+	}
+
+	createGroup := func() {
+		_, err := groups().CreateOrUpdate(ctx, "group", resources.Group{Location: to.StringPtr("westus2")})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should reject requests without the token", func() {
+		client := groups()
+		client.Authorizer = nil
+		_, err := client.Get(ctx, "group")
+		Expect(azerrors.Classify(err).StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should reconcile resource groups with the client of the repository", func() {
+		configuration, err := server.Config()
+		Expect(err).ToNot(HaveOccurred())
+		client := resourcegroups.New(configuration)
+		group := &azurev1alpha1.ResourceGroup{
+			Spec: azurev1alpha1.ResourceGroupSpec{Name: "group", Location: "westus2", SubscriptionID: subscription},
+		}
+
+		done, err := client.Ensure(ctx, group)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeFalse())
+		done, err = client.Ensure(ctx, group)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeTrue())
+		Expect(*group.Status.ID).To(Equal(groupID))
+
+		var remote resources.Group
+		found, err := server.Resource(groupID, &remote)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(*remote.Location).To(Equal("westus2"))
+
+		// Resource groups are deleted by a long running operation, finished by the next poll.
+		found, err = client.Delete(ctx, group)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+		found, err = server.Resource(groupID, &remote)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("should create resources with long running operations", func() {
+		createGroup()
+		future, err := vnets().CreateOrUpdate(ctx, "group", "vnet", network.VirtualNetwork{
+			Location: to.StringPtr("westus2"),
+			VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+				AddressSpace: &network.AddressSpace{AddressPrefixes: &[]string{"10.0.0.0/16"}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(future.PollingMethod()).To(Equal(azure.PollingAsyncOperation))
+
+		var vnet network.VirtualNetwork
+		_, err = server.Resource(vnetID, &vnet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*vnet.ProvisioningState).To(Equal("Creating"))
+
+		// Writes are rejected until the operation finishes.
+		_, err = vnets().CreateOrUpdate(ctx, "group", "vnet", vnet)
+		Expect(azerrors.Classify(err).Code).To(Equal("AnotherOperationInProgress"))
+
+		done, err := future.DoneWithContext(ctx, vnets())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeTrue())
+		vnet, err = vnets().Get(ctx, "group", "vnet", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*vnet.ID).To(Equal(vnetID))
+		Expect(*vnet.Name).To(Equal("vnet"))
+		Expect(*vnet.Type).To(Equal("Microsoft.Network/virtualNetworks"))
+		Expect(*vnet.ProvisioningState).To(Equal("Succeeded"))
+		Expect(*vnet.AddressSpace.AddressPrefixes).To(ConsistOf("10.0.0.0/16"))
+	})
+
+	It("should answer like Azure for missing resources and parents", func() {
+		_, err := vnets().Get(ctx, "group", "vnet", "")
+		Expect(azerrors.Classify(err).Code).To(Equal("ResourceGroupNotFound"))
+
+		createGroup()
+		vnet, err := vnets().Get(ctx, "group", "vnet", "")
+		Expect(vnet.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(azerrors.Classify(err).Code).To(Equal("ResourceNotFound"))
+
+		subnets := network.NewSubnetsClientWithBaseURI(server.URL, subscription)
+		subnets.Authorizer = server.Authorizer()
+		_, err = subnets.CreateOrUpdate(ctx, "group", "vnet", "subnet", network.Subnet{})
+		Expect(azerrors.Classify(err).Code).To(Equal("ParentResourceNotFound"))
+
+		// Deleting a missing resource succeeds.
+		future, err := vnets().Delete(ctx, "group", "vnet")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(future.Response().StatusCode).To(Equal(http.StatusNoContent))
+	})
+
+	It("should delete child resources with their parents", func() {
+		Expect(server.Seed(vnetID, network.VirtualNetwork{Location: to.StringPtr("westus2")})).To(Succeed())
+		Expect(server.Seed(vnetID+"/subnets/subnet", network.Subnet{})).To(Succeed())
+		Expect(server.Seed(groupID, resources.Group{Location: to.StringPtr("westus2")})).To(Succeed())
+
+		subnets := network.NewSubnetsClientWithBaseURI(server.URL, subscription)
+		subnets.Authorizer = server.Authorizer()
+		list, err := subnets.List(ctx, "group", "vnet")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Values()).To(HaveLen(1))
+		Expect(*list.Values()[0].ProvisioningState).To(Equal("Succeeded"))
+
+		future, err := groups().Delete(ctx, "group")
+		Expect(err).ToNot(HaveOccurred())
+		done, err := future.DoneWithContext(ctx, groups())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeTrue())
+		found, err := server.Resource(vnetID+"/subnets/subnet", &network.Subnet{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("should inject faults into requests and operations", func() {
+		createGroup()
+		server.Inject(azfake.Fault{Method: http.MethodGet, Path: vnetID, Status: http.StatusForbidden, Code: "AuthorizationFailed", Times: 1})
+		server.Inject(azfake.Fault{Method: http.MethodPut, Path: vnetID, Code: "QuotaExceeded", Message: "out of networks", Operation: true})
+
+		_, err := vnets().Get(ctx, "group", "vnet", "")
+		Expect(azerrors.Classify(err).Code).To(Equal("AuthorizationFailed"))
+		_, err = vnets().Get(ctx, "group", "vnet", "")
+		Expect(azerrors.Classify(err).Code).To(Equal("ResourceNotFound"))
+
+		future, err := vnets().CreateOrUpdate(ctx, "group", "vnet", network.VirtualNetwork{Location: to.StringPtr("westus2")})
+		Expect(err).ToNot(HaveOccurred())
+		done, err := future.DoneWithContext(ctx, vnets())
+		Expect(done).To(BeTrue())
+		Expect(azerrors.Classify(err).Code).To(Equal("QuotaExceeded"))
+		vnet, err := vnets().Get(ctx, "group", "vnet", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*vnet.ProvisioningState).To(Equal("Failed"))
+	})
+
+	It("should finish operations at once without polls", func() {
+		server.Close()
+		server = azfake.NewServer(azfake.Polls(0))
+		createGroup()
+		future, err := vnets().CreateOrUpdate(ctx, "group", "vnet", network.VirtualNetwork{Location: to.StringPtr("westus2")})
+		Expect(err).ToNot(HaveOccurred())
+		done, err := future.DoneWithContext(ctx, vnets())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(done).To(BeTrue())
+		Expect(server.Requests()).To(Equal([]string{
+			"PUT /subscriptions/" + subscription + "/resourcegroups/group",
+			"PUT " + vnetID,
+		}))
+	})
+
+	It("should update resources and list keys", func() {
+		createGroup()
+		accounts := storage.NewAccountsClientWithBaseURI(server.URL, subscription)
+		accounts.Authorizer = server.Authorizer()
+		Expect(server.Seed(groupID+"/providers/Microsoft.Storage/storageAccounts/account", storage.Account{
+			Location: to.StringPtr("westus2"),
+			Tags:     map[string]*string{"team": to.StringPtr("infra")},
+		})).To(Succeed())
+
+		account, err := accounts.Update(ctx, "group", "account", storage.AccountUpdateParameters{
+			Tags: map[string]*string{"env": to.StringPtr("test")},
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				EnableHTTPSTrafficOnly: to.BoolPtr(true),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(account.Tags).To(Equal(map[string]*string{"env": to.StringPtr("test")}))
+		Expect(*account.Location).To(Equal("westus2"))
+		Expect(*account.EnableHTTPSTrafficOnly).To(BeTrue())
+
+		keys, err := accounts.ListKeys(ctx, "group", "account")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*keys.Keys).To(HaveLen(2))
+		Expect(*(*keys.Keys)[0].Value).ToNot(BeEmpty())
+		again, err := accounts.ListKeys(ctx, "group", "account")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again.Keys).To(Equal(keys.Keys))
+	})
+
+	Describe("Key Vault", func() {
+
+		var vaults keyvault.BaseClient
+
+		BeforeEach(func() {
+			vaults = keyvault.New()
+			vaults.Authorizer = server.Authorizer()
+		})
+
+		It("should serve secrets", func() {
+			_, err := vaults.GetSecret(ctx, server.VaultURL("vault"), "secret", "")
+			Expect(azerrors.Classify(err).Code).To(Equal("SecretNotFound"))
+
+			first, err := vaults.SetSecret(ctx, server.VaultURL("vault"), "secret", keyvault.SecretSetParameters{Value: to.StringPtr("one")})
+			Expect(err).ToNot(HaveOccurred())
+			server.SetSecret("vault", "secret", "two")
+
+			latest, err := vaults.GetSecret(ctx, server.VaultURL("vault"), "secret", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*latest.Value).To(Equal("two"))
+			Expect(*latest.ID).ToNot(Equal(*first.ID))
+
+			previous, err := vaults.GetSecret(ctx, server.VaultURL("vault"), "secret", (*first.ID)[len(*first.ID)-32:])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*previous.Value).To(Equal("one"))
+
+			_, err = vaults.DeleteSecret(ctx, server.VaultURL("vault"), "secret")
+			Expect(err).ToNot(HaveOccurred())
+			_, found := server.Secret("vault", "secret")
+			Expect(found).To(BeFalse())
+		})
+
+		It("should import certificates with their backing secret", func() {
+			pfx := newPFX("password")
+			bundle, err := vaults.ImportCertificate(ctx, server.VaultURL("vault"), "cert", keyvault.CertificateImportParameters{
+				Base64EncodedCertificate: to.StringPtr(base64.StdEncoding.EncodeToString(pfx)),
+				Password:                 to.StringPtr("password"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*bundle.Sid).To(ContainSubstring("/secrets/cert/"))
+
+			cert, err := vaults.GetCertificate(ctx, server.VaultURL("vault"), "cert", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*cert.Cer).To(Equal(*bundle.Cer))
+			Expect(*cert.X509Thumbprint).ToNot(BeEmpty())
+
+			secret, err := vaults.GetSecret(ctx, server.VaultURL("vault"), "cert", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*secret.ContentType).To(Equal("application/x-pkcs12"))
+			data, err := base64.StdEncoding.DecodeString(*secret.Value)
+			Expect(err).ToNot(HaveOccurred())
+			_, leaf, err := pkcs12.Decode(data, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leaf.Raw).To(Equal(*cert.Cer))
+
+			_, err = vaults.DeleteCertificate(ctx, server.VaultURL("vault"), "cert")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = vaults.GetSecret(ctx, server.VaultURL("vault"), "cert", "")
+			Expect(azerrors.Classify(err).Code).To(Equal("SecretNotFound"))
+		})
+
+		It("should sync secrets into Kubernetes with the client of the repository", func() {
+			scheme := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(azurev1alpha1.AddToScheme(scheme)).To(Succeed())
+			kubeclient := fake.NewFakeClientWithScheme(scheme)
+
+			configuration, err := server.Config()
+			Expect(err).ToNot(HaveOccurred())
+			client, err := secrets.New(configuration, &kubeclient, scheme)
+			Expect(err).ToNot(HaveOccurred())
+
+			server.SetSecret("vault", "password", "hunter2")
+			secret := &azurev1alpha1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "password", Namespace: "default"},
+				Spec: azurev1alpha1.SecretSpec{
+					SecretIdentifier: azurev1alpha1.SecretIdentifier{Name: "password", Vault: "vault"},
+				},
+			}
+			Expect(client.Ensure(ctx, secret)).To(Succeed())
+
+			var local corev1.Secret
+			Expect(kubeclient.Get(ctx, types.NamespacedName{Name: "password", Namespace: "default"}, &local)).To(Succeed())
+			Expect(local.Data).To(HaveKeyWithValue("password", []byte("hunter2")))
+		})
+	})
+})
+
+// newPFX returns a PFX holding a new self signed certificate and its key, encrypted with password.
+func newPFX(password string) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "azfake"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	pfx, err := pkcs12.Encode(rand.Reader, key, cert, nil, password)
+	Expect(err).ToNot(HaveOccurred())
+	return pfx
+}
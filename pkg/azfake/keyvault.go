@@ -0,0 +1,365 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package azfake
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+)
+
+// pkcs12ContentType is the content type of the secrets backing certificates, holding a base64 encoded PFX.
+const pkcs12ContentType = "application/x-pkcs12"
+
+// vault holds the secrets and certificates of a Key Vault, keyed by lower case name.
+type vault struct {
+	secrets      map[string]*secret
+	certificates map[string]*certificate
+}
+
+// secret is a Key Vault secret, with its versions from oldest to newest.
+type secret struct {
+	name     string
+	versions []secretVersion
+}
+
+type secretVersion struct {
+	version     string
+	value       string
+	contentType string
+	tags        map[string]string
+	created     int64
+	// managed is true for the secrets backing certificates.
+	managed bool
+}
+
+// certificate is a Key Vault certificate, with its versions from oldest to newest.
+type certificate struct {
+	name     string
+	versions []certificateVersion
+}
+
+type certificateVersion struct {
+	version string
+	cer     []byte
+	tags    map[string]string
+	created int64
+}
+
+// SetSecret stores a new version of the secret name in vault, as if it had been set in Azure.
+func (s *Server) SetSecret(vault, name, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setSecret(vault, name, secretVersion{value: value})
+}
+
+// Secret returns the latest value of the secret name in vault.
+func (s *Server) Secret(vault, name string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.vault(vault).secrets[strings.ToLower(name)]
+	if !ok {
+		return "", false
+	}
+	return stored.versions[len(stored.versions)-1].value, true
+}
+
+// ImportCertificate stores a new version of the certificate name in vault from a PFX, and the secret backing it.
+func (s *Server) ImportCertificate(vault, name string, pfx []byte, password string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.importCertificate(vault, name, pfx, password, nil)
+	return err
+}
+
+func (s *Server) vault(name string) *vault {
+	v, ok := s.vaults[strings.ToLower(name)]
+	if !ok {
+		v = &vault{secrets: map[string]*secret{}, certificates: map[string]*certificate{}}
+		s.vaults[strings.ToLower(name)] = v
+	}
+	return v
+}
+
+func (s *Server) newVersion() string {
+	s.sequence++
+	return fmt.Sprintf("%032x", s.sequence)
+}
+
+func (s *Server) setSecret(vault, name string, version secretVersion) secretVersion {
+	v := s.vault(vault)
+	stored, ok := v.secrets[strings.ToLower(name)]
+	if !ok {
+		stored = &secret{name: name}
+		v.secrets[strings.ToLower(name)] = stored
+	}
+	version.version = s.newVersion()
+	version.created = time.Now().Unix()
+	stored.versions = append(stored.versions, version)
+	return version
+}
+
+func (s *Server) importCertificate(vault, name string, pfx []byte, password string, tags map[string]string) (certificateVersion, error) {
+	key, cert, caCerts, err := pkcs12.DecodeChain(pfx, password)
+	if err != nil {
+		return certificateVersion{}, err
+	}
+	// Like Key Vault, the backing secret holds the PFX without a password.
+	if password != "" {
+		if pfx, err = pkcs12.Encode(rand.Reader, key, cert, caCerts, ""); err != nil {
+			return certificateVersion{}, err
+		}
+	}
+	v := s.vault(vault)
+	stored, ok := v.certificates[strings.ToLower(name)]
+	if !ok {
+		stored = &certificate{name: name}
+		v.certificates[strings.ToLower(name)] = stored
+	}
+	backing := s.setSecret(vault, name, secretVersion{
+		value:       base64.StdEncoding.EncodeToString(pfx),
+		contentType: pkcs12ContentType,
+		tags:        tags,
+		managed:     true,
+	})
+	version := certificateVersion{version: backing.version, cer: cert.Raw, tags: tags, created: backing.created}
+	stored.versions = append(stored.versions, version)
+	return version, nil
+}
+
+// serveVault serves the Key Vault data plane for path, which starts with the name of the vault.
+func (s *Server) serveVault(w http.ResponseWriter, r *http.Request, path string) {
+	segments := strings.Split(path, "/")
+	name := segments[0]
+	base := fmt.Sprintf("http://%s/vaults/%s", r.Host, name)
+	if len(segments) < 2 {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("The path %q is not served by the emulator.", r.URL.Path))
+		return
+	}
+	switch strings.ToLower(segments[1]) {
+	case "secrets":
+		s.serveSecrets(w, r, base, name, segments[2:])
+	case "certificates":
+		s.serveCertificates(w, r, base, name, segments[2:])
+	default:
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("The path %q is not served by the emulator.", r.URL.Path))
+	}
+}
+
+func (s *Server) serveSecrets(w http.ResponseWriter, r *http.Request, base, vault string, segments []string) {
+	secrets := s.vault(vault).secrets
+	if len(segments) == 0 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %s is not allowed.", r.Method))
+			return
+		}
+		var names []string
+		for key := range secrets {
+			names = append(names, key)
+		}
+		sort.Strings(names)
+		items := []map[string]interface{}{}
+		for _, key := range names {
+			stored := secrets[key]
+			latest := stored.versions[len(stored.versions)-1]
+			items = append(items, map[string]interface{}{
+				"id":          fmt.Sprintf("%s/secrets/%s", base, stored.name),
+				"contentType": latest.contentType,
+				"tags":        latest.tags,
+				"attributes":  attributes(latest.created),
+				"managed":     latest.managed,
+			})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"value": items})
+		return
+	}
+
+	name := segments[0]
+	stored, found := secrets[strings.ToLower(name)]
+	switch {
+	case r.Method == http.MethodPut && len(segments) == 1:
+		var params struct {
+			Value       *string           `json:"value"`
+			ContentType string            `json:"contentType"`
+			Tags        map[string]string `json:"tags"`
+		}
+		if err := readJSON(r, &params); err != nil || params.Value == nil {
+			writeError(w, http.StatusBadRequest, "BadParameter", "The value of the secret must be provided.")
+			return
+		}
+		version := s.setSecret(vault, name, secretVersion{value: *params.Value, contentType: params.ContentType, tags: params.Tags})
+		writeJSON(w, http.StatusOK, secretBundle(base, secrets[strings.ToLower(name)].name, version))
+	case r.Method == http.MethodGet && len(segments) <= 2:
+		if !found {
+			writeError(w, http.StatusNotFound, "SecretNotFound", fmt.Sprintf("A secret with (name/id) %s was not found in this key vault.", name))
+			return
+		}
+		version, ok := stored.versions[len(stored.versions)-1], true
+		if len(segments) == 2 && segments[1] != "" {
+			version, ok = findSecretVersion(stored, segments[1])
+		}
+		if !ok {
+			writeError(w, http.StatusNotFound, "SecretNotFound", fmt.Sprintf("A secret with (name/id) %s/%s was not found in this key vault.", name, segments[1]))
+			return
+		}
+		writeJSON(w, http.StatusOK, secretBundle(base, stored.name, version))
+	case r.Method == http.MethodDelete && len(segments) == 1:
+		if !found {
+			writeError(w, http.StatusNotFound, "SecretNotFound", fmt.Sprintf("A secret with (name/id) %s was not found in this key vault.", name))
+			return
+		}
+		delete(secrets, strings.ToLower(name))
+		bundle := secretBundle(base, stored.name, stored.versions[len(stored.versions)-1])
+		bundle["recoveryId"] = fmt.Sprintf("%s/deletedsecrets/%s", base, stored.name)
+		bundle["deletedDate"] = time.Now().Unix()
+		writeJSON(w, http.StatusOK, bundle)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %s is not allowed.", r.Method))
+	}
+}
+
+func (s *Server) serveCertificates(w http.ResponseWriter, r *http.Request, base, vault string, segments []string) {
+	certificates := s.vault(vault).certificates
+	if len(segments) == 0 {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %s is not allowed.", r.Method))
+		return
+	}
+	name := segments[0]
+	stored, found := certificates[strings.ToLower(name)]
+	switch {
+	case r.Method == http.MethodPost && len(segments) == 2 && strings.EqualFold(segments[1], "import"):
+		var params struct {
+			Value    string            `json:"value"`
+			Password string            `json:"pwd"`
+			Tags     map[string]string `json:"tags"`
+		}
+		if err := readJSON(r, &params); err != nil {
+			writeError(w, http.StatusBadRequest, "BadParameter", err.Error())
+			return
+		}
+		pfx, err := base64.StdEncoding.DecodeString(params.Value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "BadParameter", fmt.Sprintf("The certificate must be a base64 encoded PFX: %v", err))
+			return
+		}
+		version, err := s.importCertificate(vault, name, pfx, params.Password, params.Tags)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "BadParameter", fmt.Sprintf("The certificate could not be read: %v", err))
+			return
+		}
+		writeJSON(w, http.StatusOK, certificateBundle(base, certificates[strings.ToLower(name)].name, version))
+	case r.Method == http.MethodGet && len(segments) <= 2:
+		if !found {
+			writeError(w, http.StatusNotFound, "CertificateNotFound", fmt.Sprintf("A certificate with (name/id) %s was not found in this key vault.", name))
+			return
+		}
+		version, ok := stored.versions[len(stored.versions)-1], true
+		if len(segments) == 2 && segments[1] != "" {
+			version, ok = findCertificateVersion(stored, segments[1])
+		}
+		if !ok {
+			writeError(w, http.StatusNotFound, "CertificateNotFound", fmt.Sprintf("A certificate with (name/id) %s/%s was not found in this key vault.", name, segments[1]))
+			return
+		}
+		writeJSON(w, http.StatusOK, certificateBundle(base, stored.name, version))
+	case r.Method == http.MethodDelete && len(segments) == 1:
+		if !found {
+			writeError(w, http.StatusNotFound, "CertificateNotFound", fmt.Sprintf("A certificate with (name/id) %s was not found in this key vault.", name))
+			return
+		}
+		// The secret backing a certificate goes with it.
+		delete(certificates, strings.ToLower(name))
+		delete(s.vault(vault).secrets, strings.ToLower(name))
+		bundle := certificateBundle(base, stored.name, stored.versions[len(stored.versions)-1])
+		bundle["recoveryId"] = fmt.Sprintf("%s/deletedcertificates/%s", base, stored.name)
+		bundle["deletedDate"] = time.Now().Unix()
+		writeJSON(w, http.StatusOK, bundle)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %s is not allowed.", r.Method))
+	}
+}
+
+func findSecretVersion(stored *secret, version string) (secretVersion, bool) {
+	for _, v := range stored.versions {
+		if strings.EqualFold(v.version, version) {
+			return v, true
+		}
+	}
+	return secretVersion{}, false
+}
+
+func findCertificateVersion(stored *certificate, version string) (certificateVersion, bool) {
+	for _, v := range stored.versions {
+		if strings.EqualFold(v.version, version) {
+			return v, true
+		}
+	}
+	return certificateVersion{}, false
+}
+
+func secretBundle(base, name string, version secretVersion) map[string]interface{} {
+	bundle := map[string]interface{}{
+		"id":         fmt.Sprintf("%s/secrets/%s/%s", base, name, version.version),
+		"value":      version.value,
+		"attributes": attributes(version.created),
+	}
+	if version.contentType != "" {
+		bundle["contentType"] = version.contentType
+	}
+	if version.tags != nil {
+		bundle["tags"] = version.tags
+	}
+	if version.managed {
+		bundle["managed"] = true
+		bundle["kid"] = fmt.Sprintf("%s/keys/%s/%s", base, name, version.version)
+	}
+	return bundle
+}
+
+func certificateBundle(base, name string, version certificateVersion) map[string]interface{} {
+	thumbprint := sha1.Sum(version.cer)
+	bundle := map[string]interface{}{
+		"id":         fmt.Sprintf("%s/certificates/%s/%s", base, name, version.version),
+		"kid":        fmt.Sprintf("%s/keys/%s/%s", base, name, version.version),
+		"sid":        fmt.Sprintf("%s/secrets/%s/%s", base, name, version.version),
+		"x5t":        base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+		"cer":        version.cer,
+		"attributes": attributes(version.created),
+		"policy": map[string]interface{}{
+			"id":           fmt.Sprintf("%s/certificates/%s/policy", base, name),
+			"secret_props": map[string]string{"contentType": pkcs12ContentType},
+		},
+	}
+	if version.tags != nil {
+		bundle["tags"] = version.tags
+	}
+	return bundle
+}
+
+func attributes(created int64) map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":       true,
+		"created":       created,
+		"updated":       created,
+		"recoveryLevel": "Purgeable",
+	}
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
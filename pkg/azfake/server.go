@@ -0,0 +1,281 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+// Package azfake runs an in-memory emulator of Azure Resource Manager and Key Vault,
+// so Azure clients and controllers can be tested without credentials or a subscription.
+//
+// The emulator stores the JSON of every resource put to it, and answers like Azure does:
+// resources are created and deleted by long running operations polled with the Azure-AsyncOperation header,
+// missing resources and resource groups return 404, and requests against a resource with a pending operation return 409.
+// Key Vault secrets and certificates are served below the URL of each vault returned by VaultURL.
+package azfake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+// Token is the access token the emulator expects as a bearer token on every request.
+const Token = "azfake"
+
+// Server is an emulator of Azure Resource Manager and Key Vault listening on a local address.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// polls is the number of polls a long running operation takes to finish.
+	polls      int
+	resources  map[string]*resource
+	operations map[string]*operation
+	vaults     map[string]*vault
+	faults     []*Fault
+	requests   []string
+	sequence   int
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// Polls sets how many requests for a resource or its operation status a long running operation takes to finish.
+// Zero finishes every operation before the response, as if every request was synchronous. The default is one.
+func Polls(polls int) Option {
+	return func(s *Server) {
+		s.polls = polls
+	}
+}
+
+// NewServer starts an emulator. Callers should Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		polls:      1,
+		resources:  map[string]*resource{},
+		operations: map[string]*operation{},
+		vaults:     map[string]*vault{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Environment returns a cloud whose Resource Manager endpoint is the emulator.
+func (s *Server) Environment() azure.Environment {
+	env := azure.PublicCloud
+	env.Name = "AzureFakeCloud"
+	env.ResourceManagerEndpoint = s.URL + "/"
+	return env
+}
+
+// Authorizer returns an authorizer presenting the token accepted by the emulator.
+func (s *Server) Authorizer() autorest.Authorizer {
+	return autorest.NewBearerAuthorizer(&adal.Token{AccessToken: Token})
+}
+
+// VaultURL returns the URL of the Key Vault named vault within the emulator.
+func (s *Server) VaultURL(vault string) string {
+	return fmt.Sprintf("%s/vaults/%s", s.URL, vault)
+}
+
+// Options returns the configuration options pointing every client built from a config.Config at the emulator.
+func (s *Server) Options() []config.Option {
+	return []config.Option{
+		config.Cloud(s.Environment()),
+		config.Authorizer(s.Authorizer()),
+		config.VaultURLs(s.VaultURL),
+	}
+}
+
+// Config returns a configuration for the emulator, with opts applied after the options of the emulator.
+func (s *Server) Config(opts ...config.Option) (*config.Config, error) {
+	return config.New(append(s.Options(), opts...)...)
+}
+
+// Fault makes matching requests fail with an Azure error, e.g. to exercise throttling or quota errors.
+type Fault struct {
+	// Method matches requests with this HTTP method. Empty matches every method.
+	Method string
+	// Path matches requests for this resource ID or Key Vault path, compared case insensitively. Empty matches every path.
+	Path string
+	// Status is the HTTP status of the failed responses. Defaults to 500.
+	Status int
+	// Code and Message describe the error returned. Code defaults to InternalServerError.
+	Code    string
+	Message string
+	// Times limits how many requests fail. Zero fails every matching request.
+	Times int
+	// Operation fails the long running operation started by matching requests instead of the requests themselves.
+	Operation bool
+}
+
+// Inject makes requests matching fault fail until it is used up.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fault.Status == 0 {
+		fault.Status = http.StatusInternalServerError
+	}
+	if fault.Code == "" {
+		fault.Code = "InternalServerError"
+	}
+	if fault.Message == "" {
+		fault.Message = fmt.Sprintf("Injected fault for %s %s", fault.Method, fault.Path)
+	}
+	s.faults = append(s.faults, &fault)
+}
+
+// fault returns the first fault matching a request, using it up.
+func (s *Server) fault(method, path string, operation bool) *Fault {
+	for i, f := range s.faults {
+		if f.Operation != operation {
+			continue
+		}
+		if f.Method != "" && !strings.EqualFold(f.Method, method) {
+			continue
+		}
+		if f.Path != "" && !strings.EqualFold(strings.TrimSuffix(f.Path, "/"), path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// Requests returns every request served so far, formatted as the method and path, e.g. PUT /subscriptions/id/resourceGroups/group.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.requests...)
+}
+
+// Seed stores resource as if it had been created in Azure at id. Its provisioning state defaults to Succeeded.
+func (s *Server) Seed(id string, resource interface{}) error {
+	body, err := toMap(resource)
+	if err != nil {
+		return err
+	}
+	t, ok := parse(id)
+	if !ok || t.trailing != "" {
+		return fmt.Errorf("invalid resource id %q", id)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.store(t, body)
+	if provisioningState(stored.body) == "" {
+		setProvisioningState(stored.body, stateSucceeded)
+	}
+	return nil
+}
+
+// Resource decodes the resource stored at id into v, e.g. a *network.VirtualNetwork. It returns false when there is no such resource.
+func (s *Server) Resource(id string, v interface{}) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		return false, nil
+	}
+	data, err := json.Marshal(stored.body)
+	if err != nil {
+		return true, err
+	}
+	return true, json.Unmarshal(data, v)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+path)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("x-ms-request-id", s.newID())
+	correlationID := r.Header.Get("x-ms-correlation-request-id")
+	if correlationID == "" {
+		correlationID = s.newID()
+	}
+	w.Header().Set("x-ms-correlation-request-id", correlationID)
+
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, http.StatusUnauthorized, "AuthenticationFailed", "Authentication failed. The 'Authorization' header is missing or invalid.")
+		return
+	}
+	if f := s.fault(r.Method, path, false); f != nil {
+		writeError(w, f.Status, f.Code, f.Message)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(path, "/vaults/"):
+		s.serveVault(w, r, strings.TrimPrefix(path, "/vaults/"))
+	case strings.HasPrefix(path, "/operations/"):
+		s.serveOperation(w, strings.TrimPrefix(path, "/operations/"))
+	default:
+		s.serveResource(w, r, path)
+	}
+}
+
+// newID returns an identifier formatted like the request IDs of Azure.
+func (s *Server) newID() string {
+	s.sequence++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012x", s.sequence)
+}
+
+// serviceError is the error body returned by Azure Resource Manager and Key Vault.
+type serviceError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]serviceError{"error": {Code: code, Message: message}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+// toMap converts v to its JSON object form.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+// decode parses a JSON object, keeping numbers as written.
+func decode(data []byte) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	return body, nil
+}
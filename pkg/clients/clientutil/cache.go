@@ -0,0 +1,51 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil
+
+import (
+	"sync"
+)
+
+// ClientCache is a thread-safe cache of authorized Azure clients keyed by subscription ID.
+// Clients share one instance per subscription, so concurrent reconciles never swap subscriptions under each other.
+type ClientCache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry holds the client of one subscription. Its lock is held while the client is created,
+// so authorizing one subscription only blocks callers waiting for the same subscription.
+type cacheEntry struct {
+	mu     sync.Mutex
+	client interface{}
+}
+
+// NewClientCache returns an empty client cache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{entries: map[string]*cacheEntry{}}
+}
+
+// Get returns the client cached for subscriptionID. On a miss it calls create and caches the result if create succeeds.
+func (c *ClientCache) Get(subscriptionID string, create func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[subscriptionID]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[subscriptionID] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.client != nil {
+		return entry.client, nil
+	}
+	client, err := create()
+	if err != nil {
+		return nil, err
+	}
+	entry.client = client
+	return client, nil
+}
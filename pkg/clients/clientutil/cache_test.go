@@ -0,0 +1,80 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
+)
+
+var _ = Describe("client cache", func() {
+
+	It("should create one client per subscription under concurrent access", func() {
+		cache := clientutil.NewClientCache()
+		var created int32
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			sub := "sub-a"
+			if i%2 == 0 {
+				sub = "sub-b"
+			}
+			wg.Add(1)
+			go func(sub string) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				client, err := cache.Get(sub, func() (interface{}, error) {
+					atomic.AddInt32(&created, 1)
+					return sub, nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(client).To(Equal(sub))
+			}(sub)
+		}
+		wg.Wait()
+		Expect(created).To(Equal(int32(2)))
+	})
+
+	It("should not block other subscriptions while a client is created", func() {
+		cache := clientutil.NewClientCache()
+		started, release := make(chan struct{}), make(chan struct{})
+		defer close(release)
+		go func() {
+			_, _ = cache.Get("slow", func() (interface{}, error) {
+				close(started)
+				<-release
+				return "slow", nil
+			})
+		}()
+		<-started
+
+		done := make(chan interface{})
+		go func() {
+			client, _ := cache.Get("fast", func() (interface{}, error) {
+				return "fast", nil
+			})
+			done <- client
+		}()
+		Eventually(done).Should(Receive(Equal("fast")))
+	})
+
+	It("should not cache clients which failed to authorize", func() {
+		cache := clientutil.NewClientCache()
+		_, err := cache.Get("sub", func() (interface{}, error) {
+			return nil, errors.New("unauthorized")
+		})
+		Expect(err).To(HaveOccurred())
+		client, err := cache.Get("sub", func() (interface{}, error) {
+			return "authorized", nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client).To(Equal("authorized"))
+	})
+})
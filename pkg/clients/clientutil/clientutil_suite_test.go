@@ -0,0 +1,17 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestClientutil(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "clientutil")
+}
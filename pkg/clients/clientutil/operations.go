@@ -0,0 +1,119 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// futureState is the serialized form of an azure.Future, which only the SDK can construct otherwise.
+type futureState struct {
+	Method        string `json:"method"`
+	PollingMethod string `json:"pollingMethod"`
+	PollingURL    string `json:"pollingURI"`
+	State         string `json:"lroState"`
+	ResultURL     string `json:"resultURI"`
+}
+
+// RecordOperation stores the long running operation started by future on the status of obj,
+// so it is polled by ResumeOperation instead of being started again. Operations which completed immediately are not recorded.
+func RecordOperation(obj runtime.Object, future azure.Future) error {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return nil
+	}
+	data, err := future.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	var state futureState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	if state.PollingURL == "" || terminal(state.State) {
+		return nil
+	}
+	local.GetResourceStatus().Operation = &azurev1alpha1.Operation{
+		Method:        state.Method,
+		PollingURL:    state.PollingURL,
+		PollingMethod: state.PollingMethod,
+		ResultURL:     state.ResultURL,
+		State:         state.State,
+		StartTime:     metav1.Now(),
+	}
+	return nil
+}
+
+// ResumeOperation polls the operation recorded on obj when it was started with one of methods, using client to authorize requests.
+// It returns true while the operation is still running. Finished operations are cleared, and the error of failed ones returned.
+// Operations started with another method, e.g. a PUT recorded before the object was deleted, are forgotten.
+func ResumeOperation(ctx context.Context, client autorest.Client, obj runtime.Object, methods ...string) (bool, error) {
+	local, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !ok {
+		return false, nil
+	}
+	status := local.GetResourceStatus()
+	operation := status.Operation
+	if operation == nil {
+		return false, nil
+	}
+	if !contains(methods, operation.Method) {
+		status.Operation = nil
+		return false, nil
+	}
+	future, err := futureFor(operation)
+	if err != nil {
+		status.Operation = nil
+		return false, err
+	}
+	done, err := future.DoneWithContext(ctx, client)
+	if !done {
+		if future.Status() != "" {
+			operation.State = future.Status()
+		}
+		return true, err
+	}
+	status.Operation = nil
+	return false, err
+}
+
+func contains(methods []string, method string) bool {
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// terminal returns true for the final states of an operation.
+func terminal(state string) bool {
+	return strings.EqualFold(state, "Succeeded") || strings.EqualFold(state, "Failed") || strings.EqualFold(state, "Canceled")
+}
+
+// futureFor rebuilds the future of a recorded operation.
+func futureFor(operation *azurev1alpha1.Operation) (azure.Future, error) {
+	var future azure.Future
+	data, err := json.Marshal(futureState{
+		Method:        operation.Method,
+		PollingMethod: operation.PollingMethod,
+		PollingURL:    operation.PollingURL,
+		State:         operation.State,
+		ResultURL:     operation.ResultURL,
+	})
+	if err != nil {
+		return future, err
+	}
+	return future, future.UnmarshalJSON(data)
+}
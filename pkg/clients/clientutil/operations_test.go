@@ -0,0 +1,113 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
+)
+
+var _ = Describe("operations", func() {
+
+	var (
+		server *httptest.Server
+		state  string
+	)
+
+	BeforeEach(func() {
+		state = "InProgress"
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.URL.Path == "/operation":
+				if state == "Failed" {
+					fmt.Fprint(w, `{"status":"Failed","error":{"code":"QuotaExceeded","message":"out of cores"}}`)
+					return
+				}
+				fmt.Fprintf(w, `{"status":%q}`, state)
+			case r.Method == http.MethodPut || r.Method == http.MethodDelete:
+				w.Header().Set("Azure-AsyncOperation", "http://"+r.Host+"/operation")
+				w.WriteHeader(http.StatusAccepted)
+				fmt.Fprint(w, `{}`)
+			default:
+				fmt.Fprint(w, `{"properties":{"provisioningState":"Succeeded"}}`)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	start := func(method string) azure.Future {
+		req, err := http.NewRequest(method, server.URL+"/resource", strings.NewReader("{}"))
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		future, err := azure.NewFutureFromResponse(resp)
+		Expect(err).ToNot(HaveOccurred())
+		return future
+	}
+
+	It("should record operations and resume polling until they finish", func() {
+		group := &azurev1alpha1.ResourceGroup{}
+		Expect(clientutil.RecordOperation(group, start(http.MethodPut))).To(Succeed())
+		Expect(group.Status.Operation).ToNot(BeNil())
+		Expect(group.Status.Operation.Method).To(Equal(http.MethodPut))
+		Expect(group.Status.Operation.PollingURL).To(Equal(server.URL + "/operation"))
+		Expect(group.Status.Operation.StartTime.IsZero()).To(BeFalse())
+
+		pending, err := clientutil.ResumeOperation(context.Background(), autorest.NewClientWithUserAgent(""), group, http.MethodPut)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeTrue())
+		Expect(group.Status.Operation).ToNot(BeNil())
+
+		state = "Succeeded"
+		pending, err = clientutil.ResumeOperation(context.Background(), autorest.NewClientWithUserAgent(""), group, http.MethodPut)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+		Expect(group.Status.Operation).To(BeNil())
+	})
+
+	It("should clear failed operations and return their error", func() {
+		group := &azurev1alpha1.ResourceGroup{}
+		Expect(clientutil.RecordOperation(group, start(http.MethodPut))).To(Succeed())
+
+		state = "Failed"
+		pending, err := clientutil.ResumeOperation(context.Background(), autorest.NewClientWithUserAgent(""), group, http.MethodPut)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("QuotaExceeded"))
+		Expect(pending).To(BeFalse())
+		Expect(group.Status.Operation).To(BeNil())
+	})
+
+	It("should forget operations started with another method", func() {
+		group := &azurev1alpha1.ResourceGroup{}
+		Expect(clientutil.RecordOperation(group, start(http.MethodPut))).To(Succeed())
+
+		pending, err := clientutil.ResumeOperation(context.Background(), autorest.NewClientWithUserAgent(""), group, http.MethodDelete)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+		Expect(group.Status.Operation).To(BeNil())
+	})
+
+	It("should do nothing without a recorded operation", func() {
+		group := &azurev1alpha1.ResourceGroup{}
+		pending, err := clientutil.ResumeOperation(context.Background(), autorest.NewClientWithUserAgent(""), group, http.MethodPut)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+	})
+})
@@ -0,0 +1,141 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+)
+
+// Tags stamped on Azure resources to record which object owns them.
+const (
+	OwnerClusterTag   = "incendiary-iguana.cluster"
+	OwnerNamespaceTag = "incendiary-iguana.namespace"
+	OwnerNameTag      = "incendiary-iguana.name"
+	OwnerUIDTag       = "incendiary-iguana.uid"
+)
+
+// OwnershipConflictError is returned when an Azure resource belongs to someone other than the object being reconciled.
+type OwnershipConflictError struct {
+	// Owner describes the current owner of the Azure resource.
+	Owner string
+}
+
+func (e *OwnershipConflictError) Error() string {
+	return fmt.Sprintf("azure resource is owned by %s, set annotation %s=true to adopt it", e.Owner, azurev1alpha1.AdoptAnnotation)
+}
+
+// IsOwnershipConflict returns true if the error is an OwnershipConflictError.
+func IsOwnershipConflict(err error) bool {
+	_, ok := err.(*OwnershipConflictError)
+	return ok
+}
+
+// OwnerTags returns the tags identifying obj as the owner of an Azure resource, merged over existing tags.
+func OwnerTags(clusterID string, obj runtime.Object, existing map[string]*string) map[string]*string {
+	tags := map[string]*string{}
+	for key, value := range existing {
+		tags[key] = value
+	}
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return tags
+	}
+	tags[OwnerClusterTag] = stringPtr(clusterID)
+	tags[OwnerNamespaceTag] = stringPtr(res.GetNamespace())
+	tags[OwnerNameTag] = stringPtr(res.GetName())
+	// Objects applied by the ensure command never reach the API server, so they have no UID to record.
+	if uid := res.GetUID(); uid != "" {
+		tags[OwnerUIDTag] = stringPtr(string(uid))
+	} else {
+		delete(tags, OwnerUIDTag)
+	}
+	return tags
+}
+
+// OwnerTagged returns true if tags identify obj as the owner of the Azure resource.
+// Clients update resources lacking the tags even when nothing else changed, so the tags are stamped on the first pass.
+func OwnerTagged(clusterID string, obj runtime.Object, tags map[string]*string) bool {
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	return value(tags[OwnerClusterTag]) == clusterID &&
+		value(tags[OwnerNamespaceTag]) == res.GetNamespace() &&
+		value(tags[OwnerNameTag]) == res.GetName() &&
+		value(tags[OwnerUIDTag]) == string(res.GetUID())
+}
+
+// CheckOwnership verifies that obj may mutate an existing Azure resource with the provided tags.
+// A resource is owned by obj if its ownership tags match the cluster, namespace, name and UID of obj.
+// UIDs are only compared when both obj and the tags carry one, since objects applied by the ensure command have none.
+// Resources without ownership tags are accepted from objects the controller already manages, see managedBefore,
+// and from objects without a UID, which the ensure command applies straight to Azure.
+// In all other cases an OwnershipConflictError is returned, unless obj has the adopt annotation.
+//
+// Only kinds creating Azure resources which carry tags are checked. Subnets and SQL firewall rules are children
+// of a tagged resource and have no tags of their own, and kinds writing Kubernetes secrets create nothing in Azure.
+func CheckOwnership(clusterID string, obj runtime.Object, tags map[string]*string) error {
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if res.GetAnnotations()[azurev1alpha1.AdoptAnnotation] == "true" {
+		return nil
+	}
+	if _, tagged := tags[OwnerNameTag]; !tagged {
+		if res.GetUID() == "" || managedBefore(obj) {
+			return nil
+		}
+		return &OwnershipConflictError{Owner: "an unmanaged owner"}
+	}
+	uid, owner := string(res.GetUID()), value(tags[OwnerUIDTag])
+	if value(tags[OwnerClusterTag]) == clusterID &&
+		value(tags[OwnerNamespaceTag]) == res.GetNamespace() &&
+		value(tags[OwnerNameTag]) == res.GetName() &&
+		(uid == "" || owner == "" || uid == owner) {
+		return nil
+	}
+	return &OwnershipConflictError{
+		Owner: fmt.Sprintf("%s/%s in cluster %q", value(tags[OwnerNamespaceTag]), value(tags[OwnerNameTag]), value(tags[OwnerClusterTag])),
+	}
+}
+
+// managedBefore returns true for objects carrying the controller finalizer whose OwnershipConflict condition is either
+// absent, as on objects reconciled before ownership tags were introduced, or false, as after a successful reconcile.
+// The controller sets the condition to unknown before adding its finalizer to a new object, so new objects are checked
+// strictly until their first successful reconcile.
+func managedBefore(obj runtime.Object) bool {
+	res, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	finalized := false
+	for _, finalizer := range res.GetFinalizers() {
+		finalized = finalized || finalizer == azurev1alpha1.Finalizer
+	}
+	accessor, ok := obj.(azurev1alpha1.StatusAccessor)
+	if !finalized || !ok {
+		return false
+	}
+	conflict := accessor.GetResourceStatus().Conditions.Get(azurev1alpha1.ConditionOwnershipConflict)
+	return conflict == nil || conflict.Status == corev1.ConditionFalse
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil_test
+
+import (
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
+)
+
+var _ = Describe("ownership", func() {
+
+	const cluster = "cluster-a"
+
+	var group *azurev1alpha1.ResourceGroup
+
+	BeforeEach(func() {
+		group = &azurev1alpha1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "group", Namespace: "default", UID: "1234"},
+		}
+	})
+
+	It("should stamp owner tags without dropping existing tags", func() {
+		tags := clientutil.OwnerTags(cluster, group, map[string]*string{"team": to.StringPtr("infra")})
+		Expect(tags).To(HaveKeyWithValue("team", to.StringPtr("infra")))
+		Expect(tags).To(HaveKeyWithValue(clientutil.OwnerClusterTag, to.StringPtr(cluster)))
+		Expect(tags).To(HaveKeyWithValue(clientutil.OwnerNamespaceTag, to.StringPtr("default")))
+		Expect(tags).To(HaveKeyWithValue(clientutil.OwnerNameTag, to.StringPtr("group")))
+		Expect(tags).To(HaveKeyWithValue(clientutil.OwnerUIDTag, to.StringPtr("1234")))
+	})
+
+	It("should accept resources owned by the object", func() {
+		tags := clientutil.OwnerTags(cluster, group, nil)
+		Expect(clientutil.CheckOwnership(cluster, group, tags)).To(Succeed())
+	})
+
+	It("should refuse resources owned by another object", func() {
+		other := group.DeepCopy()
+		other.UID = "5678"
+		err := clientutil.CheckOwnership(cluster, group, clientutil.OwnerTags(cluster, other, nil))
+		Expect(clientutil.IsOwnershipConflict(err)).To(BeTrue())
+
+		err = clientutil.CheckOwnership("cluster-b", group, clientutil.OwnerTags(cluster, group, nil))
+		Expect(clientutil.IsOwnershipConflict(err)).To(BeTrue())
+	})
+
+	It("should refuse unmanaged resources unless adopted", func() {
+		err := clientutil.CheckOwnership(cluster, group, map[string]*string{})
+		Expect(clientutil.IsOwnershipConflict(err)).To(BeTrue())
+
+		group.Annotations = map[string]string{azurev1alpha1.AdoptAnnotation: "true"}
+		Expect(clientutil.CheckOwnership(cluster, group, map[string]*string{})).To(Succeed())
+	})
+
+	It("should accept untagged resources from objects managed before ownership tags", func() {
+		err := clientutil.CheckOwnership(cluster, group, nil)
+		Expect(clientutil.IsOwnershipConflict(err)).To(BeTrue())
+
+		group.Finalizers = []string{azurev1alpha1.Finalizer}
+		Expect(clientutil.CheckOwnership(cluster, group, nil)).To(Succeed())
+
+		group.Status.Conditions.Set(azurev1alpha1.Condition{Type: azurev1alpha1.ConditionOwnershipConflict, Status: corev1.ConditionFalse})
+		Expect(clientutil.CheckOwnership(cluster, group, nil)).To(Succeed())
+	})
+
+	It("should refuse untagged resources for new objects", func() {
+		group.Finalizers = []string{azurev1alpha1.Finalizer}
+		group.Status.Conditions.Set(azurev1alpha1.Condition{Type: azurev1alpha1.ConditionOwnershipConflict, Status: corev1.ConditionUnknown})
+		err := clientutil.CheckOwnership(cluster, group, nil)
+		Expect(clientutil.IsOwnershipConflict(err)).To(BeTrue())
+	})
+
+	It("should accept untagged resources from objects without a UID", func() {
+		group.UID = ""
+		Expect(clientutil.CheckOwnership(cluster, group, nil)).To(Succeed())
+	})
+
+	It("should not stamp an empty UID", func() {
+		tags := clientutil.OwnerTags(cluster, group, nil)
+		group.UID = ""
+		tags = clientutil.OwnerTags(cluster, group, tags)
+		Expect(tags).ToNot(HaveKey(clientutil.OwnerUIDTag))
+		Expect(clientutil.OwnerTagged(cluster, group, tags)).To(BeTrue())
+	})
+
+	It("should compare UIDs only when both the object and the tags have one", func() {
+		cli := group.DeepCopy()
+		cli.UID = ""
+		tags := clientutil.OwnerTags(cluster, cli, nil)
+		Expect(clientutil.CheckOwnership(cluster, group, tags)).To(Succeed())
+		Expect(clientutil.OwnerTagged(cluster, group, tags)).To(BeFalse())
+
+		Expect(clientutil.CheckOwnership(cluster, cli, clientutil.OwnerTags(cluster, group, nil))).To(Succeed())
+
+		other := cli.DeepCopy()
+		other.Name = "other"
+		err := clientutil.CheckOwnership(cluster, cli, clientutil.OwnerTags(cluster, other, nil))
+		Expect(clientutil.IsOwnershipConflict(err)).To(BeTrue())
+	})
+
+	It("should report whether tags identify the object", func() {
+		Expect(clientutil.OwnerTagged(cluster, group, nil)).To(BeFalse())
+		Expect(clientutil.OwnerTagged(cluster, group, clientutil.OwnerTags(cluster, group, nil))).To(BeTrue())
+		Expect(clientutil.OwnerTagged("cluster-b", group, clientutil.OwnerTags(cluster, group, nil))).To(BeFalse())
+	})
+})
@@ -0,0 +1,34 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil
+
+import (
+	"bytes"
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretOutdated reads the secret named name in namespace and reports whether it is missing or
+// any key of data is absent or holds a different value. Keys of the secret which are not in data are ignored.
+func SecretOutdated(ctx context.Context, kubeclient client.Client, namespace, name string, data map[string][]byte) (bool, error) {
+	secret := &corev1.Secret{}
+	if err := kubeclient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrs.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	for key, value := range data {
+		current, ok := secret.Data[key]
+		if !ok || !bytes.Equal(current, value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package clientutil_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
+)
+
+var _ = Describe("secrets", func() {
+
+	It("should report missing and changed keys", func() {
+		ctx := context.Background()
+		kubeclient := fake.NewFakeClientWithScheme(scheme.Scheme, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "keys", Namespace: "default"},
+			Data:       map[string][]byte{"primary": []byte("key-1"), "other": []byte("value")},
+		})
+
+		outdated, err := clientutil.SecretOutdated(ctx, kubeclient, "default", "keys", map[string][]byte{"primary": []byte("key-1")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outdated).To(BeFalse())
+
+		outdated, err = clientutil.SecretOutdated(ctx, kubeclient, "default", "keys", map[string][]byte{"primary": []byte("key-2")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outdated).To(BeTrue())
+
+		outdated, err = clientutil.SecretOutdated(ctx, kubeclient, "default", "keys", map[string][]byte{"secondary": []byte("key-1")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outdated).To(BeTrue())
+
+		outdated, err = clientutil.SecretOutdated(ctx, kubeclient, "default", "missing", map[string][]byte{"primary": []byte("key-1")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outdated).To(BeTrue())
+	})
+})
@@ -14,42 +14,61 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
-	"github.com/alexeldeib/incendiary-iguana/controllers"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
-var _ controllers.AsyncClient = &Client{}
-
 type Client struct {
-	factory  factoryFunc
-	internal compute.DisksClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) compute.DisksClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, compute.NewDisksClient)
+	return NewWithFactory(configuration, func(subscriptionID string) compute.DisksClient {
+		return compute.NewDisksClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
 // It uses the factory argument to instantiate new clients for a specific subscription.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (compute.DisksClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "VM")
+		tracing.Instrument(&client.Client, "VM")
+		c.config.WireLog(&client.Client, "VM")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return compute.DisksClient{}, err
+	}
+	return client.(compute.DisksClient), nil
 }
 
 // Ensure handles reconciliation of a disk.
@@ -67,15 +86,21 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
 
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, fmt.Sprintf("%s_%s_%s_osdisk", local.Spec.SubscriptionID, local.Spec.ResourceGroup, local.Spec.Name))
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, fmt.Sprintf("%s_%s_%s_osdisk", local.Spec.SubscriptionID, local.Spec.ResourceGroup, local.Spec.Name))
 	if err != nil {
 		// Not found is a successful delete
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	if err != nil && !found {
 		return false, nil
@@ -12,7 +12,6 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
-	"github.com/Azure/go-autorest/autorest/azure"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -21,13 +20,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	internal   keyvault.BaseClient
-	kubeclient *ctrl.Client
-	scheme     *runtime.Scheme
+	internal      keyvault.BaseClient
+	configuration *config.Config
+	kubeclient    *ctrl.Client
+	scheme        *runtime.Scheme
 }
 
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) (*Client, error) {
@@ -40,7 +43,10 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 		return nil, err
 	}
 	kvclient.Authorizer = authorizer
-	return &Client{internal: kvclient, kubeclient: kubeclient, scheme: scheme}, nil
+	metrics.Instrument(&kvclient.Client, "DockerConfig")
+	tracing.Instrument(&kvclient.Client, "DockerConfig")
+	configuration.WireLog(&kvclient.Client, "DockerConfig")
+	return &Client{internal: kvclient, configuration: configuration, kubeclient: kubeclient, scheme: scheme}, nil
 }
 
 // ForSubscription authorizes the client for a given subscription
@@ -54,7 +60,7 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (*[]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	vault := fmt.Sprintf("https://%s.%s", secret.Spec.Vault, azure.PublicCloud.KeyVaultDNSSuffix)
+	vault := c.configuration.VaultURL(secret.Spec.Vault)
 	passwordBundle, err := c.internal.GetSecret(ctx, vault, secret.Spec.Password, "")
 	if err != nil {
 		return nil, err
@@ -69,12 +75,11 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (*[]byte, error) {
 
 // Ensure takes a spec corresponding to one Azure KV secret. It syncs that secret into Kubernetes, remapping the name if necessary.
 func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
-	// TODO(ace): cloud-sensitive
 	secret, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	vault := fmt.Sprintf("https://%s.%s", secret.Spec.Vault, azure.PublicCloud.KeyVaultDNSSuffix)
+	vault := c.configuration.VaultURL(secret.Spec.Vault)
 	passwordBundle, err := c.internal.GetSecret(ctx, vault, secret.Spec.Password, "")
 	if err != nil {
 		return err
@@ -108,7 +113,23 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	return err
 }
 
-// Delete deletes a secret from Keyvault.
+// Observe reads the password from Keyvault without mutating anything.
+// It reports whether the Kubernetes secret no longer holds the docker config built from it.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	secret, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	content, err := c.Get(ctx, secret)
+	if err != nil {
+		return false, err
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, secret.ObjectMeta.Namespace, secret.ObjectMeta.Name, map[string][]byte{
+		corev1.DockerConfigJsonKey: *content,
+	})
+}
+
+// Delete removes the Kubernetes secret written by Ensure, leaving Keyvault untouched.
 func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
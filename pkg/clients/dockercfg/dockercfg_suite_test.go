@@ -0,0 +1,17 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package dockercfg
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDockercfg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "dockercfg")
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package dockercfg
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+var _ = Describe("docker configs", func() {
+
+	DescribeTable("should derive the vault URL from the cloud",
+		func(env azure.Environment, host string) {
+			configuration, err := config.New(config.Cloud(env), config.Authorizer(autorest.NullAuthorizer{}))
+			Expect(err).ToNot(HaveOccurred())
+			var kubeclient client.Client
+			c, err := New(configuration, &kubeclient, nil)
+			Expect(err).ToNot(HaveOccurred())
+			var hosts []string
+			c.internal.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				hosts = append(hosts, r.URL.Host)
+				return &http.Response{
+					Request:    r,
+					Header:     http.Header{},
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(`{"value":"password"}`)),
+				}, nil
+			})
+
+			_, err = c.Get(context.Background(), &azurev1alpha1.DockerConfig{Spec: azurev1alpha1.DockerConfigSpec{Username: "user", Password: "secret", Vault: "vault", Server: "registry.example.com"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(ConsistOf(host))
+		},
+		Entry("public", azure.PublicCloud, "vault.vault.azure.net"),
+		Entry("US government", azure.USGovernmentCloud, "vault.vault.usgovcloudapi.net"),
+		Entry("China", azure.ChinaCloud, "vault.vault.azure.cn"),
+		Entry("German", azure.GermanCloud, "vault.vault.microsoftazure.de"),
+	)
+})
@@ -13,39 +13,61 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	factory  factoryFunc
-	internal msi.UserAssignedIdentitiesClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) msi.UserAssignedIdentitiesClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, msi.NewUserAssignedIdentitiesClient)
+	return NewWithFactory(configuration, func(subscriptionID string) msi.UserAssignedIdentitiesClient {
+		return msi.NewUserAssignedIdentitiesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
 // It uses the factory argument to instantiate new clients for a specific subscription.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (msi.UserAssignedIdentitiesClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "Identity")
+		tracing.Instrument(&client.Client, "Identity")
+		c.config.WireLog(&client.Client, "Identity")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return msi.UserAssignedIdentitiesClient{}, err
+	}
+	return client.(msi.UserAssignedIdentitiesClient), nil
 }
 
 // Ensure creates or updates a managed identity in an idempotent manner.
@@ -54,14 +76,23 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	if err != nil {
 		return err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
 		return err
 	}
-
 	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return err
+		}
+	}
+
+	if found && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
 		return nil
 	}
 
@@ -70,9 +101,13 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 		Location(&local.Spec.Location),
 	)
 
-	if _, err := c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.Build()); err != nil {
+	identity := spec.Build()
+	identity.Tags = clientutil.OwnerTags(c.config.ClusterID(), local, nil)
+	created, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, identity)
+	if err != nil {
 		return err
 	}
+	c.SetStatus(local, created)
 
 	return nil
 }
@@ -83,7 +118,11 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (msi.Identity, err
 	if err != nil {
 		return msi.Identity{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return msi.Identity{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 }
 
 // Delete handles deletion of a managed identity.
@@ -92,11 +131,15 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	if err != nil {
 		return err
 	}
-	response, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	response, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil && !response.IsHTTPStatus(http.StatusNotFound) {
 		return err
 	}
-	// remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	// remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	// found := !remote.IsHTTPStatus(http.StatusNotFound)
 	// c.SetStatus(local, remote)
 	// if err != nil && remote.IsHTTPStatus(http.StatusNotFound) {
@@ -106,6 +149,26 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	return nil
 }
 
+// Observe refreshes the status of the identity from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	c.SetStatus(local, remote)
+	if err != nil && found {
+		return false, err
+	}
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
+}
+
 // SetStatus sets the status subresource fields of the CRD reflecting the state of the object in Azure.
 func (c *Client) SetStatus(local *azurev1alpha1.Identity, remote msi.Identity) {
 	local.Status.ID = remote.ID
@@ -43,12 +43,9 @@ func Location(location *string) func(s *Spec) {
 	}
 }
 
-func (s *Spec) NeedsUpdate(local *azurev1alpha1.VirtualNetwork) bool {
-	// return any([]func() bool{
-	// 	// Both immutable?
-	// 	// func() bool { return Name(s) == nil || local.Spec.Name != *Name(s) },
-	// 	// func() bool { return Location(s) == nil || local.Spec.Location != *Location(s) },
-	// })
+// NeedsUpdate reports whether the identity in Azure differs from the spec of local.
+// Identities have no mutable properties, so an existing identity never needs an update.
+func (s *Spec) NeedsUpdate(local *azurev1alpha1.Identity) bool {
 	return false
 }
 
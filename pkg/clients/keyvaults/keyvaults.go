@@ -10,42 +10,63 @@ import (
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
-	"github.com/Azure/go-autorest/autorest/to"
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 	uuid "github.com/satori/go.uuid"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type Client struct {
-	factory  factoryFunc
-	internal keyvault.VaultsClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) keyvault.VaultsClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, keyvault.NewVaultsClient)
+	return NewWithFactory(configuration, func(subscriptionID string) keyvault.VaultsClient {
+		return keyvault.NewVaultsClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (keyvault.VaultsClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "Keyvault")
+		tracing.Instrument(&client.Client, "Keyvault")
+		c.config.WireLog(&client.Client, "Keyvault")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return keyvault.VaultsClient{}, err
+	}
+	return client.(keyvault.VaultsClient), nil
 }
 
 // Ensure creates or updates a keyvault in an idempotent manner and sets its provisioning state.
@@ -54,26 +75,52 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	if err != nil {
 		return err
 	}
+	internal, err := c.forSubscription(vault.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
 	// TODO(ace): handle location/name changes? via status somehow
 	tenantId, err := uuid.FromString(vault.Spec.TenantID)
 	if err != nil {
 		return err
 	}
 
-	// TODO(ace): use spec here
+	remote, err := internal.Get(ctx, vault.Spec.ResourceGroup, vault.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return err
+	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), vault, remote.Tags); err != nil {
+			return err
+		}
+	}
+
+	var spec *Spec
+	if found {
+		spec = NewSpecWithRemote(&remote)
+		if !spec.NeedsUpdate(vault) && clientutil.OwnerTagged(c.config.ClusterID(), vault, remote.Tags) {
+			vault.Status.ID = remote.ID
+			return nil
+		}
+	} else {
+		spec = NewSpec()
+	}
+
+	spec.Set(
+		Name(vault.Spec.Name),
+		Location(vault.Spec.Location),
+		TenantID(tenantId),
+	)
+
+	desired := spec.Build()
 	opts := keyvault.VaultCreateOrUpdateParameters{
-		Properties: &keyvault.VaultProperties{
-			TenantID:       &tenantId,
-			AccessPolicies: &[]keyvault.AccessPolicyEntry{},
-			Sku: &keyvault.Sku{
-				Family: to.StringPtr("A"),
-				Name:   keyvault.Standard,
-			},
-		},
-		Location: &vault.Spec.Location,
-	}
-
-	if _, err := c.internal.CreateOrUpdate(ctx, vault.Spec.ResourceGroup, vault.Spec.Name, opts); err != nil {
+		Tags:       clientutil.OwnerTags(c.config.ClusterID(), vault, desired.Tags),
+		Properties: desired.Properties,
+		Location:   desired.Location,
+	}
+
+	if _, err := internal.CreateOrUpdate(ctx, vault.Spec.ResourceGroup, vault.Spec.Name, opts); err != nil {
 		return err
 	}
 
@@ -90,7 +137,31 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (keyvault.Vault, e
 	if err != nil {
 		return keyvault.Vault{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return keyvault.Vault{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+}
+
+// Observe refreshes the status of the keyvault from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	local.Status.ID = remote.ID
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
 }
 
 // Delete handles deletion of a keyvault and returns its provisioning state.
@@ -99,15 +170,24 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	if err != nil {
 		return err
 	}
-	response, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
-	if err != nil && !response.IsHTTPStatus(http.StatusNotFound) {
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	response, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	// Azure answers 204 for vaults which are already gone, which the SDK does not expect.
+	if err != nil && !response.IsHTTPStatus(http.StatusNotFound) && !response.IsHTTPStatus(http.StatusNoContent) {
 		return err
 	}
 	return nil
 }
 
 func (c *Client) SetStatus(ctx context.Context, local *azurev1alpha1.Keyvault) error {
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	local.Status.ID = remote.ID
 	return err
 }
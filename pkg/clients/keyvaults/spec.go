@@ -6,18 +6,29 @@ package keyvaults
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/Azure/go-autorest/autorest/to"
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/google/go-cmp/cmp"
+	uuid "github.com/satori/go.uuid"
 )
 
 type Spec struct {
 	internal *keyvault.Vault
 }
 
+// NewSpec returns the spec of a new standard vault without access policies.
 func NewSpec() *Spec {
 	return &Spec{
-		internal: &keyvault.Vault{},
+		internal: &keyvault.Vault{
+			Properties: &keyvault.VaultProperties{
+				AccessPolicies: &[]keyvault.AccessPolicyEntry{},
+				Sku: &keyvault.Sku{
+					Family: to.StringPtr("A"),
+					Name:   keyvault.Standard,
+				},
+			},
+		},
 	}
 }
 
@@ -49,10 +60,23 @@ func Location(location string) func(*Spec) {
 	}
 }
 
-func (s *Spec) NeedsUpdate(local *azurev1alpha1.ResourceGroup) bool {
+func TenantID(tenantID uuid.UUID) func(*Spec) {
+	return func(s *Spec) {
+		if s.internal.Properties == nil {
+			s.internal.Properties = &keyvault.VaultProperties{}
+		}
+		s.internal.Properties.TenantID = &tenantID
+	}
+}
+
+func (s *Spec) NeedsUpdate(local *azurev1alpha1.Keyvault) bool {
 	return clientutil.Any([]func() bool{
 		func() bool { return !cmp.Equal(s.Name(), &local.Spec.Name) },
 		func() bool { return !cmp.Equal(s.Location(), &local.Spec.Location) },
+		func() bool {
+			tenantID, err := uuid.FromString(local.Spec.TenantID)
+			return err != nil || s.TenantID() == nil || !uuid.Equal(*s.TenantID(), tenantID)
+		},
 	})
 }
 
@@ -64,6 +88,13 @@ func (s *Spec) Location() *string {
 	return s.internal.Location
 }
 
+func (s *Spec) TenantID() *uuid.UUID {
+	if s.internal.Properties == nil {
+		return nil
+	}
+	return s.internal.Properties.TenantID
+}
+
 func (s *Spec) ID() *string {
 	return s.internal.ID
 }
@@ -10,46 +10,67 @@ import (
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
-	"github.com/davecgh/go-spew/spew"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 // TODO(ace): consts package
 const expand string = ""
 
 type Client struct {
-	factory  factoryFunc
-	internal network.LoadBalancersClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) network.LoadBalancersClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, network.NewLoadBalancersClient)
+	return NewWithFactory(configuration, func(subscriptionID string) network.LoadBalancersClient {
+		return network.NewLoadBalancersClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
 // It uses the factory argument to instantiate new clients for a specific subscription.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (network.LoadBalancersClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "LoadBalancer")
+		tracing.Instrument(&client.Client, "LoadBalancer")
+		c.config.WireLog(&client.Client, "LoadBalancer")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return network.LoadBalancersClient{}, err
+	}
+	return client.(network.LoadBalancersClient), nil
 }
 
 // Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
@@ -58,22 +79,33 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 	found := !remote.HasHTTPStatus(http.StatusNotFound, http.StatusConflict)
 	c.SetStatus(local, remote)
 	if err != nil && found {
 		return false, err
 	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
 
 	var spec *Spec
 	if found {
 		spec = NewSpecWithRemote(&remote)
 		if c.Done(ctx, local) {
-			if !spec.NeedsUpdate(local) {
+			if !spec.NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
 				return true, nil
 			}
 		} else {
-			spew.Dump("not done")
 			return false, nil
 		}
 	} else {
@@ -89,8 +121,33 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 		Rules(local.Spec.Rules),
 	)
 
-	_, err = c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.Build())
-	return false, err
+	lb := spec.Build()
+	lb.Tags = clientutil.OwnerTags(c.config.ClusterID(), local, lb.Tags)
+	future, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, lb)
+	if err != nil {
+		return false, err
+	}
+	return false, clientutil.RecordOperation(local, future.Future)
+}
+
+// Observe refreshes the status of the load balancer from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	found := !remote.HasHTTPStatus(http.StatusNotFound, http.StatusConflict)
+	c.SetStatus(local, remote)
+	if err != nil && found {
+		return false, err
+	}
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
 }
 
 // Get returns a virtual network.
@@ -99,7 +156,11 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (network.LoadBalan
 	if err != nil {
 		return network.LoadBalancer{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return network.LoadBalancer{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 }
 
 // Delete handles deletion of a virtual network.
@@ -108,15 +169,24 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
 
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && remote.IsHTTPStatus(http.StatusNotFound) {
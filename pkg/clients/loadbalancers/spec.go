@@ -13,7 +13,7 @@ import (
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/google/go-cmp/cmp"
-	"github.com/sanity-io/litter"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 type Spec struct {
@@ -64,6 +64,7 @@ func Frontends(frontends []string) func(s *Spec) {
 		s.internal.FrontendIPConfigurations = &[]network.FrontendIPConfiguration{}
 
 		for _, frontend := range frontends {
+			frontend := frontend
 			// TODO(ace): name these properly? but remember they must be unique (maybe a map[string]string of name:ID?)
 			parts := strings.Split(frontend, "/")
 			resourceName := parts[len(parts)-1]
@@ -86,6 +87,7 @@ func Backends(backends []string) func(s *Spec) {
 	return func(s *Spec) {
 		s.internal.BackendAddressPools = &[]network.BackendAddressPool{}
 		for _, backend := range backends {
+			backend := backend
 			*s.internal.BackendAddressPools = append(*s.internal.BackendAddressPools, network.BackendAddressPool{Name: &backend})
 		}
 	}
@@ -117,6 +119,7 @@ func Rules(rules *[]azurev1alpha1.RuleSpec) func(s *Spec) {
 		s.internal.LoadBalancingRules = &[]network.LoadBalancingRule{}
 		if rules != nil {
 			for _, rule := range *rules {
+				rule := rule
 				item := network.LoadBalancingRule{
 					Name: &rule.Name,
 					LoadBalancingRulePropertiesFormat: &network.LoadBalancingRulePropertiesFormat{
@@ -144,13 +147,9 @@ func (s *Spec) NeedsUpdate(local *azurev1alpha1.LoadBalancer) bool {
 		func() bool { return s.Name() == nil || local.Spec.Name != *s.Name() },
 		func() bool { return s.Location() == nil || local.Spec.Location != *s.Location() },
 		func() bool {
-			val := local.Spec.Rules != nil && s.Rules() != nil && !cmp.Equal(*local.Spec.Rules, *s.Rules())
-			fmt.Printf("val is: %v\n", val)
-			litter.Dump(*local.Spec.Rules)
-			litter.Dump(*s.Rules())
-			litter.Dump(cmp.Diff(*local.Spec.Rules, *s.Rules()))
-			return val
+			return local.Spec.Rules != nil && s.Rules() != nil && !cmp.Equal(*local.Spec.Rules, *s.Rules())
 		},
+		func() bool { return !cmp.Equal(local.Spec.BackendPools, s.BackendPools(), cmpopts.EquateEmpty()) },
 		// func() bool { return Subnets(s) == nil || !cmp.Equal(local.Spec.Subnets, *Subnets(s)) },
 	})
 }
@@ -163,6 +162,17 @@ func (s *Spec) Location() *string {
 	return s.internal.Location
 }
 
+func (s *Spec) BackendPools() []string {
+	if s.internal.LoadBalancerPropertiesFormat == nil || s.internal.LoadBalancerPropertiesFormat.BackendAddressPools == nil {
+		return nil
+	}
+	var pools []string
+	for _, pool := range *s.internal.LoadBalancerPropertiesFormat.BackendAddressPools {
+		pools = append(pools, to.String(pool.Name))
+	}
+	return pools
+}
+
 func (s *Spec) Rules() *[]azurev1alpha1.RuleSpec {
 	if s.internal.LoadBalancerPropertiesFormat == nil || s.internal.LoadBalancerPropertiesFormat.LoadBalancingRules == nil {
 		return nil
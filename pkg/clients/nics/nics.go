@@ -6,34 +6,34 @@ package nics
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
-	"github.com/Azure/go-autorest/autorest/to"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
-
-	"github.com/davecgh/go-spew/spew"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 const expand string = ""
 
 type Client struct {
-	factory  factoryFunc
-	internal network.InterfacesClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) network.InterfacesClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, network.NewInterfacesClient)
+	return NewWithFactory(configuration, func(subscriptionID string) network.InterfacesClient {
+		return network.NewInterfacesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -41,19 +41,36 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (network.InterfacesClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "NetworkInterface")
+		tracing.Instrument(&client.Client, "NetworkInterface")
+		c.config.WireLog(&client.Client, "NetworkInterface")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return network.InterfacesClient{}, err
+	}
+	return client.(network.InterfacesClient), nil
 }
 
 // Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
@@ -62,61 +79,75 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
 		return false, err
 	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
 
+	var spec *Spec
 	if found {
-		if c.Done(ctx, local) {
-			return true, nil
-		} else {
-			spew.Dump("not done")
+		if !c.Done(ctx, local) {
 			return false, nil
 		}
+		spec = NewSpecWithRemote(&remote)
+		if !spec.NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
+			return true, nil
+		}
+	} else {
+		spec = NewSpec()
 	}
 
-	// TODO(ace): use spec pattern from other clients
-	spec := network.Interface{
-		Location: &local.Spec.Location,
-	}
-	baseTemplate := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", local.Spec.SubscriptionID, local.Spec.ResourceGroup)
-	subnet := fmt.Sprintf("%s/providers/Microsoft.Network/virtualnetworks/%s/subnets/%s", baseTemplate, local.Spec.Network, local.Spec.Subnet)
-	ipConfigs := []network.InterfaceIPConfiguration{
-		{
-			Name: to.StringPtr("ipConfig0"),
-			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
-				Primary: to.BoolPtr(true),
-				Subnet: &network.Subnet{
-					ID: &subnet,
-				},
-				PrivateIPAllocationMethod: network.Dynamic,
-			},
-		},
+	ipConfigs, err := ipConfigurations(local)
+	if err != nil {
+		return false, err
 	}
-	if local.Spec.IPConfigurations != nil {
-		if len(*local.Spec.IPConfigurations) < 1 {
-			return false, errors.New("must have at least one IP configuration")
-		}
-		// Clear
-		ipConfigs = []network.InterfaceIPConfiguration{}
-		for index, config := range *local.Spec.IPConfigurations {
-			ipConfigName := fmt.Sprintf("ipConfig%s", strconv.Itoa(index))
-			innerSpec := buildIPConfig(ipConfigName, subnet, baseTemplate, config)
-			ipConfigs = append(ipConfigs, innerSpec)
-		}
-		ipConfigs[0].Primary = to.BoolPtr(true)
-		spec.InterfacePropertiesFormat = &network.InterfacePropertiesFormat{
-			IPConfigurations: &ipConfigs,
-		}
+	spec.Set(
+		Name(&local.Spec.Name),
+		Location(&local.Spec.Location),
+		IPConfigurations(ipConfigs),
+	)
+
+	nic := spec.Build()
+	nic.Tags = clientutil.OwnerTags(c.config.ClusterID(), local, nic.Tags)
+	future, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, nic)
+	if err != nil {
+		return false, err
 	}
+	return false, clientutil.RecordOperation(local, future.Future)
+}
 
-	if _, err = c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
+// Observe refreshes the status of the network interface from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
 		return false, err
 	}
-	return false, nil
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	c.SetStatus(local, remote)
+	if err != nil && found {
+		return false, err
+	}
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
 }
 
 // Get returns a virtual network.
@@ -125,7 +156,11 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (network.Interface
 	if err != nil {
 		return network.Interface{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return network.Interface{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 }
 
 // Delete handles deletion of a virtual network.
@@ -134,14 +169,23 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && remote.IsHTTPStatus(http.StatusNotFound) {
@@ -3,3 +3,192 @@ Copyright 2019 Alexander Eldeib.
 */
 
 package nics
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
+)
+
+type Spec struct {
+	internal *network.Interface
+}
+
+func NewSpec() *Spec {
+	return &Spec{
+		internal: &network.Interface{},
+	}
+}
+
+func NewSpecWithRemote(remote *network.Interface) *Spec {
+	return &Spec{
+		internal: remote,
+	}
+}
+
+func (s *Spec) Set(opts ...func(*Spec)) {
+	for _, opt := range opts {
+		opt(s)
+	}
+}
+
+func (s *Spec) Build() network.Interface {
+	return *s.internal
+}
+
+func Name(name *string) func(s *Spec) {
+	return func(s *Spec) {
+		s.internal.Name = name
+	}
+}
+
+func Location(location *string) func(s *Spec) {
+	return func(s *Spec) {
+		s.internal.Location = location
+	}
+}
+
+// IPConfigurations replaces the IP configurations of the interface.
+func IPConfigurations(configs []network.InterfaceIPConfiguration) func(s *Spec) {
+	return func(s *Spec) {
+		if s.internal.InterfacePropertiesFormat == nil {
+			s.internal.InterfacePropertiesFormat = &network.InterfacePropertiesFormat{}
+		}
+		s.internal.InterfacePropertiesFormat.IPConfigurations = &configs
+	}
+}
+
+// NeedsUpdate reports whether the interface in Azure differs from the spec of local.
+func (s *Spec) NeedsUpdate(local *azurev1alpha1.NetworkInterface) bool {
+	desired, err := ipConfigurations(local)
+	if err != nil {
+		// Let Ensure surface the error.
+		return true
+	}
+	return clientutil.Any([]func() bool{
+		func() bool { return s.Name() == nil || local.Spec.Name != *s.Name() },
+		func() bool { return s.Location() == nil || local.Spec.Location != *s.Location() },
+		func() bool {
+			return s.IPConfigurations() == nil || !ipConfigurationsEqual(*s.IPConfigurations(), desired)
+		},
+	})
+}
+
+func (s *Spec) Name() *string {
+	return s.internal.Name
+}
+
+func (s *Spec) Location() *string {
+	return s.internal.Location
+}
+
+func (s *Spec) IPConfigurations() *[]network.InterfaceIPConfiguration {
+	if s.internal.InterfacePropertiesFormat == nil {
+		return nil
+	}
+	return s.internal.InterfacePropertiesFormat.IPConfigurations
+}
+
+// ipConfigurations returns the IP configurations described by the spec of local.
+// Without explicit configurations the interface gets a single dynamic configuration in its subnet.
+func ipConfigurations(local *azurev1alpha1.NetworkInterface) ([]network.InterfaceIPConfiguration, error) {
+	baseTemplate := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", local.Spec.SubscriptionID, local.Spec.ResourceGroup)
+	subnet := fmt.Sprintf("%s/providers/Microsoft.Network/virtualNetworks/%s/subnets/%s", baseTemplate, local.Spec.Network, local.Spec.Subnet)
+	if local.Spec.IPConfigurations == nil {
+		return []network.InterfaceIPConfiguration{
+			{
+				Name: to.StringPtr("ipConfig0"),
+				InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+					Primary: to.BoolPtr(true),
+					Subnet: &network.Subnet{
+						ID: &subnet,
+					},
+					PrivateIPAllocationMethod: network.Dynamic,
+				},
+			},
+		}, nil
+	}
+	if len(*local.Spec.IPConfigurations) < 1 {
+		return nil, errors.New("must have at least one IP configuration")
+	}
+	ipConfigs := []network.InterfaceIPConfiguration{}
+	for index, config := range *local.Spec.IPConfigurations {
+		ipConfigName := fmt.Sprintf("ipConfig%s", strconv.Itoa(index))
+		ipConfigs = append(ipConfigs, buildIPConfig(ipConfigName, subnet, baseTemplate, config))
+	}
+	ipConfigs[0].Primary = to.BoolPtr(true)
+	return ipConfigs, nil
+}
+
+// ipConfigurationsEqual compares the fields of IP configurations set from the spec, ignoring those filled in by Azure.
+func ipConfigurationsEqual(remote, desired []network.InterfaceIPConfiguration) bool {
+	if len(remote) != len(desired) {
+		return false
+	}
+	for i := range desired {
+		want, got := desired[i].InterfaceIPConfigurationPropertiesFormat, remote[i].InterfaceIPConfigurationPropertiesFormat
+		if got == nil {
+			return false
+		}
+		if !strings.EqualFold(string(want.PrivateIPAllocationMethod), string(got.PrivateIPAllocationMethod)) {
+			return false
+		}
+		if want.PrivateIPAddress != nil && (got.PrivateIPAddress == nil || *want.PrivateIPAddress != *got.PrivateIPAddress) {
+			return false
+		}
+		if !equalIDs(subnetID(want.Subnet), subnetID(got.Subnet)) {
+			return false
+		}
+		if !equalIDs(publicIPID(want.PublicIPAddress), publicIPID(got.PublicIPAddress)) {
+			return false
+		}
+		if !equalPools(want.LoadBalancerBackendAddressPools, got.LoadBalancerBackendAddressPools) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalPools(desired, remote *[]network.BackendAddressPool) bool {
+	if desired == nil || remote == nil {
+		return (desired == nil || len(*desired) == 0) && (remote == nil || len(*remote) == 0)
+	}
+	if len(*desired) != len(*remote) {
+		return false
+	}
+	for i := range *desired {
+		if !equalIDs((*desired)[i].ID, (*remote)[i].ID) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalIDs compares Azure resource IDs, which are case insensitive.
+func equalIDs(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return strings.EqualFold(*a, *b)
+}
+
+func subnetID(subnet *network.Subnet) *string {
+	if subnet == nil {
+		return nil
+	}
+	return subnet.ID
+}
+
+func publicIPID(ip *network.PublicIPAddress) *string {
+	if ip == nil {
+		return nil
+	}
+	return ip.ID
+}
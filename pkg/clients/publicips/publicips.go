@@ -13,22 +13,27 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 const expand string = ""
 
 type Client struct {
-	factory  factoryFunc
-	internal network.PublicIPAddressesClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) network.PublicIPAddressesClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, network.NewPublicIPAddressesClient)
+	return NewWithFactory(configuration, func(subscriptionID string) network.PublicIPAddressesClient {
+		return network.NewPublicIPAddressesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -36,19 +41,36 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (network.PublicIPAddressesClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "PublicIP")
+		tracing.Instrument(&client.Client, "PublicIP")
+		c.config.WireLog(&client.Client, "PublicIP")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return network.PublicIPAddressesClient{}, err
+	}
+	return client.(network.PublicIPAddressesClient), nil
 }
 
 // Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
@@ -57,9 +79,34 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
+
+	if found && !NewSpecWithRemote(&remote).NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
+		local.Status.ID = remote.ID
+		local.Status.ProvisioningState = NewSpecWithRemote(&remote).State()
+		return c.Done(ctx, local), nil
+	}
+
 	// TODO(ace): use spec.Set() pattern from other packages
 	spec := network.PublicIPAddress{
 		Location: &local.Spec.Location,
+		Tags:     clientutil.OwnerTags(c.config.ClusterID(), local, remote.Tags),
 		Sku: &network.PublicIPAddressSku{
 			Name: network.PublicIPAddressSkuNameStandard,
 		},
@@ -67,16 +114,18 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 			PublicIPAllocationMethod: network.Static,
 		},
 	}
-	if local.Spec.SKU != nil {
-		spec.Sku.Name = network.PublicIPAddressSkuName(*local.Spec.SKU)
-	}
+	spec.Sku.Name = network.PublicIPAddressSkuName(*sku(local))
 
-	if future, err := c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
+	future, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec)
+	if err != nil {
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusConflict {
 			return false, err
 		}
 		return false, nil
 	}
+	if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
+	}
 
 	if _, err := c.SetStatus(ctx, local); err != nil {
 		return false, err
@@ -85,13 +134,40 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	return c.Done(ctx, local), nil
 }
 
+// Observe refreshes the status of the public IP from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	local.Status.ID = remote.ID
+	if remote.PublicIPAddressPropertiesFormat != nil {
+		local.Status.ProvisioningState = remote.ProvisioningState
+	}
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
+}
+
 // Get returns a virtual network.
 func (c *Client) Get(ctx context.Context, obj runtime.Object) (network.PublicIPAddress, error) {
 	local, err := c.convert(obj)
 	if err != nil {
 		return network.PublicIPAddress{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return network.PublicIPAddress{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 }
 
 // Delete handles deletion of a virtual network.
@@ -100,19 +176,32 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
 	return c.SetStatus(ctx, local)
 }
 
 // SetStatus sets the status subresource fields of the CRD reflecting the state of the object in Azure.
 func (c *Client) SetStatus(ctx context.Context, local *azurev1alpha1.PublicIP) (bool, error) {
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 	// Care about 400 and 5xx, not 404.
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	if err != nil && found {
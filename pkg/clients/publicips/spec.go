@@ -54,11 +54,19 @@ func (s *Spec) NeedsUpdate(local *azurev1alpha1.PublicIP) bool {
 	return clientutil.Any([]func() bool{
 		func() bool { return !cmp.Equal(s.Name(), &local.Spec.Name) },
 		func() bool { return !cmp.Equal(s.Location(), &local.Spec.Location) },
-		func() bool { return !cmp.Equal(s.SKU(), local.Spec.SKU) },
+		func() bool { return !cmp.Equal(s.SKU(), sku(local)) },
 		// func() bool { return !cmp.Equal(s.AllocationMethod(), local.Spec.AllocationMethod) },
 	})
 }
 
+// sku returns the SKU of local, defaulting to standard.
+func sku(local *azurev1alpha1.PublicIP) *string {
+	if local.Spec.SKU == nil {
+		return to.StringPtr(string(network.PublicIPAddressSkuNameStandard))
+	}
+	return local.Spec.SKU
+}
+
 func (s *Spec) Name() *string {
 	return s.internal.Name
 }
@@ -20,14 +20,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
-
-	"github.com/davecgh/go-spew/spew"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
 	factory    factoryFunc
-	internal   redis.Client
+	cache      *clientutil.ClientCache
 	config     *config.Config
 	kubeclient *ctrl.Client
 	scheme     *runtime.Scheme
@@ -37,7 +38,9 @@ type factoryFunc func(subscriptionID string) redis.Client
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) *Client {
-	return NewWithFactory(configuration, kubeclient, redis.NewClient, scheme)
+	return NewWithFactory(configuration, kubeclient, func(subscriptionID string) redis.Client {
+		return redis.NewClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	}, scheme)
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -45,6 +48,7 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, factory factoryFunc, scheme *runtime.Scheme) *Client {
 	return &Client{
+		cache:      clientutil.NewClientCache(),
 		config:     configuration,
 		factory:    factory,
 		kubeclient: kubeclient,
@@ -52,14 +56,30 @@ func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, facto
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (redis.Client, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "Redis")
+		tracing.Instrument(&client.Client, "Redis")
+		c.config.WireLog(&client.Client, "Redis")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return redis.Client{}, err
+	}
+	return client.(redis.Client), nil
 }
 
 // Ensure creates or updates a redis cache in an idempotent manner.
@@ -68,12 +88,24 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
 		return false, err
 	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
 
 	if found {
 		if c.Done(ctx, local) {
@@ -82,11 +114,10 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 					return false, err
 				}
 			}
-			if !c.NeedsUpdate(local, remote) {
+			if !c.NeedsUpdate(local, remote) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
 				return true, nil
 			}
 		} else {
-			spew.Dump("not done")
 			return false, nil
 		}
 	}
@@ -94,6 +125,7 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	// TODO(ace): spec.Set()
 	spec := redis.CreateParameters{
 		Location: &local.Spec.Location,
+		Tags:     clientutil.OwnerTags(c.config.ClusterID(), local, nil),
 		CreateProperties: &redis.CreateProperties{
 			EnableNonSslPort: &local.Spec.EnableNonSslPort,
 			Sku: &redis.Sku{
@@ -104,20 +136,23 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 		},
 	}
 
-	if _, err = c.internal.Create(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
+	future, err := internal.Create(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec)
+	if err != nil {
 		return false, err
 	}
-
-	return false, nil
+	return false, clientutil.RecordOperation(local, future.Future)
 }
 
 func (c *Client) SyncSecrets(ctx context.Context, local *azurev1alpha1.Redis) error {
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
 	if local.Spec.PrimaryKey == nil && local.Spec.SecondaryKey == nil {
 		return nil
 	}
-	keys, err := c.internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
-		spew.Dump("err listing keys")
 		return err
 	}
 
@@ -128,10 +163,11 @@ func (c *Client) SyncSecrets(ctx context.Context, local *azurev1alpha1.Redis) er
 		},
 	}
 
+	data, err := secretData(local, keys)
+	if err != nil {
+		return err
+	}
 	_, err = controllerutil.CreateOrUpdate(ctx, *c.kubeclient, targetSecret, func() error {
-		spew.Dump("set primary")
-		var final *multierror.Error
-
 		if targetSecret.Data == nil {
 			targetSecret.Data = map[string][]byte{}
 		}
@@ -140,27 +176,67 @@ func (c *Client) SyncSecrets(ctx context.Context, local *azurev1alpha1.Redis) er
 		// 	final = multierror.Append(final, controllerutil.SetControllerReference(local, targetSecret, c.scheme))
 		// }
 
-		if local.Spec.PrimaryKey != nil {
-			if keys.PrimaryKey != nil {
-				targetSecret.Data[*local.Spec.PrimaryKey] = []byte(*keys.PrimaryKey)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
+		for key, value := range data {
+			targetSecret.Data[key] = value
 		}
+		return nil
+	})
+	return err
+}
 
-		if local.Spec.SecondaryKey != nil {
-			if keys.SecondaryKey != nil {
-				targetSecret.Data[*local.Spec.SecondaryKey] = []byte(*keys.SecondaryKey)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
+// Observe refreshes the status of the cache from Azure without mutating anything.
+// It reports whether the cache no longer matches the spec, or the target secret no longer holds its keys.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	c.SetStatus(local, remote)
+	if !found || !c.Done(ctx, local) || c.NeedsUpdate(local, remote) {
+		return true, nil
+	}
+	if c.kubeclient == nil || local.Spec.TargetSecret == nil || (local.Spec.PrimaryKey == nil && local.Spec.SecondaryKey == nil) {
+		return false, nil
+	}
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	if err != nil {
+		return false, err
+	}
+	data, err := secretData(local, keys)
+	if err != nil {
+		return false, err
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, local.ObjectMeta.Namespace, *local.Spec.TargetSecret, data)
+}
 
+// secretData returns the entries of the target secret holding the keys requested by the spec of local.
+func secretData(local *azurev1alpha1.Redis, keys redis.AccessKeys) (map[string][]byte, error) {
+	var final *multierror.Error
+	data := map[string][]byte{}
+	if local.Spec.PrimaryKey != nil {
+		if keys.PrimaryKey != nil {
+			data[*local.Spec.PrimaryKey] = []byte(*keys.PrimaryKey)
+		} else {
+			final = multierror.Append(final, errors.New("expected primary key but found nil"))
 		}
-
-		return final.ErrorOrNil()
-	})
-	spew.Dump("returning listkey")
-	return err
+	}
+	if local.Spec.SecondaryKey != nil {
+		if keys.SecondaryKey != nil {
+			data[*local.Spec.SecondaryKey] = []byte(*keys.SecondaryKey)
+		} else {
+			final = multierror.Append(final, errors.New("expected secondary key but found nil"))
+		}
+	}
+	return data, final.ErrorOrNil()
 }
 
 // Delete handles deletion of a resource groups.
@@ -169,15 +245,24 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		resp := future.Response()
 		if resp != nil && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusConflict {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && remote.IsHTTPStatus(http.StatusNotFound) {
@@ -202,29 +287,20 @@ func (c *Client) Done(ctx context.Context, local *azurev1alpha1.Redis) bool {
 
 func (c *Client) NeedsUpdate(local *azurev1alpha1.Redis, remote redis.ResourceType) bool {
 	if remote.Sku != nil {
-		spew.Dump("local")
-		spew.Dump(local.Spec.SKU)
-		spew.Dump("remote")
-		spew.Dump(remote.Sku)
 		if !strings.EqualFold(string(local.Spec.SKU.Name), string(remote.Sku.Name)) {
-			spew.Dump("changed SkuName")
 			return true
 		}
 		if !strings.EqualFold(string(local.Spec.SKU.Family), string(remote.Sku.Family)) {
-			spew.Dump("changed SkuFamily")
 			return true
 		}
-		if remote.Sku.Capacity == nil && local.Spec.SKU.Capacity != *remote.Sku.Capacity {
-			spew.Dump("changed Capacity")
+		if remote.Sku.Capacity != nil && local.Spec.SKU.Capacity != *remote.Sku.Capacity {
 			return true
 		}
 	}
 	if remote.EnableNonSslPort != nil && *remote.EnableNonSslPort != local.Spec.EnableNonSslPort {
-		spew.Dump("changed non ssl port")
 		return true
 	}
-	if remote.Location != nil && strings.EqualFold(*remote.Location, local.Spec.Location) {
-		spew.Dump("changed Location")
+	if remote.Location != nil && !strings.EqualFold(*remote.Location, local.Spec.Location) {
 		return true
 	}
 	return false
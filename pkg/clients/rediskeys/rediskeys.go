@@ -19,14 +19,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
-
-	"github.com/davecgh/go-spew/spew"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
 	factory    factoryFunc
-	internal   redis.Client
+	cache      *clientutil.ClientCache
 	config     *config.Config
 	kubeclient *ctrl.Client
 	scheme     *runtime.Scheme
@@ -36,7 +37,9 @@ type factoryFunc func(subscriptionID string) redis.Client
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) *Client {
-	return NewWithFactory(configuration, kubeclient, redis.NewClient, scheme)
+	return NewWithFactory(configuration, kubeclient, func(subscriptionID string) redis.Client {
+		return redis.NewClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	}, scheme)
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -44,6 +47,7 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, factory factoryFunc, scheme *runtime.Scheme) *Client {
 	return &Client{
+		cache:      clientutil.NewClientCache(),
 		config:     configuration,
 		factory:    factory,
 		kubeclient: kubeclient,
@@ -51,14 +55,30 @@ func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, facto
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (redis.Client, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "RedisKey")
+		tracing.Instrument(&client.Client, "RedisKey")
+		c.config.WireLog(&client.Client, "RedisKey")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return redis.Client{}, err
+	}
+	return client.(redis.Client), nil
 }
 
 func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
@@ -66,13 +86,16 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	if err != nil {
 		return err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
 
 	if local.Spec.PrimaryKey == nil && local.Spec.SecondaryKey == nil {
 		return nil
 	}
-	keys, err := c.internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
-		spew.Dump("err listing keys")
 		return err
 	}
 
@@ -83,10 +106,11 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 		},
 	}
 
+	data, err := secretData(local, keys)
+	if err != nil {
+		return err
+	}
 	_, err = controllerutil.CreateOrUpdate(ctx, *c.kubeclient, targetSecret, func() error {
-		spew.Dump("set primary")
-		var final *multierror.Error
-
 		if targetSecret.Data == nil {
 			targetSecret.Data = map[string][]byte{}
 		}
@@ -95,27 +119,58 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 		// 	final = multierror.Append(final, controllerutil.SetControllerReference(local, targetSecret, c.scheme))
 		// }
 
-		if local.Spec.PrimaryKey != nil {
-			if keys.PrimaryKey != nil {
-				targetSecret.Data[*local.Spec.PrimaryKey] = []byte(*keys.PrimaryKey)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
+		for key, value := range data {
+			targetSecret.Data[key] = value
 		}
+		return nil
+	})
+	return err
+}
 
-		if local.Spec.SecondaryKey != nil {
-			if keys.SecondaryKey != nil {
-				targetSecret.Data[*local.Spec.SecondaryKey] = []byte(*keys.SecondaryKey)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
+// Observe reads the keys of the cache without mutating anything.
+// It reports whether the target secret no longer holds the keys requested by the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if local.Spec.PrimaryKey == nil && local.Spec.SecondaryKey == nil {
+		return false, nil
+	}
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	if err != nil {
+		return false, err
+	}
+	data, err := secretData(local, keys)
+	if err != nil {
+		return false, err
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, local.ObjectMeta.Namespace, local.Spec.TargetSecret, data)
+}
 
+// secretData returns the entries of the target secret holding the keys requested by the spec of local.
+func secretData(local *azurev1alpha1.RedisKey, keys redis.AccessKeys) (map[string][]byte, error) {
+	var final *multierror.Error
+	data := map[string][]byte{}
+	if local.Spec.PrimaryKey != nil {
+		if keys.PrimaryKey != nil {
+			data[*local.Spec.PrimaryKey] = []byte(*keys.PrimaryKey)
+		} else {
+			final = multierror.Append(final, errors.New("expected primary key but found nil"))
 		}
-
-		return final.ErrorOrNil()
-	})
-	spew.Dump("returning listkey")
-	return err
+	}
+	if local.Spec.SecondaryKey != nil {
+		if keys.SecondaryKey != nil {
+			data[*local.Spec.SecondaryKey] = []byte(*keys.SecondaryKey)
+		} else {
+			final = multierror.Append(final, errors.New("expected secondary key but found nil"))
+		}
+	}
+	return data, final.ErrorOrNil()
 }
 
 // Delete handles deletion of a resource groups.
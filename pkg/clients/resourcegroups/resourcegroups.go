@@ -8,27 +8,30 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"net/http/httputil"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
-	"github.com/Azure/go-autorest/autorest"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	factory  factoryFunc
-	internal resources.GroupsClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) resources.GroupsClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, resources.NewGroupsClient)
+	return NewWithFactory(configuration, func(subscriptionID string) resources.GroupsClient {
+		return resources.NewGroupsClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -36,19 +39,36 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (resources.GroupsClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "ResourceGroup")
+		tracing.Instrument(&client.Client, "ResourceGroup")
+		c.config.WireLog(&client.Client, "ResourceGroup")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return resources.GroupsClient{}, err
+	}
+	return client.(resources.GroupsClient), nil
 }
 
 // Ensure creates or updates a resource group in an idempotent manner.
@@ -57,19 +77,28 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
 
-	remote, err := c.internal.Get(ctx, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
 		return false, err
 	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
 
 	var spec *Spec
 	if found {
 		spec = NewSpecWithRemote(&remote)
 		if c.Done(ctx, local) {
-			if !spec.NeedsUpdate(local) {
+			if !spec.NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
 				return true, nil
 			}
 		} else {
@@ -84,25 +113,56 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 		Location(local.Spec.Location),
 	)
 
-	_, err = c.internal.CreateOrUpdate(ctx, local.Spec.Name, spec.Build())
+	group := spec.Build()
+	group.Tags = clientutil.OwnerTags(c.config.ClusterID(), local, group.Tags)
+	_, err = internal.CreateOrUpdate(ctx, local.Spec.Name, group)
 	return false, err
 }
 
+// Observe refreshes the status of the resource group from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	c.SetStatus(local, remote)
+	if err != nil && found {
+		return false, err
+	}
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
+}
+
 // Delete handles deletion of a resource groups.
 func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	local, err := c.convert(obj)
 	if err != nil {
 		return false, err
 	}
-	future, err := c.internal.Delete(ctx, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		resp := future.Response()
 		if resp != nil && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusConflict {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && remote.IsHTTPStatus(http.StatusNotFound) {
@@ -134,34 +194,6 @@ func (c *Client) NeedsUpdate(local *azurev1alpha1.ResourceGroup, remote resource
 	return false
 }
 
-func LogRequest() autorest.PrepareDecorator {
-	return func(p autorest.Preparer) autorest.Preparer {
-		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
-			r, err := p.Prepare(r)
-			if err != nil {
-				fmt.Println(err)
-			}
-			dump, _ := httputil.DumpRequestOut(r, true)
-			fmt.Println(string(dump))
-			return r, err
-		})
-	}
-}
-
-func LogResponse() autorest.RespondDecorator {
-	return func(p autorest.Responder) autorest.Responder {
-		return autorest.ResponderFunc(func(r *http.Response) error {
-			err := p.Respond(r)
-			if err != nil {
-				fmt.Println(err)
-			}
-			dump, _ := httputil.DumpResponse(r, true)
-			fmt.Println(string(dump))
-			return err
-		})
-	}
-}
-
 func (c *Client) convert(obj runtime.Object) (*azurev1alpha1.ResourceGroup, error) {
 	local, ok := obj.(*azurev1alpha1.ResourceGroup)
 	if !ok {
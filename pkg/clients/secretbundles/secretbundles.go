@@ -16,7 +16,6 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -28,10 +27,13 @@ import (
 	"software.sslmate.com/src/go-pkcs12"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/redis"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/servicebus"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/tlssecrets"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
@@ -50,9 +52,12 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 	kvclient := keyvault.New()
 	authorizer, err := configuration.GetKeyvaultAuthorizer()
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	kvclient.Authorizer = authorizer
+	metrics.Instrument(&kvclient.Client, "SecretBundle")
+	tracing.Instrument(&kvclient.Client, "SecretBundle")
+	configuration.WireLog(&kvclient.Client, "SecretBundle")
 	return &Client{
 		internal:      kvclient,
 		kubeclient:    kubeclient,
@@ -68,48 +73,13 @@ func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error
 
 // Ensure takes a spec corresponding to one Azure KV secret. It syncs that secret into Kubernetes, remapping the name if necessary.
 func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
-	// TODO(ace): cloud-sensitive
 	secret, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	secrets := map[string][]byte{}
-	for name, item := range secret.Spec.Secrets {
-		// TODO(ace): more graceful error handling?
-		// parallelize and collect?
-		vault := fmt.Sprintf("https://%s.%s", item.Vault, azure.PublicCloud.KeyVaultDNSSuffix)
-		if item.Kind == nil {
-			bundle, err := c.internal.GetSecret(ctx, vault, item.Name, "")
-			if err != nil {
-				return err
-			}
-			secrets[name] = []byte(*bundle.Value)
-			continue
-		}
-
-		switch *item.Kind {
-		case "sha":
-			// Shortcircuit SHA handling because it uses a different client
-			cert, err := c.internal.GetCertificate(ctx, vault, item.Name, "")
-			if err != nil {
-				return err
-			}
-			out, err := formatSHA(*cert.X509Thumbprint)
-			if err != nil {
-				return err
-			}
-			secrets[name] = out
-		default:
-			bundle, err := c.internal.GetSecret(ctx, vault, item.Name, "")
-			if err != nil {
-				return err
-			}
-			output, err := format(*item.Kind, *bundle.Value, item.Reverse)
-			if err != nil {
-				return err
-			}
-			secrets[name] = output
-		}
+	secrets, err := c.data(ctx, secret)
+	if err != nil {
+		return err
 	}
 
 	local := &corev1.Secret{
@@ -145,7 +115,64 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	return err
 }
 
-// Delete deletes a secret from Keyvault.
+// Observe reads the secrets of the bundle from Keyvault without mutating anything.
+// It reports whether the Kubernetes secret no longer holds them.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	secret, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	secrets, err := c.data(ctx, secret)
+	if err != nil {
+		return false, err
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, secret.ObjectMeta.Namespace, secret.ObjectMeta.Name, secrets)
+}
+
+// data reads every secret of the bundle from Keyvault, formatted as requested by its kind.
+func (c *Client) data(ctx context.Context, secret *azurev1alpha1.SecretBundle) (map[string][]byte, error) {
+	secrets := map[string][]byte{}
+	for name, item := range secret.Spec.Secrets {
+		// TODO(ace): more graceful error handling?
+		// parallelize and collect?
+		vault := c.configuration.VaultURL(item.Vault)
+		if item.Kind == nil {
+			bundle, err := c.internal.GetSecret(ctx, vault, item.Name, "")
+			if err != nil {
+				return nil, err
+			}
+			secrets[name] = []byte(*bundle.Value)
+			continue
+		}
+
+		switch *item.Kind {
+		case "sha":
+			// Shortcircuit SHA handling because it uses a different client
+			cert, err := c.internal.GetCertificate(ctx, vault, item.Name, "")
+			if err != nil {
+				return nil, err
+			}
+			out, err := formatSHA(*cert.X509Thumbprint)
+			if err != nil {
+				return nil, err
+			}
+			secrets[name] = out
+		default:
+			bundle, err := c.internal.GetSecret(ctx, vault, item.Name, "")
+			if err != nil {
+				return nil, err
+			}
+			output, err := format(*item.Kind, *bundle.Value, item.Reverse)
+			if err != nil {
+				return nil, err
+			}
+			secrets[name] = output
+		}
+	}
+	return secrets, nil
+}
+
+// Delete removes the Kubernetes secret written by Ensure, leaving Keyvault untouched.
 func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	secret, err := c.convert(obj)
 	if err != nil {
@@ -153,7 +180,7 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	}
 	local := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      secret.Spec.Name,
+			Name:      secret.ObjectMeta.Name,
 			Namespace: secret.ObjectMeta.Namespace,
 		},
 	}
@@ -10,7 +10,6 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/to"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,13 +19,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	internal   keyvault.BaseClient
-	kubeclient *ctrl.Client
-	scheme     *runtime.Scheme
+	internal      keyvault.BaseClient
+	configuration *config.Config
+	kubeclient    *ctrl.Client
+	scheme        *runtime.Scheme
 }
 
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) (*Client, error) {
@@ -36,10 +39,13 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 	kvclient := keyvault.New()
 	authorizer, err := configuration.GetKeyvaultAuthorizer()
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	kvclient.Authorizer = authorizer
-	return &Client{internal: kvclient, kubeclient: kubeclient, scheme: scheme}, nil
+	metrics.Instrument(&kvclient.Client, "Secret")
+	tracing.Instrument(&kvclient.Client, "Secret")
+	configuration.WireLog(&kvclient.Client, "Secret")
+	return &Client{internal: kvclient, configuration: configuration, kubeclient: kubeclient, scheme: scheme}, nil
 }
 
 // ForSubscription authorizes the client for a given subscription
@@ -53,18 +59,17 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (keyvault.SecretBu
 	if err != nil {
 		return keyvault.SecretBundle{}, err
 	}
-	vault := fmt.Sprintf("https://%s.%s", secret.Spec.Vault, azure.PublicCloud.KeyVaultDNSSuffix)
+	vault := c.configuration.VaultURL(secret.Spec.Vault)
 	return c.internal.GetSecret(ctx, vault, secret.Spec.Name, "")
 }
 
 // Ensure takes a spec corresponding to one Azure KV secret. It syncs that secret into Kubernetes, remapping the name if necessary.
 func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
-	// TODO(ace): cloud-sensitive
 	secret, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	vault := fmt.Sprintf("https://%s.%s", secret.Spec.Vault, azure.PublicCloud.KeyVaultDNSSuffix)
+	vault := c.configuration.VaultURL(secret.Spec.Vault)
 	bundle, err := c.internal.GetSecret(ctx, vault, secret.Spec.Name, "")
 	if err != nil {
 		return err
@@ -102,7 +107,27 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	return err
 }
 
-// Delete deletes a secret from Keyvault.
+// Observe reads the secret from Keyvault without mutating anything.
+// It reports whether the Kubernetes secret no longer holds its value.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	secret, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	bundle, err := c.Get(ctx, secret)
+	if err != nil {
+		return false, err
+	}
+	key := secret.Spec.Name
+	if secret.Spec.FriendlyName != nil {
+		key = *secret.Spec.FriendlyName
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, secret.ObjectMeta.Namespace, secret.ObjectMeta.Name, map[string][]byte{
+		key: []byte(*bundle.Value),
+	})
+}
+
+// Delete removes the Kubernetes secret written by Ensure, leaving Keyvault untouched.
 func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
@@ -110,7 +135,7 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	}
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      local.Spec.Name,
+			Name:      local.ObjectMeta.Name,
 			Namespace: local.ObjectMeta.Namespace,
 		},
 	}
@@ -0,0 +1,17 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package secrets
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecrets(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "secrets")
+}
@@ -10,25 +10,31 @@ import (
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 const expand string = ""
 
 type Client struct {
-	factory  factoryFunc
-	internal network.SecurityGroupsClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) network.SecurityGroupsClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, network.NewSecurityGroupsClient)
+	return NewWithFactory(configuration, func(subscriptionID string) network.SecurityGroupsClient {
+		return network.NewSecurityGroupsClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -36,19 +42,36 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (network.SecurityGroupsClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "SecurityGroup")
+		tracing.Instrument(&client.Client, "SecurityGroup")
+		c.config.WireLog(&client.Client, "SecurityGroup")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return network.SecurityGroupsClient{}, err
+	}
+	return client.(network.SecurityGroupsClient), nil
 }
 
 // Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
@@ -57,36 +80,50 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
+	}
+
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
+
+	if found && !needsUpdate(local, remote) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
+		local.Status.ID = remote.ID
+		local.Status.ProvisioningState = remote.ProvisioningState
+		return c.Done(ctx, local), nil
+	}
 
+	rules := securityRules(local)
 	spec := network.SecurityGroup{
 		Location: &local.Spec.Location,
+		Tags:     clientutil.OwnerTags(c.config.ClusterID(), local, remote.Tags),
 		SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
-			SecurityRules: &[]network.SecurityRule{},
+			SecurityRules: &rules,
 		},
 	}
-	for _, rule := range local.Spec.Rules {
-		newRule := network.SecurityRule{
-			Name: &rule.Name,
-			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
-				Protocol:                 rule.Protocol,
-				SourceAddressPrefix:      rule.SourceAddressPrefix,
-				SourcePortRange:          rule.SourcePortRange,
-				DestinationAddressPrefix: rule.DestinationAddressPrefix,
-				DestinationPortRange:     rule.DestinationPortRange,
-				Access:                   rule.Access,
-				Direction:                rule.Direction,
-				Priority:                 rule.Priority,
-			},
-		}
-		*spec.SecurityGroupPropertiesFormat.SecurityRules = append(*spec.SecurityGroupPropertiesFormat.SecurityRules, newRule)
-	}
 
-	if future, err := c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
+	future, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec)
+	if err != nil {
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusConflict {
 			return false, err
 		}
 		return false, nil
 	}
+	if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
+	}
 
 	if _, err := c.SetStatus(ctx, local); err != nil {
 		return false, err
@@ -95,13 +132,41 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	return c.Done(ctx, local), nil
 }
 
+// Observe refreshes the status of the security group from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	local.Status.ID = remote.ID
+	local.Status.ProvisioningState = nil
+	if remote.SecurityGroupPropertiesFormat != nil {
+		local.Status.ProvisioningState = remote.ProvisioningState
+	}
+	return !found || needsUpdate(local, remote), nil
+}
+
 // Get returns a virtual network.
 func (c *Client) Get(ctx context.Context, obj runtime.Object) (network.SecurityGroup, error) {
 	local, err := c.convert(obj)
 	if err != nil {
 		return network.SecurityGroup{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return network.SecurityGroup{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 }
 
 // Delete handles deletion of a virtual network.
@@ -110,19 +175,32 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
 	return c.SetStatus(ctx, local)
 }
 
 // SetStatus sets the status subresource fields of the CRD reflecting the state of the object in Azure.
 func (c *Client) SetStatus(ctx context.Context, local *azurev1alpha1.SecurityGroup) (bool, error) {
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, expand)
 	// Care about 400 and 5xx, not 404.
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	if err != nil && found {
@@ -147,6 +225,58 @@ func (c *Client) Done(ctx context.Context, local *azurev1alpha1.SecurityGroup) b
 	return true
 }
 
+// securityRules returns the security rules described by the spec of local.
+func securityRules(local *azurev1alpha1.SecurityGroup) []network.SecurityRule {
+	rules := []network.SecurityRule{}
+	for i := range local.Spec.Rules {
+		rule := local.Spec.Rules[i]
+		rules = append(rules, network.SecurityRule{
+			Name: &rule.Name,
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Protocol:                 rule.Protocol,
+				SourceAddressPrefix:      rule.SourceAddressPrefix,
+				SourcePortRange:          rule.SourcePortRange,
+				DestinationAddressPrefix: rule.DestinationAddressPrefix,
+				DestinationPortRange:     rule.DestinationPortRange,
+				Access:                   rule.Access,
+				Direction:                rule.Direction,
+				Priority:                 rule.Priority,
+			},
+		})
+	}
+	return rules
+}
+
+// needsUpdate reports whether the security group in Azure differs from the spec of local, ignoring fields filled in by Azure.
+func needsUpdate(local *azurev1alpha1.SecurityGroup, remote network.SecurityGroup) bool {
+	if remote.Location == nil || *remote.Location != local.Spec.Location {
+		return true
+	}
+	if remote.SecurityGroupPropertiesFormat == nil || remote.SecurityRules == nil {
+		return true
+	}
+	rules := []network.SecurityRule{}
+	for _, rule := range *remote.SecurityRules {
+		if rule.SecurityRulePropertiesFormat == nil {
+			return true
+		}
+		rules = append(rules, network.SecurityRule{
+			Name: rule.Name,
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Protocol:                 rule.Protocol,
+				SourceAddressPrefix:      rule.SourceAddressPrefix,
+				SourcePortRange:          rule.SourcePortRange,
+				DestinationAddressPrefix: rule.DestinationAddressPrefix,
+				DestinationPortRange:     rule.DestinationPortRange,
+				Access:                   rule.Access,
+				Direction:                rule.Direction,
+				Priority:                 rule.Priority,
+			},
+		})
+	}
+	return !cmp.Equal(rules, securityRules(local))
+}
+
 func (c *Client) convert(obj runtime.Object) (*azurev1alpha1.SecurityGroup, error) {
 	local, ok := obj.(*azurev1alpha1.SecurityGroup)
 	if !ok {
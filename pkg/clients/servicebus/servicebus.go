@@ -20,13 +20,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
-	"github.com/davecgh/go-spew/spew"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
 	factory    factoryFunc
-	internal   servicebus.NamespacesClient
+	cache      *clientutil.ClientCache
 	config     *config.Config
 	kubeclient *ctrl.Client
 	scheme     *runtime.Scheme
@@ -36,7 +38,9 @@ type factoryFunc func(subscriptionID string) servicebus.NamespacesClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) *Client {
-	return NewWithFactory(configuration, kubeclient, servicebus.NewNamespacesClient, scheme)
+	return NewWithFactory(configuration, kubeclient, func(subscriptionID string) servicebus.NamespacesClient {
+		return servicebus.NewNamespacesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	}, scheme)
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -44,6 +48,7 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, factory factoryFunc, scheme *runtime.Scheme) *Client {
 	return &Client{
+		cache:      clientutil.NewClientCache(),
 		config:     configuration,
 		factory:    factory,
 		kubeclient: kubeclient,
@@ -51,14 +56,30 @@ func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, facto
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (servicebus.NamespacesClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "ServiceBusNamespace")
+		tracing.Instrument(&client.Client, "ServiceBusNamespace")
+		c.config.WireLog(&client.Client, "ServiceBusNamespace")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return servicebus.NamespacesClient{}, err
+	}
+	return client.(servicebus.NamespacesClient), nil
 }
 
 // Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
@@ -67,13 +88,25 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
+	}
 
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
 		return false, err
 	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
 
 	if found {
 		if c.Done(ctx, local) {
@@ -82,17 +115,17 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 					return false, err
 				}
 			}
-			if !c.NeedsUpdate(local, remote) {
+			if !c.NeedsUpdate(local, remote) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
 				return true, nil
 			}
 		} else {
-			spew.Dump("not done")
 			return false, nil
 		}
 	}
 
 	spec := servicebus.SBNamespace{
 		Location: &local.Spec.Location,
+		Tags:     clientutil.OwnerTags(c.config.ClusterID(), local, nil),
 		Sku: &servicebus.SBSku{
 			Name:     servicebus.SkuName(local.Spec.SKU.Name),
 			Tier:     servicebus.SkuTier(local.Spec.SKU.Tier),
@@ -100,10 +133,11 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 		},
 	}
 
-	if _, err = c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
+	future, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec)
+	if err != nil {
 		return false, err
 	}
-	return false, nil
+	return false, clientutil.RecordOperation(local, future.Future)
 }
 
 // Get returns a service bus.
@@ -112,12 +146,20 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (servicebus.SBName
 	if err != nil {
 		return servicebus.SBNamespace{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return servicebus.SBNamespace{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 }
 
 // ListKeys returns an array of keys for a storage account.
 func (c *Client) ListKeys(ctx context.Context, local *azurev1alpha1.ServiceBusNamespace) (map[string][]byte, error) {
-	keys, err := c.internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name, "RootManageSharedAccessKey")
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name, "RootManageSharedAccessKey")
 	if err != nil {
 		return nil, err
 	}
@@ -140,13 +182,15 @@ func (c *Client) ListKeys(ctx context.Context, local *azurev1alpha1.ServiceBusNa
 }
 
 func (c *Client) SyncSecrets(ctx context.Context, local *azurev1alpha1.ServiceBusNamespace) error {
-	spew.Dump("listing keys")
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
 	if local.Spec.TargetSecret == nil {
 		return nil
 	}
-	keys, err := c.internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name, "RootManageSharedAccessKey")
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name, "RootManageSharedAccessKey")
 	if err != nil {
-		spew.Dump("err listing keys")
 		return err
 	}
 
@@ -156,10 +200,11 @@ func (c *Client) SyncSecrets(ctx context.Context, local *azurev1alpha1.ServiceBu
 			Namespace: local.ObjectMeta.Namespace,
 		},
 	}
+	data, err := secretData(local, keys)
+	if err != nil {
+		return err
+	}
 	_, err = controllerutil.CreateOrUpdate(ctx, *c.kubeclient, targetSecret, func() error {
-		spew.Dump("set primary")
-		var final *multierror.Error
-
 		if targetSecret.Data == nil {
 			targetSecret.Data = map[string][]byte{}
 		}
@@ -168,46 +213,72 @@ func (c *Client) SyncSecrets(ctx context.Context, local *azurev1alpha1.ServiceBu
 		// 	final = multierror.Append(final, controllerutil.SetControllerReference(local, targetSecret, c.scheme))
 		// }
 
-		if local.Spec.PrimaryKey != nil {
-			if keys.PrimaryKey != nil {
-				targetSecret.Data[*local.Spec.PrimaryKey] = []byte(*keys.PrimaryKey)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
+		for key, value := range data {
+			targetSecret.Data[key] = value
 		}
+		return nil
+	})
 
-		if local.Spec.SecondaryKey != nil {
-			if keys.SecondaryKey != nil {
-				targetSecret.Data[*local.Spec.SecondaryKey] = []byte(*keys.SecondaryKey)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
-
-		}
+	return err
+}
 
-		if local.Spec.PrimaryConnectionString != nil {
-			if keys.PrimaryConnectionString != nil {
-				targetSecret.Data[*local.Spec.PrimaryConnectionString] = []byte(*keys.PrimaryConnectionString)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
+// Observe refreshes the status of the namespace from Azure without mutating anything.
+// It reports whether the namespace no longer matches the spec, or the target secret no longer holds its keys.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	c.SetStatus(local, remote)
+	if !found || !c.Done(ctx, local) || c.NeedsUpdate(local, remote) {
+		return true, nil
+	}
+	if c.kubeclient == nil || local.Spec.TargetSecret == nil {
+		return false, nil
+	}
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name, "RootManageSharedAccessKey")
+	if err != nil {
+		return false, err
+	}
+	data, err := secretData(local, keys)
+	if err != nil {
+		return false, err
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, local.ObjectMeta.Namespace, *local.Spec.TargetSecret, data)
+}
 
+// secretData returns the entries of the target secret holding the keys requested by the spec of local.
+func secretData(local *azurev1alpha1.ServiceBusNamespace, keys servicebus.AccessKeys) (map[string][]byte, error) {
+	var final *multierror.Error
+	data := map[string][]byte{}
+	for _, entry := range []struct {
+		name, value *string
+		description string
+	}{
+		{local.Spec.PrimaryKey, keys.PrimaryKey, "primary key"},
+		{local.Spec.SecondaryKey, keys.SecondaryKey, "secondary key"},
+		{local.Spec.PrimaryConnectionString, keys.PrimaryConnectionString, "primary connection string"},
+		{local.Spec.SecondaryConnectionString, keys.SecondaryConnectionString, "secondary connection string"},
+	} {
+		if entry.name == nil {
+			continue
 		}
-
-		if local.Spec.SecondaryConnectionString != nil {
-			if keys.SecondaryConnectionString != nil {
-				targetSecret.Data[*local.Spec.SecondaryConnectionString] = []byte(*keys.SecondaryConnectionString)
-			} else {
-				final = multierror.Append(final, errors.New("expected primary key but found nil"))
-			}
-
+		if entry.value == nil {
+			final = multierror.Append(final, errors.Errorf("expected %s but found nil", entry.description))
+			continue
 		}
-
-		return final.ErrorOrNil()
-	})
-	spew.Dump("returning listkey")
-
-	return err
+		data[*entry.name] = []byte(*entry.value)
+	}
+	return data, final.ErrorOrNil()
 }
 
 // Delete handles deletion of a virtual network.
@@ -216,14 +287,23 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && remote.IsHTTPStatus(http.StatusNotFound) {
@@ -250,20 +330,16 @@ func (c *Client) Done(ctx context.Context, local *azurev1alpha1.ServiceBusNamesp
 func (c *Client) NeedsUpdate(local *azurev1alpha1.ServiceBusNamespace, remote servicebus.SBNamespace) bool {
 	if remote.Sku != nil {
 		if !strings.EqualFold(string(local.Spec.SKU.Name), string(remote.Sku.Name)) {
-			spew.Dump("changed sku name")
 			return true
 		}
 		if !strings.EqualFold(string(local.Spec.SKU.Tier), string(remote.Sku.Tier)) {
-			spew.Dump("changed sku tier")
 			return true
 		}
 		if remote.Sku.Capacity != nil && local.Spec.SKU.Capacity != *remote.Sku.Capacity {
-			spew.Dump("changed capacity")
 			return true
 		}
 	}
-	if remote.Location != nil && strings.EqualFold(*remote.Location, local.Spec.Location) {
-		spew.Dump("changed Location")
+	if remote.Location != nil && !strings.EqualFold(*remote.Location, local.Spec.Location) {
 		return true
 	}
 	return false
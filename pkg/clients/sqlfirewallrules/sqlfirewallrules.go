@@ -13,20 +13,25 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	factory  factoryFunc
-	internal sql.FirewallRulesClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) sql.FirewallRulesClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, sql.NewFirewallRulesClient)
+	return NewWithFactory(configuration, func(subscriptionID string) sql.FirewallRulesClient {
+		return sql.NewFirewallRulesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -34,19 +39,36 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (sql.FirewallRulesClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "SQLFirewallRule")
+		tracing.Instrument(&client.Client, "SQLFirewallRule")
+		c.config.WireLog(&client.Client, "SQLFirewallRule")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return sql.FirewallRulesClient{}, err
+	}
+	return client.(sql.FirewallRulesClient), nil
 }
 
 // Ensure creates or updates a SQL server in an idempotent manner and sets its provisioning state.
@@ -55,8 +77,12 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	if err != nil {
 		return err
 	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
 
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
@@ -67,11 +93,10 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	var spec *Spec
 	if found {
 		spec = NewSpecWithRemote(&remote)
-		// TODO(ace): this is not checking whether the secret needs to be updated
 		// TODO(ace): this should be an extension point to gracefully handle immutable updates
-		// if !spec.NeedsUpdate(local) {
-		// 	return nil
-		// }
+		if !spec.NeedsUpdate(local) {
+			return nil
+		}
 	} else {
 		spec = NewSpec()
 	}
@@ -82,8 +107,32 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 		End(&local.Spec.End),
 	)
 
-	_, err = c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name, spec.Build())
-	return err
+	remote, err = internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name, spec.Build())
+	if err != nil {
+		return err
+	}
+	c.SetStatus(local, remote)
+	return nil
+}
+
+// Observe refreshes the status of the firewall rule from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	c.SetStatus(local, remote)
+	if err != nil && found {
+		return false, err
+	}
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
 }
 
 // Get returns a SQL server.
@@ -92,7 +141,11 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (sql.FirewallRule,
 	if err != nil {
 		return sql.FirewallRule{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return sql.FirewallRule{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name)
 }
 
 // Delete handles deletion of a SQL server.
@@ -101,7 +154,11 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	if err != nil {
 		return err
 	}
-	_, err = c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	_, err = internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Server, local.Spec.Name)
 	return nil
 }
 
@@ -6,11 +6,12 @@ package sqlservers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
-	"github.com/sanity-io/litter"
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,11 +24,13 @@ import (
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/sqlfirewallrules"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
 	factory    factoryFunc
-	internal   sql.ServersClient
+	cache      *clientutil.ClientCache
 	firewalls  *sqlfirewallrules.Client
 	kubeclient *client.Client
 	config     *config.Config
@@ -38,7 +41,9 @@ type factoryFunc func(subscriptionID string) sql.ServersClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config, kubeclient *client.Client, scheme *runtime.Scheme) *Client {
-	return NewWithFactory(configuration, kubeclient, sql.NewServersClient, scheme)
+	return NewWithFactory(configuration, kubeclient, func(subscriptionID string) sql.ServersClient {
+		return sql.NewServersClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	}, scheme)
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -46,6 +51,7 @@ func New(configuration *config.Config, kubeclient *client.Client, scheme *runtim
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, kubeclient *client.Client, factory factoryFunc, scheme *runtime.Scheme) *Client {
 	return &Client{
+		cache:      clientutil.NewClientCache(),
 		config:     configuration,
 		factory:    factory,
 		kubeclient: kubeclient,
@@ -54,36 +60,81 @@ func NewWithFactory(configuration *config.Config, kubeclient *client.Client, fac
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (sql.ServersClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "SQLServer")
+		tracing.Instrument(&client.Client, "SQLServer")
+		c.config.WireLog(&client.Client, "SQLServer")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return sql.ServersClient{}, err
+	}
+	return client.(sql.ServersClient), nil
 }
 
 // Ensure creates or updates a SQL server in an idempotent manner and sets its provisioning state.
-func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
+// It returns true once the last create or update has finished and the firewall rules are in place.
+func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	local, err := c.convert(obj)
 	if err != nil {
-		return err
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+
+	// Azure never returns the password, so the secret records the one last applied once an operation finishes.
+	resumed := local.Status.Operation != nil
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut, http.MethodPatch); pending || err != nil {
+		return false, err
 	}
 
 	// Set status
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
-		return err
+		return false, err
+	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
 	}
 
 	// TODO(ace): create something like SQLServerCredential CRD, and pivot on state of that
 	// Will allow for higher level orchestration better than the raw Kubernetes secret (?)
 	targetSecret, err := c.ensureSecret(ctx, local)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	if found && resumed {
+		if err := c.markPasswordApplied(ctx, targetSecret); err != nil {
+			return false, err
+		}
+	}
+	if found && passwordApplied(targetSecret) && !NewSpecWithRemote(&remote).NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
+		// Block access after creation if desired
+		if err := c.ensureRule(ctx, local); err != nil {
+			return false, err
+		}
+		return true, nil
 	}
 
 	// Pull from secret. Known to exist by construction.
@@ -94,11 +145,7 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	var spec *Spec
 	if found {
 		spec = NewSpecWithRemote(&remote)
-		// TODO(ace): this is not checking whether the secret needs to be updated
 		// TODO(ace): this should be an extension point to gracefully handle immutable updates
-		// if !spec.NeedsUpdate(local) {
-		// 	return nil
-		// }
 	} else {
 		spec = NewSpec()
 	}
@@ -112,37 +159,25 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	)
 
 	// Apply to Azure. Use Update() if the object was found, to ensure that we set the password.
+	// The operation is polled by later passes instead of blocking the reconcile.
 	if found {
 		updateProps := sql.ServerUpdate{
 			ServerProperties: spec.Build().ServerProperties,
+			Tags:             clientutil.OwnerTags(c.config.ClusterID(), local, remote.Tags),
 		}
-		future, err := c.internal.Update(ctx, local.Spec.ResourceGroup, local.Spec.Name, updateProps)
-		if err != nil {
-			return err
-		}
-		b, err := future.MarshalJSON()
-		litter.Dump(string(b))
-		if err := future.WaitForCompletionRef(ctx, c.internal.Client); err != nil {
-			return err
-		}
-	} else {
-		// Opt to allow for blocking calls, highly parallelizing the controller instead.
-		future, err := c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.Build())
+		future, err := internal.Update(ctx, local.Spec.ResourceGroup, local.Spec.Name, updateProps)
 		if err != nil {
-			return nil
-		}
-		b, err := future.MarshalJSON()
-		litter.Dump(string(b))
-		if err := future.WaitForCompletionRef(ctx, c.internal.Client); err != nil {
-			return err
+			return false, err
 		}
+		return false, clientutil.RecordOperation(local, future.Future)
 	}
-
-	// Block access after creation if desired
-	if err := c.ensureRule(ctx, local); err != nil {
-		return err
+	server := spec.Build()
+	server.Tags = clientutil.OwnerTags(c.config.ClusterID(), local, nil)
+	future, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, server)
+	if err != nil {
+		return false, err
 	}
-	return nil
+	return false, clientutil.RecordOperation(local, future.Future)
 }
 
 func (c *Client) ensureSecret(ctx context.Context, local *azurev1alpha1.SQLServer) (*corev1.Secret, error) {
@@ -186,8 +221,78 @@ func (c *Client) ensureSecret(ctx context.Context, local *azurev1alpha1.SQLServe
 	return targetSecret, nil
 }
 
-func (c *Client) ensureRule(ctx context.Context, local *azurev1alpha1.SQLServer) error {
-	rule := &azurev1alpha1.SQLFirewallRule{
+// markPasswordApplied records the password in secret as applied to the server.
+func (c *Client) markPasswordApplied(ctx context.Context, secret *corev1.Secret) error {
+	if passwordApplied(secret) {
+		return nil
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[azurev1alpha1.AppliedPasswordAnnotation] = passwordHash(secret)
+	return (*c.kubeclient).Update(ctx, secret)
+}
+
+// passwordApplied reports whether the password in secret is the one last applied to the server.
+func passwordApplied(secret *corev1.Secret) bool {
+	return secret.Annotations[azurev1alpha1.AppliedPasswordAnnotation] == passwordHash(secret)
+}
+
+func passwordHash(secret *corev1.Secret) string {
+	sum := sha256.Sum256(secret.Data["password"])
+	return hex.EncodeToString(sum[:])
+}
+
+// Observe refreshes the status of the server from Azure without mutating anything.
+// It reports whether the server no longer matches the spec, its admin secret is gone or holds a password which was not applied,
+// or the rule allowing Azure services is missing or present against the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	c.SetStatus(local, remote)
+	if !found || NewSpecWithRemote(&remote).NeedsUpdate(local) {
+		return true, nil
+	}
+
+	secret := &corev1.Secret{}
+	err = (*c.kubeclient).Get(ctx, types.NamespacedName{Name: local.Spec.Name, Namespace: local.ObjectMeta.Namespace}, secret)
+	if apierrs.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !passwordApplied(secret) {
+		return true, nil
+	}
+
+	rule := firewallRule(local)
+	if err := c.firewalls.ForSubscription(ctx, rule); err != nil {
+		return false, err
+	}
+	existing, err := c.firewalls.Get(ctx, rule)
+	ruleFound := !existing.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && ruleFound {
+		return false, err
+	}
+	allowed := local.Spec.AllowAzureServiceAccess != nil && *local.Spec.AllowAzureServiceAccess
+	return allowed != ruleFound, nil
+}
+
+// firewallRule returns the rule allowing Azure services to reach the server.
+func firewallRule(local *azurev1alpha1.SQLServer) *azurev1alpha1.SQLFirewallRule {
+	return &azurev1alpha1.SQLFirewallRule{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      local.Spec.Name,
 			Namespace: local.ObjectMeta.Namespace,
@@ -201,7 +306,10 @@ func (c *Client) ensureRule(ctx context.Context, local *azurev1alpha1.SQLServer)
 			End:            "0.0.0.0",
 		},
 	}
+}
 
+func (c *Client) ensureRule(ctx context.Context, local *azurev1alpha1.SQLServer) error {
+	rule := firewallRule(local)
 	if err := c.firewalls.ForSubscription(ctx, rule); err != nil {
 		return err
 	}
@@ -220,6 +328,13 @@ func (c *Client) ensureRule(ctx context.Context, local *azurev1alpha1.SQLServer)
 			return err
 		}
 	} else {
+		existing, err := c.firewalls.Get(ctx, rule)
+		if existing.IsHTTPStatus(http.StatusNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
 		if err := c.firewalls.Delete(ctx, rule); err != nil {
 			fmt.Printf("err: %s", err.Error())
 			return err
@@ -234,14 +349,22 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (sql.Server, error
 	if err != nil {
 		return sql.Server{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return sql.Server{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 }
 
-// Delete handles deletion of a SQL server.
-func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
+// Delete handles deletion of a SQL server. It returns true while the server still exists.
+func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	local, err := c.convert(obj)
 	if err != nil {
-		return err
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
 	}
 
 	targetSecret := &corev1.Secret{
@@ -253,18 +376,28 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 
 	// Get SQL Server secret
 	if err = (*c.kubeclient).Delete(ctx, targetSecret); client.IgnoreNotFound(err) != nil {
-		return err
+		return false, err
 	}
 
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
-	if err != nil {
-		return err
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
 	}
-	err = future.WaitForCompletionRef(ctx, c.internal.Client)
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
-		return err
+		// Not found is a successful delete
+		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
+			return false, err
+		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	return nil
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	c.SetStatus(local, remote)
+	if err != nil && !found {
+		return false, nil
+	}
+	return found, err
 }
 
 // SetStatus sets the status subresource fields of the CRD reflecting the state of the object in Azure.
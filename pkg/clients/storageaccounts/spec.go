@@ -49,6 +49,7 @@ func (s *Spec) ForCreate() storage.AccountCreateParameters {
 		result.Sku = s.internal.Sku
 	}
 	result.Location = s.Location()
+	result.Tags = s.internal.Tags
 	return result
 }
 
@@ -62,6 +63,7 @@ func (s *Spec) ForUpdate() storage.AccountUpdateParameters {
 	if s.internal.Sku != nil {
 		result.Sku = s.internal.Sku
 	}
+	result.Tags = s.internal.Tags
 	return result
 }
 
@@ -71,7 +73,13 @@ func Location(location *string) func(*Spec) {
 	}
 }
 
-func (s *Spec) NeedsUpdate(local *azurev1alpha1.PublicIP) bool {
+func Tags(tags map[string]*string) func(*Spec) {
+	return func(s *Spec) {
+		s.internal.Tags = tags
+	}
+}
+
+func (s *Spec) NeedsUpdate(local *azurev1alpha1.StorageAccount) bool {
 	// lol
 	return clientutil.Any([]func() bool{
 		func() bool { return !cmp.Equal(s.Location(), &local.Spec.Location) },
@@ -11,7 +11,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
 	"github.com/Azure/go-autorest/autorest/to"
-	"github.com/davecgh/go-spew/spew"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -20,13 +19,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
 	config     *config.Config
 	factory    factoryFunc
-	internal   storage.AccountsClient
+	cache      *clientutil.ClientCache
 	kubeclient *ctrl.Client
 	scheme     *runtime.Scheme
 }
@@ -35,7 +37,9 @@ type factoryFunc func(subscriptionID string) storage.AccountsClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) *Client {
-	return NewWithFactory(configuration, kubeclient, storage.NewAccountsClient, scheme)
+	return NewWithFactory(configuration, kubeclient, func(subscriptionID string) storage.AccountsClient {
+		return storage.NewAccountsClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	}, scheme)
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -43,6 +47,7 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, factory factoryFunc, scheme *runtime.Scheme) *Client {
 	return &Client{
+		cache:      clientutil.NewClientCache(),
 		config:     configuration,
 		factory:    factory,
 		kubeclient: kubeclient,
@@ -50,65 +55,101 @@ func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, facto
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
-func (c *Client) ForSubscription(obj runtime.Object) error {
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
+func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
 }
 
-// Ensure creates or updates a SQL server in an idempotent manner and sets its provisioning state.
-func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (storage.AccountsClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "StorageAccount")
+		tracing.Instrument(&client.Client, "StorageAccount")
+		c.config.WireLog(&client.Client, "StorageAccount")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return storage.AccountsClient{}, err
+	}
+	return client.(storage.AccountsClient), nil
+}
+
+// Ensure creates or updates a storage account in an idempotent manner and sets its provisioning state.
+// Storage accounts are created asynchronously, so it reports the account done only once it is provisioned and its keys are synced.
+func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	local, err := c.convert(obj)
 	if err != nil {
-		return err
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
 	}
 
 	// Set status
-	remote, err := c.internal.GetProperties(ctx, local.Spec.ResourceGroup, local.Spec.Name, "")
+	remote, err := internal.GetProperties(ctx, local.Spec.ResourceGroup, local.Spec.Name, "")
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && found {
-		return err
+		return false, err
 	}
 
-	// Wrap, check status, and exit early if appropriate
-	var spec *Spec
-	if found {
-		spec = NewSpecWithRemote(&remote)
-		if err := c.SyncSecret(ctx, local); err != nil {
-			return err
+	if !found {
+		spec := NewSpec()
+		spec.Set(
+			Location(&local.Spec.Location),
+			Tags(clientutil.OwnerTags(c.config.ClusterID(), local, nil)),
+		)
+		future, err := internal.Create(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.ForCreate())
+		if err != nil {
+			return false, err
 		}
-		// TODO(ace): this should be an extension point to gracefully handle immutable updates
-		// if c.Done(local)
-		// if !spec.NeedsUpdate(local) {
-		// 	return nil
-		// }
-	} else {
-		spec = NewSpec()
+		return false, clientutil.RecordOperation(local, future.Future)
+	}
+
+	if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+		return false, err
+	}
+	if !c.Done(local) {
+		return false, nil
+	}
+	if err := c.SyncSecret(ctx, local); err != nil {
+		return false, err
 	}
 
-	// Overlay new properties over old/default spec
+	// Wrap, check status, and exit early if appropriate
+	spec := NewSpecWithRemote(&remote)
+	// TODO(ace): this should be an extension point to gracefully handle immutable updates
+	if !spec.NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
+		return true, nil
+	}
+
+	// Overlay new properties over old spec
 	spec.Set(
 		Location(&local.Spec.Location),
+		Tags(clientutil.OwnerTags(c.config.ClusterID(), local, spec.internal.Tags)),
 	)
-
-	if !found {
-		if _, err = c.internal.Create(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.ForCreate()); err != nil {
-			return err
-		}
-		return nil
-	}
-	_, err = c.internal.Update(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.ForUpdate())
-	return err
+	_, err = internal.Update(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.ForUpdate())
+	return false, err
 }
 
 // ListKeys returns a virtual network.
 func (c *Client) ListKeys(ctx context.Context, local *azurev1alpha1.StorageAccount) (map[string][]byte, error) {
-	keys, err := c.internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +166,6 @@ func (c *Client) SyncSecret(ctx context.Context, local *azurev1alpha1.StorageAcc
 
 	keys, err := c.ListKeys(ctx, local)
 	if err != nil {
-		spew.Dump("err listing keys")
 		return err
 	}
 
@@ -154,27 +194,65 @@ func (c *Client) SyncSecret(ctx context.Context, local *azurev1alpha1.StorageAcc
 	return err
 }
 
-// Delete handles deletion of a SQL server.
-func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
+// Observe refreshes the status of the storage account from Azure without mutating anything.
+// It reports whether the account no longer matches the spec, or the target secret no longer holds its key.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
 	local, err := c.convert(obj)
 	if err != nil {
-		return err
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.GetProperties(ctx, local.Spec.ResourceGroup, local.Spec.Name, "")
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	c.SetStatus(local, remote)
+	if !found || NewSpecWithRemote(&remote).NeedsUpdate(local) {
+		return true, nil
+	}
+	if local.Spec.TargetSecret == nil {
+		return false, nil
+	}
+	keys, err := c.ListKeys(ctx, local)
+	if err != nil {
+		return false, err
 	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, local.ObjectMeta.Namespace, *local.Spec.TargetSecret, keys)
+}
 
-	targetSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      local.Spec.Name,
-			Namespace: local.ObjectMeta.Namespace,
-		},
+// Delete handles deletion of a storage account and the target secret holding its key.
+// Storage accounts are deleted synchronously, so it reports the account as found only when deletion failed.
+func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
 	}
 
-	// Get SQL Server secret
-	if err = (*c.kubeclient).Delete(ctx, targetSecret); client.IgnoreNotFound(err) != nil {
-		return err
+	if local.Spec.TargetSecret != nil {
+		targetSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      *local.Spec.TargetSecret,
+				Namespace: local.ObjectMeta.Namespace,
+			},
+		}
+		if err = (*c.kubeclient).Delete(ctx, targetSecret); client.IgnoreNotFound(err) != nil {
+			return false, err
+		}
 	}
 
-	_, err = c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
-	return err
+	response, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	if err != nil && !response.IsHTTPStatus(http.StatusNotFound) {
+		return true, err
+	}
+	return false, nil
 }
 
 // SetStatus sets the status subresource fields of the CRD reflecting the state of the object in Azure.
@@ -9,7 +9,6 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
-	"github.com/davecgh/go-spew/spew"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -18,13 +17,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
 	config     *config.Config
 	factory    factoryFunc
-	internal   storage.AccountsClient
+	cache      *clientutil.ClientCache
 	kubeclient *ctrl.Client
 	scheme     *runtime.Scheme
 }
@@ -33,7 +35,9 @@ type factoryFunc func(subscriptionID string) storage.AccountsClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) *Client {
-	return NewWithFactory(configuration, kubeclient, storage.NewAccountsClient, scheme)
+	return NewWithFactory(configuration, kubeclient, func(subscriptionID string) storage.AccountsClient {
+		return storage.NewAccountsClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	}, scheme)
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -41,6 +45,7 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, factory factoryFunc, scheme *runtime.Scheme) *Client {
 	return &Client{
+		cache:      clientutil.NewClientCache(),
 		config:     configuration,
 		factory:    factory,
 		kubeclient: kubeclient,
@@ -48,19 +53,39 @@ func NewWithFactory(configuration *config.Config, kubeclient *ctrl.Client, facto
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (storage.AccountsClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "StorageKey")
+		tracing.Instrument(&client.Client, "StorageKey")
+		c.config.WireLog(&client.Client, "StorageKey")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return storage.AccountsClient{}, err
+	}
+	return client.(storage.AccountsClient), nil
 }
 
 // ListKeys returns a virtual network.
 func (c *Client) ListKeys(ctx context.Context, local *azurev1alpha1.StorageKey) (map[string][]byte, error) {
-	keys, err := c.internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := internal.ListKeys(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +95,7 @@ func (c *Client) ListKeys(ctx context.Context, local *azurev1alpha1.StorageKey)
 		result[*local.Spec.PrimaryKey] = []byte(*(*keys.Keys)[0].Value)
 	}
 	if local.Spec.PrimaryConnectionString != nil {
-		connectionString := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=core.windows.net", local.Spec.Name, *(*keys.Keys)[0].Value)
+		connectionString := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", local.Spec.Name, *(*keys.Keys)[0].Value, c.config.Cloud().StorageEndpointSuffix)
 		result[*local.Spec.PrimaryConnectionString] = []byte(connectionString)
 	}
 	return result, nil
@@ -88,7 +113,6 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 
 	keys, err := c.ListKeys(ctx, local)
 	if err != nil {
-		spew.Dump("err listing keys")
 		return err
 	}
 
@@ -116,16 +140,36 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 	return err
 }
 
-// Delete handles deletion of a SQL server.
+// Observe reads the keys of the storage account without mutating anything.
+// It reports whether the target secret no longer holds the keys requested by the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	if local.Spec.TargetSecret == nil {
+		return false, nil
+	}
+	keys, err := c.ListKeys(ctx, local)
+	if err != nil {
+		return false, err
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, local.ObjectMeta.Namespace, *local.Spec.TargetSecret, keys)
+}
+
+// Delete removes the target secret holding the keys, leaving the storage account untouched.
 func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
+	if local.Spec.TargetSecret == nil {
+		return nil
+	}
 
 	targetSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      local.Spec.Name,
+			Name:      *local.Spec.TargetSecret,
 			Namespace: local.ObjectMeta.Namespace,
 		},
 	}
@@ -0,0 +1,17 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package storagekeys_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestStoragekeys(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "storagekeys")
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package storagekeys_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/storagekeys"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+// keysSender answers every request with the keys of a storage account.
+var keysSender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+	return &http.Response{
+		Request:    r,
+		Header:     http.Header{},
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`{"keys":[{"keyName":"key1","value":"secret","permissions":"FULL"}]}`)),
+	}, nil
+})
+
+var _ = Describe("storage keys", func() {
+
+	DescribeTable("should derive the connection string endpoint from the cloud",
+		func(env azure.Environment, connectionString string) {
+			configuration, err := config.New(config.Cloud(env), config.Authorizer(autorest.NullAuthorizer{}))
+			Expect(err).ToNot(HaveOccurred())
+			client := storagekeys.NewWithFactory(configuration, nil, func(subscriptionID string) storage.AccountsClient {
+				client := storage.NewAccountsClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+				client.Sender = keysSender
+				return client
+			}, nil)
+
+			keys, err := client.ListKeys(context.Background(), &azurev1alpha1.StorageKey{
+				Spec: azurev1alpha1.StorageKeySpec{
+					Name:                    "account",
+					ResourceGroup:           "group",
+					SubscriptionID:          "00000000-0000-0000-0000-000000000000",
+					PrimaryConnectionString: to.StringPtr("connection"),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(keys["connection"])).To(Equal(connectionString))
+		},
+		Entry("public", azure.PublicCloud, "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=secret;EndpointSuffix=core.windows.net"),
+		Entry("US government", azure.USGovernmentCloud, "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=secret;EndpointSuffix=core.usgovcloudapi.net"),
+		Entry("China", azure.ChinaCloud, "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=secret;EndpointSuffix=core.chinacloudapi.cn"),
+		Entry("German", azure.GermanCloud, "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=secret;EndpointSuffix=core.cloudapi.de"),
+	)
+})
@@ -14,7 +14,6 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -26,13 +25,17 @@ import (
 	pkcs12 "software.sslmate.com/src/go-pkcs12"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	internal   keyvault.BaseClient
-	kubeclient *ctrl.Client
-	scheme     *runtime.Scheme
+	internal      keyvault.BaseClient
+	configuration *config.Config
+	kubeclient    *ctrl.Client
+	scheme        *runtime.Scheme
 }
 
 func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.Scheme) (*Client, error) {
@@ -42,10 +45,13 @@ func New(configuration *config.Config, kubeclient *ctrl.Client, scheme *runtime.
 	kvclient := keyvault.New()
 	authorizer, err := configuration.GetKeyvaultAuthorizer()
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	kvclient.Authorizer = authorizer
-	return &Client{internal: kvclient, kubeclient: kubeclient, scheme: scheme}, nil
+	metrics.Instrument(&kvclient.Client, "TLSSecret")
+	tracing.Instrument(&kvclient.Client, "TLSSecret")
+	configuration.WireLog(&kvclient.Client, "TLSSecret")
+	return &Client{internal: kvclient, configuration: configuration, kubeclient: kubeclient, scheme: scheme}, nil
 }
 
 // ForSubscription authorizes the client for a given subscription
@@ -60,31 +66,82 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (keyvault.SecretBu
 	if err != nil {
 		return keyvault.SecretBundle{}, err
 	}
-	vault := fmt.Sprintf("https://%s.%s", secret.Spec.Vault, azure.PublicCloud.KeyVaultDNSSuffix)
+	vault := c.configuration.VaultURL(secret.Spec.Vault)
 	return c.internal.GetSecret(ctx, vault, secret.Spec.Name, "")
 }
 
 // Ensure takes a spec corresponding to one Azure KV secret. It syncs that secret into Kubernetes, remapping the name if necessary.
 func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
-	// TODO(ace): cloud-sensitive
 	secret, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	vault := fmt.Sprintf("https://%s.%s", secret.Spec.Vault, azure.PublicCloud.KeyVaultDNSSuffix)
-	bundle, err := c.internal.GetSecret(ctx, vault, secret.Spec.Name, "")
+	data, err := c.data(ctx, secret)
 	if err != nil {
 		return err
 	}
 
+	local := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secret.ObjectMeta.Name,
+			Namespace: secret.ObjectMeta.Namespace,
+		},
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, *c.kubeclient, local, func() error {
+		if secret.ObjectMeta.UID != "" {
+			innerErr := controllerutil.SetControllerReference(secret, local, c.scheme)
+			if innerErr != nil {
+				return innerErr
+			}
+		}
+		if local.Data == nil {
+			local.Data = map[string][]byte{}
+		}
+		for key, value := range data {
+			local.Data[key] = value
+		}
+		local.Type = corev1.SecretTypeTLS
+		return nil
+	})
+
+	secret.Status.State = nil
+	if err == nil {
+		secret.Status.State = to.StringPtr("Succeeded")
+	}
+	return err
+}
+
+// Observe reads the certificate from Keyvault without mutating anything.
+// It reports whether the Kubernetes secret no longer holds the certificate chain and key built from it.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	secret, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	data, err := c.data(ctx, secret)
+	if err != nil {
+		return false, err
+	}
+	return clientutil.SecretOutdated(ctx, *c.kubeclient, secret.ObjectMeta.Namespace, secret.ObjectMeta.Name, data)
+}
+
+// data reads the certificate from Keyvault and returns the PEM encoded chain and key of the Kubernetes secret.
+func (c *Client) data(ctx context.Context, secret *azurev1alpha1.TLSSecret) (map[string][]byte, error) {
+	vault := c.configuration.VaultURL(secret.Spec.Vault)
+	bundle, err := c.internal.GetSecret(ctx, vault, secret.Spec.Name, "")
+	if err != nil {
+		return nil, err
+	}
+
 	p12, err := base64.StdEncoding.DecodeString(*bundle.Value)
 	if err != nil {
-		return errors.Wrapf(err, "err decoding base64 to p12")
+		return nil, errors.Wrapf(err, "err decoding base64 to p12")
 	}
 
 	pfxKey, pfxCert, caCerts, err := pkcs12.DecodeChain(p12, "")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if secret.Spec.Reverse {
@@ -120,40 +177,16 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) error {
 
 	var keyPEM bytes.Buffer
 	if err := pem.Encode(&keyPEM, keyBlock); err != nil {
-		return err
-	}
-
-	local := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secret.ObjectMeta.Name,
-			Namespace: secret.ObjectMeta.Namespace,
-		},
+		return nil, err
 	}
 
-	_, err = controllerutil.CreateOrUpdate(ctx, *c.kubeclient, local, func() error {
-		if secret.ObjectMeta.UID != "" {
-			innerErr := controllerutil.SetControllerReference(secret, local, c.scheme)
-			if innerErr != nil {
-				return innerErr
-			}
-		}
-		if local.Data == nil {
-			local.Data = map[string][]byte{}
-		}
-		local.Data["tls.crt"] = []byte(output)
-		local.Data["tls.key"] = keyPEM.Bytes()
-		local.Type = corev1.SecretTypeTLS
-		return nil
-	})
-
-	secret.Status.State = nil
-	if err == nil {
-		secret.Status.State = to.StringPtr("Succeeded")
-	}
-	return err
+	return map[string][]byte{
+		"tls.crt": []byte(output),
+		"tls.key": keyPEM.Bytes(),
+	}, nil
 }
 
-// Delete deletes a secret from Keyvault.
+// Delete removes the Kubernetes secret written by Ensure, leaving Keyvault untouched.
 func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	secret, err := c.convert(obj)
 	if err != nil {
@@ -161,7 +194,7 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) error {
 	}
 	local := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      secret.Spec.Name,
+			Name:      secret.ObjectMeta.Name,
 			Namespace: secret.ObjectMeta.Namespace,
 		},
 	}
@@ -0,0 +1,17 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package tlssecrets
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTlssecrets(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "tlssecrets")
+}
@@ -66,7 +66,10 @@ func (s *Spec) NeedsUpdate(local *azurev1alpha1.TrafficManager) bool {
 		func() bool {
 			return Status(s) != nil && *Status(s) != trafficmanager.ProfileStatus(local.Spec.ProfileStatus)
 		},
-		func() bool { return Name(s) == nil || local.Spec.Name != *Name(s) },
+		func() bool {
+			return s.internal.ProfileProperties != nil &&
+				s.internal.ProfileProperties.TrafficRoutingMethod != trafficmanager.TrafficRoutingMethod(local.Spec.TrafficRoutingMethod)
+		},
 	})
 }
 
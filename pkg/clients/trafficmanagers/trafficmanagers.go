@@ -15,20 +15,25 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	factory  factoryFunc
-	internal trafficmanager.ProfilesClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) trafficmanager.ProfilesClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, trafficmanager.NewProfilesClient)
+	return NewWithFactory(configuration, func(subscriptionID string) trafficmanager.ProfilesClient {
+		return trafficmanager.NewProfilesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -36,20 +41,65 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
-func (c *Client) ForSubscription(subID string) error {
-	c.internal = c.factory(subID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
+func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
+	local, err := c.convert(obj)
+	if err != nil {
+		return err
+	}
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (trafficmanager.ProfilesClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "TrafficManager")
+		tracing.Instrument(&client.Client, "TrafficManager")
+		c.config.WireLog(&client.Client, "TrafficManager")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return trafficmanager.ProfilesClient{}, err
+	}
+	return client.(trafficmanager.ProfilesClient), nil
 }
 
-// Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
-func (c *Client) Ensure(ctx context.Context, local *azurev1alpha1.TrafficManager) (bool, error) {
+// Ensure creates or updates a traffic manager profile in an idempotent manner and sets its monitor status.
+func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+		if !NewSpecWithRemote(&remote).NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
+			c.setStatus(local, remote)
+			return c.Done(ctx, local), nil
+		}
+	}
+
 	spec := trafficmanager.Profile{
+		Tags: clientutil.OwnerTags(c.config.ClusterID(), local, remote.Tags),
 		ProfileProperties: &trafficmanager.ProfileProperties{
 			ProfileStatus:        trafficmanager.ProfileStatus(local.Spec.ProfileStatus),
 			TrafficRoutingMethod: trafficmanager.TrafficRoutingMethod(local.Spec.TrafficRoutingMethod),
@@ -66,7 +116,7 @@ func (c *Client) Ensure(ctx context.Context, local *azurev1alpha1.TrafficManager
 			Endpoints: &[]trafficmanager.Endpoint{},
 			DNSConfig: &trafficmanager.DNSConfig{
 				RelativeName: local.Spec.DNSConfig.RelativeName,
-				Fqdn:         to.StringPtr(fmt.Sprintf("%s.trafficmanager.net", *local.Spec.DNSConfig.RelativeName)),
+				Fqdn:         to.StringPtr(fmt.Sprintf("%s.%s", *local.Spec.DNSConfig.RelativeName, c.config.Cloud().TrafficManagerDNSSuffix)),
 				TTL:          local.Spec.DNSConfig.TTL,
 			},
 		},
@@ -123,7 +173,7 @@ func (c *Client) Ensure(ctx context.Context, local *azurev1alpha1.TrafficManager
 		}
 	}
 
-	if _, err := c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
+	if _, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
 		return false, err
 	}
 
@@ -134,44 +184,97 @@ func (c *Client) Ensure(ctx context.Context, local *azurev1alpha1.TrafficManager
 	return c.Done(ctx, local), nil
 }
 
-// Delete handles deletion of a virtual network.
-func (c *Client) Delete(ctx context.Context, local *azurev1alpha1.TrafficManager) error {
-	_, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
-	return err
+// Observe refreshes the status of the profile from Azure without mutating it.
+// It reports whether the remote profile no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	found := !remote.IsHTTPStatus(http.StatusNotFound)
+	if err != nil && found {
+		return false, err
+	}
+	c.setStatus(local, remote)
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
+}
+
+// Delete handles deletion of a traffic manager profile.
+// Profiles are deleted synchronously, so it reports the profile as found only when deletion failed.
+func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	response, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	if err != nil && !response.IsHTTPStatus(http.StatusNotFound) {
+		return true, err
+	}
+	return false, nil
 }
 
 // SetStatus sets the status subresource fields of the CRD reflecting the state of the object in Azure.
 func (c *Client) SetStatus(ctx context.Context, local *azurev1alpha1.TrafficManager) (bool, error) {
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	// Care about 400 and 5xx, not 404.
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	if err != nil && !remote.HasHTTPStatus(http.StatusNotFound, http.StatusConflict) {
 		return found, err
 	}
 
+	c.setStatus(local, remote)
+	return found, nil
+}
+
+func (c *Client) setStatus(local *azurev1alpha1.TrafficManager, remote trafficmanager.Profile) {
 	local.Status.ID = remote.ID
 	if remote.ProfileProperties != nil {
 		local.Status.FQDN = remote.ProfileProperties.DNSConfig.Fqdn
 		local.Status.ProfileMonitorStatus = string(remote.ProfileProperties.MonitorConfig.ProfileMonitorStatus)
 	}
-	return found, nil
 }
 
 // Done checks the current state of the CRD against the desired end state.
+// Inactive profiles have no enabled endpoints to monitor, so their status will not change either.
 func (c *Client) Done(ctx context.Context, local *azurev1alpha1.TrafficManager) bool {
 	// TODO(ace): make this check individual endpoints? what about ICMs?
-	return local.Status.ProfileMonitorStatus == "Online"
+	switch trafficmanager.ProfileMonitorStatus(local.Status.ProfileMonitorStatus) {
+	case trafficmanager.ProfileMonitorStatusOnline, trafficmanager.ProfileMonitorStatusDisabled, trafficmanager.ProfileMonitorStatusInactive:
+		return true
+	}
+	return false
 }
 
 // Get returns a virtual network.
 func (c *Client) Get(ctx context.Context, local *azurev1alpha1.TrafficManager) (trafficmanager.Profile, error) {
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return trafficmanager.Profile{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 }
 
 // remove?
 // GetProfileStatus returns the status of an entire Azure TM.
 func (c *Client) GetProfileStatus(ctx context.Context, local *azurev1alpha1.TrafficManager) (string, error) {
-	res, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return "", err
+	}
+	res, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		return "", err
 	}
@@ -181,7 +284,11 @@ func (c *Client) GetProfileStatus(ctx context.Context, local *azurev1alpha1.Traf
 // remove?
 // GetEndpointStatus returns the status of one endpoint within an Azure Traffic Manager.
 func (c *Client) GetEndpointStatus(ctx context.Context, local *azurev1alpha1.TrafficManager, name string) (string, error) {
-	profile, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return "", err
+	}
+	profile, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,84 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package trafficmanagers_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/trafficmanagers"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+// profileSender stores the profile written by PUT and returns it to GET, like Azure Resource Manager does.
+func profileSender(profile *[]byte) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		resp := &http.Response{Request: r, Header: http.Header{}, StatusCode: http.StatusOK}
+		switch {
+		case r.Method == http.MethodPut:
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+			*profile = body
+		case *profile == nil:
+			resp.StatusCode = http.StatusNotFound
+			resp.Body = ioutil.NopCloser(bytes.NewBufferString(`{"error":{"code":"ResourceNotFound"}}`))
+			return resp, nil
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(*profile))
+		return resp, nil
+	})
+}
+
+var _ = Describe("traffic managers", func() {
+
+	DescribeTable("should derive the FQDN from the cloud",
+		func(env azure.Environment, fqdn string) {
+			configuration, err := config.New(config.Cloud(env), config.Authorizer(autorest.NullAuthorizer{}))
+			Expect(err).ToNot(HaveOccurred())
+			var profile []byte
+			client := trafficmanagers.NewWithFactory(configuration, func(subscriptionID string) trafficmanager.ProfilesClient {
+				client := trafficmanager.NewProfilesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+				client.Sender = profileSender(&profile)
+				return client
+			})
+
+			local := &azurev1alpha1.TrafficManager{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default"},
+				Spec: azurev1alpha1.TrafficManagerSpec{
+					Name:                 "profile",
+					SubscriptionID:       "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:        "group",
+					ProfileStatus:        "Enabled",
+					TrafficRoutingMethod: "Weighted",
+					DNSConfig:            azurev1alpha1.DNSConfig{RelativeName: to.StringPtr("profile")},
+				},
+			}
+			_, err = client.Ensure(context.Background(), local)
+			Expect(err).ToNot(HaveOccurred())
+
+			remote, err := client.Get(context.Background(), local)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(remote.DNSConfig.Fqdn).To(Equal(to.StringPtr(fqdn)))
+		},
+		Entry("public", azure.PublicCloud, "profile.trafficmanager.net"),
+		Entry("US government", azure.USGovernmentCloud, "profile.usgovtrafficmanager.net"),
+		Entry("China", azure.ChinaCloud, "profile.trafficmanager.cn"),
+		Entry("German", azure.GermanCloud, "profile.azuretrafficmanager.de"),
+	)
+})
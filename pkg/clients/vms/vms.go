@@ -12,28 +12,32 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
-	"github.com/davecgh/go-spew/spew"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/disks"
 	"github.com/alexeldeib/incendiary-iguana/pkg/clients/zones"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	factory  factoryFunc
-	internal compute.VirtualMachinesClient
-	config   *config.Config
-	disks    *disks.Client
-	zones    *zones.Client
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
+	disks   *disks.Client
+	zones   *zones.Client
 }
 
 type factoryFunc func(subscriptionID string) compute.VirtualMachinesClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, compute.NewVirtualMachinesClient)
+	return NewWithFactory(configuration, func(subscriptionID string) compute.VirtualMachinesClient {
+		return compute.NewVirtualMachinesClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -41,6 +45,7 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 		disks:   disks.New(configuration),
@@ -48,14 +53,30 @@ func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription of the object and caches it for later calls.
 func (c *Client) ForSubscription(ctx context.Context, obj runtime.Object) error {
 	local, err := c.convert(obj)
 	if err != nil {
 		return err
 	}
-	c.internal = c.factory(local.Spec.SubscriptionID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err = c.forSubscription(local.Spec.SubscriptionID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (compute.VirtualMachinesClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "VM")
+		tracing.Instrument(&client.Client, "VM")
+		c.config.WireLog(&client.Client, "VM")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return compute.VirtualMachinesClient{}, err
+	}
+	return client.(compute.VirtualMachinesClient), nil
 }
 
 // Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
@@ -64,22 +85,33 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, compute.InstanceView)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodPut); pending || err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, compute.InstanceView)
 	found := !remote.HasHTTPStatus(http.StatusNotFound, http.StatusConflict)
 	c.SetStatus(local, remote)
 	if err != nil && found {
 		return false, err
 	}
+	if found {
+		if err := clientutil.CheckOwnership(c.config.ClusterID(), local, remote.Tags); err != nil {
+			return false, err
+		}
+	}
 
 	var spec *Spec
 	if found {
 		spec = NewSpecWithRemote(&remote)
 		if c.Done(ctx, local) {
-			if !spec.NeedsUpdate(local) {
+			if !spec.NeedsUpdate(local) && clientutil.OwnerTagged(c.config.ClusterID(), local, remote.Tags) {
 				return true, nil
 			}
 		} else {
-			spew.Dump("not done")
 			return false, nil
 		}
 	} else {
@@ -108,8 +140,33 @@ func (c *Client) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
 		zoneFn,
 	)
 
-	_, err = c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec.Build())
-	return false, err
+	vm := spec.Build()
+	vm.Tags = clientutil.OwnerTags(c.config.ClusterID(), local, vm.Tags)
+	future, err := internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, vm)
+	if err != nil {
+		return false, err
+	}
+	return false, clientutil.RecordOperation(local, future.Future)
+}
+
+// Observe refreshes the status of the virtual machine from Azure without mutating it.
+// It reports whether the remote resource no longer matches the spec.
+func (c *Client) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	local, err := c.convert(obj)
+	if err != nil {
+		return false, err
+	}
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, compute.InstanceView)
+	found := !remote.HasHTTPStatus(http.StatusNotFound, http.StatusConflict)
+	c.SetStatus(local, remote)
+	if err != nil && found {
+		return false, err
+	}
+	return !found || NewSpecWithRemote(&remote).NeedsUpdate(local), nil
 }
 
 // Get returns a virtual network.
@@ -118,7 +175,11 @@ func (c *Client) Get(ctx context.Context, obj runtime.Object) (compute.VirtualMa
 	if err != nil {
 		return compute.VirtualMachine{}, err
 	}
-	return c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, compute.InstanceView)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return compute.VirtualMachine{}, err
+	}
+	return internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, compute.InstanceView)
 }
 
 // Delete handles deletion of a virtual network.
@@ -127,14 +188,23 @@ func (c *Client) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	future, err := c.internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if pending, err := clientutil.ResumeOperation(ctx, internal.Client, local, http.MethodDelete); pending || err != nil {
+		return true, err
+	}
+	future, err := internal.Delete(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 	if err != nil {
 		// Not found is a successful delete
 		if resp := future.Response(); resp != nil && resp.StatusCode != http.StatusNotFound {
 			return false, err
 		}
+	} else if err := clientutil.RecordOperation(local, future.Future); err != nil {
+		return false, err
 	}
-	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, compute.InstanceView)
+	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name, compute.InstanceView)
 	found := !remote.IsHTTPStatus(http.StatusNotFound)
 	c.SetStatus(local, remote)
 	if err != nil && remote.IsHTTPStatus(http.StatusNotFound) {
@@ -169,11 +239,9 @@ func (c *Client) InProgress(ctx context.Context, local *azurev1alpha1.VM) bool {
 
 func (c *Client) NeedsUpdate(local *azurev1alpha1.VM, remote compute.VirtualMachine) bool {
 	if !strings.EqualFold(string(local.Spec.SKU), string(remote.VirtualMachineProperties.HardwareProfile.VMSize)) {
-		spew.Dump("changed sku name")
 		return true
 	}
 	if !strings.EqualFold(*remote.Location, local.Spec.Location) {
-		spew.Dump("changed Location")
 		return true
 	}
 	return false
@@ -15,20 +15,25 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/clients/clientutil"
 	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
 )
 
 type Client struct {
-	factory  factoryFunc
-	internal compute.ResourceSkusClient
-	config   *config.Config
+	factory factoryFunc
+	cache   *clientutil.ClientCache
+	config  *config.Config
 }
 
 type factoryFunc func(subscriptionID string) compute.ResourceSkusClient
 
 // New returns a new client able to authenticate to multiple Azure subscriptions using the provided configuration.
 func New(configuration *config.Config) *Client {
-	return NewWithFactory(configuration, compute.NewResourceSkusClient)
+	return NewWithFactory(configuration, func(subscriptionID string) compute.ResourceSkusClient {
+		return compute.NewResourceSkusClientWithBaseURI(configuration.ResourceManagerEndpoint(), subscriptionID)
+	})
 }
 
 // NewWithFactory returns an interface which can authorize the configured client to many subscriptions.
@@ -36,21 +41,42 @@ func New(configuration *config.Config) *Client {
 // This can be used to stub Azure client for testing.
 func NewWithFactory(configuration *config.Config, factory factoryFunc) *Client {
 	return &Client{
+		cache:   clientutil.NewClientCache(),
 		config:  configuration,
 		factory: factory,
 	}
 }
 
-// ForSubscription authorizes the client for a given subscription
+// ForSubscription authorizes a client for the subscription and caches it for later calls.
 func (c *Client) ForSubscription(subID string) error {
-	c.internal = c.factory(subID)
-	return c.config.AuthorizeClientFromArgs(&c.internal.Client)
+	_, err := c.forSubscription(subID)
+	return err
+}
+
+// forSubscription returns a client authorized for the subscription, creating and caching it on first use.
+func (c *Client) forSubscription(subscriptionID string) (compute.ResourceSkusClient, error) {
+	client, err := c.cache.Get(subscriptionID, func() (interface{}, error) {
+		client := c.factory(subscriptionID)
+		metrics.Instrument(&client.Client, "VM")
+		tracing.Instrument(&client.Client, "VM")
+		c.config.WireLog(&client.Client, "VM")
+		c.config.RateLimit(&client.Client, subscriptionID)
+		return client, c.config.AuthorizeClientFromArgs(&client.Client)
+	})
+	if err != nil {
+		return compute.ResourceSkusClient{}, err
+	}
+	return client.(compute.ResourceSkusClient), nil
 }
 
 // Get returns a resource group.
 func (c *Client) Get(ctx context.Context, local *azurev1alpha1.VM) ([]string, error) {
+	internal, err := c.forSubscription(local.Spec.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
 	var zones []string
-	res, err := c.internal.ListComplete(ctx)
+	res, err := internal.ListComplete(ctx)
 	if err != nil {
 		return zones, err
 	}
@@ -97,7 +123,7 @@ func (c *Client) Get(ctx context.Context, local *azurev1alpha1.VM) ([]string, er
 
 // // Ensure creates or updates a virtual network in an idempotent manner and sets its provisioning state.
 // func (c *Client) Ensure(ctx context.Context, local *azurev1alpha1.VM) (bool, error) {
-// 	remote, err := c.internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
+// 	remote, err := internal.Get(ctx, local.Spec.ResourceGroup, local.Spec.Name)
 // 	found := !remote.HasHTTPStatus(http.StatusNotFound, http.StatusConflict)
 // 	c.SetStatus(local, remote)
 // 	if err != nil && found {
@@ -115,7 +141,7 @@ func (c *Client) Get(ctx context.Context, local *azurev1alpha1.VM) ([]string, er
 
 // 	// Name:         to.StringPtr(fmt.Sprintf("%s_%s_%s_osdisk", local.Spec.SubscriptionID, local.Spec.ResourceGroup, local.Spec.Name)),
 
-// 	if _, err = c.internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
+// 	if _, err = internal.CreateOrUpdate(ctx, local.Spec.ResourceGroup, local.Spec.Name, spec); err != nil {
 // 		spew.Dump(err)
 // 		return false, err
 // 	}
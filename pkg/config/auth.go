@@ -0,0 +1,322 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package config
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// AuthMethod selects how a Config obtains tokens for Azure.
+type AuthMethod string
+
+const (
+	// AuthClientSecret authenticates a service principal with its client secret.
+	AuthClientSecret AuthMethod = "client-secret"
+	// AuthClientCertificate authenticates a service principal with a PKCS#12 client certificate.
+	AuthClientCertificate AuthMethod = "client-certificate"
+	// AuthManagedIdentity fetches tokens for the managed identity of the host from the instance metadata service.
+	AuthManagedIdentity AuthMethod = "managed-identity"
+	// AuthWorkloadIdentity exchanges a federated token read from a file, e.g. a projected service account token,
+	// for a token of the app it is federated with.
+	AuthWorkloadIdentity AuthMethod = "workload-identity"
+	// AuthFile authenticates with the service principal described by an SDK auth file.
+	AuthFile AuthMethod = "auth-file"
+)
+
+// AuthMethods lists every supported authentication method.
+var AuthMethods = []AuthMethod{AuthClientSecret, AuthClientCertificate, AuthManagedIdentity, AuthWorkloadIdentity, AuthFile}
+
+// ParseAuthMethod validates an authentication method provided by a user. The empty string infers the method from the other settings.
+func ParseAuthMethod(method string) (AuthMethod, error) {
+	if method == "" {
+		return "", nil
+	}
+	for _, known := range AuthMethods {
+		if AuthMethod(method) == known {
+			return known, nil
+		}
+	}
+	return "", fmt.Errorf("unknown auth method %q, must be one of %v", method, AuthMethods)
+}
+
+// clientAssertionType is the OAuth client assertion type of federated tokens.
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// federatedTokenEnvVar names the file holding the federated token, as set by the Azure workload identity webhook.
+const federatedTokenEnvVar = "AZURE_FEDERATED_TOKEN_FILE"
+
+// Method selects the authentication method explicitly, overriding the method implied by other options.
+func Method(method AuthMethod) Option {
+	return func(c *Config) {
+		c.method = method
+	}
+}
+
+// Certificate authenticates the app with a PKCS#12 client certificate instead of a key.
+func Certificate(data []byte, password string) Option {
+	return func(c *Config) {
+		c.method = AuthClientCertificate
+		c.certificate = data
+		c.certificatePassword = password
+	}
+}
+
+// CertificateFile authenticates the app with the PKCS#12 client certificate stored at path instead of a key.
+func CertificateFile(path, password string) Option {
+	return func(c *Config) {
+		c.method = AuthClientCertificate
+		c.certificatePath = path
+		c.certificatePassword = password
+	}
+}
+
+// ManagedIdentity authenticates as the managed identity of the host.
+// The client ID selects a user assigned identity, and may be empty for the system assigned identity.
+func ManagedIdentity(clientID string) Option {
+	return func(c *Config) {
+		c.method = AuthManagedIdentity
+		c.identityClientID = clientID
+	}
+}
+
+// FederatedTokenFile authenticates the app by exchanging the federated token stored at path, which is read again on every refresh.
+func FederatedTokenFile(path string) Option {
+	return func(c *Config) {
+		c.method = AuthWorkloadIdentity
+		c.tokenFile = path
+	}
+}
+
+// AuthFileLocation authenticates with the service principal described by the SDK auth file at path.
+func AuthFileLocation(path string) Option {
+	return func(c *Config) {
+		c.method = AuthFile
+		c.authFile = path
+	}
+}
+
+// Authorizer authorizes every client with authorizer instead of obtaining tokens, e.g. for an emulator of Azure in tests.
+func Authorizer(authorizer autorest.Authorizer) Option {
+	return func(c *Config) {
+		c.staticAuthorizer = authorizer
+	}
+}
+
+// Environment reads credentials from the variables used by the Azure SDKs, e.g. AZURE_CLIENT_ID and AZURE_CLIENT_SECRET.
+// It does not select a method, so the method is inferred from the variables which are set unless chosen with Method.
+func Environment() Option {
+	return func(c *Config) {
+		set := func(value *string, name string) {
+			if env := os.Getenv(name); env != "" {
+				*value = env
+			}
+		}
+		set(&c.app, auth.ClientID)
+		set(&c.tenant, auth.TenantID)
+		set(&c.key, auth.ClientSecret)
+		set(&c.certificatePath, auth.CertificatePath)
+		set(&c.certificatePassword, auth.CertificatePassword)
+		set(&c.tokenFile, federatedTokenEnvVar)
+		set(&c.authFile, "AZURE_AUTH_LOCATION")
+	}
+}
+
+// AuthMethod returns the configured authentication method, or the method implied by the configured credentials.
+func (c *Config) AuthMethod() AuthMethod {
+	switch {
+	case c.method != "":
+		return c.method
+	case c.key != "":
+		return AuthClientSecret
+	case len(c.certificate) > 0 || c.certificatePath != "":
+		return AuthClientCertificate
+	case c.tokenFile != "":
+		return AuthWorkloadIdentity
+	case c.authFile != "":
+		return AuthFile
+	}
+	return AuthClientSecret
+}
+
+func (c *Config) validateArgs() error {
+	switch method := c.AuthMethod(); method {
+	case AuthClientSecret:
+		if c.app == "" || c.tenant == "" || c.key == "" {
+			return errors.New("app, tenant, and key must all be provided as options for authenticating with a client secret")
+		}
+	case AuthClientCertificate:
+		if c.app == "" || c.tenant == "" || (len(c.certificate) == 0 && c.certificatePath == "") {
+			return errors.New("app, tenant, and certificate must all be provided as options for authenticating with a client certificate")
+		}
+	case AuthWorkloadIdentity:
+		if c.app == "" || c.tenant == "" || c.tokenFile == "" {
+			return errors.New("app, tenant, and federated token file must all be provided as options for authenticating with workload identity")
+		}
+	case AuthFile:
+		if c.authFile == "" {
+			return errors.New("auth file location must be provided as an option for authenticating with an auth file")
+		}
+	case AuthManagedIdentity:
+	default:
+		return fmt.Errorf("unknown auth method %q", method)
+	}
+	return nil
+}
+
+// authorizer builds an authorizer for resource with the configured authentication method.
+func (c *Config) authorizer(resource string) (autorest.Authorizer, error) {
+	var token *adal.ServicePrincipalToken
+	var err error
+	switch c.AuthMethod() {
+	case AuthClientCertificate:
+		token, err = c.certificateToken(c.Cloud().ActiveDirectoryEndpoint, resource)
+	case AuthManagedIdentity:
+		token, err = c.managedIdentityToken(resource)
+	case AuthWorkloadIdentity:
+		token, err = c.federatedToken(resource)
+	case AuthFile:
+		token, err = c.authFileToken(resource)
+	default:
+		token, err = c.secretToken(c.Cloud().ActiveDirectoryEndpoint, resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return autorest.NewBearerAuthorizer(token), nil
+}
+
+func (c *Config) secretToken(activeDirectoryEndpoint, resource string) (*adal.ServicePrincipalToken, error) {
+	oauthConfig, err := adal.NewOAuthConfig(activeDirectoryEndpoint, c.tenant)
+	if err != nil {
+		return nil, err
+	}
+	return adal.NewServicePrincipalToken(*oauthConfig, c.app, c.key, resource)
+}
+
+func (c *Config) certificateToken(activeDirectoryEndpoint, resource string) (*adal.ServicePrincipalToken, error) {
+	oauthConfig, err := adal.NewOAuthConfig(activeDirectoryEndpoint, c.tenant)
+	if err != nil {
+		return nil, err
+	}
+	data := c.certificate
+	if len(data) == 0 {
+		if data, err = ioutil.ReadFile(c.certificatePath); err != nil {
+			return nil, fmt.Errorf("failed to read client certificate: %v", err)
+		}
+	}
+	key, certificate, err := pkcs12.Decode(data, c.certificatePassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode client certificate: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("client certificate must hold an RSA private key")
+	}
+	return adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.app, certificate, rsaKey, resource)
+}
+
+func (c *Config) managedIdentityToken(resource string) (*adal.ServicePrincipalToken, error) {
+	endpoint, err := adal.GetMSIVMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	// Like the Azure SDKs, fall back to the app as the client ID of a user assigned identity, e.g. from AZURE_CLIENT_ID.
+	clientID := c.identityClientID
+	if clientID == "" {
+		clientID = c.app
+	}
+	if clientID != "" {
+		return adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(endpoint, resource, clientID)
+	}
+	return adal.NewServicePrincipalTokenFromMSI(endpoint, resource)
+}
+
+func (c *Config) federatedToken(resource string) (*adal.ServicePrincipalToken, error) {
+	oauthConfig, err := adal.NewOAuthConfig(c.Cloud().ActiveDirectoryEndpoint, c.tenant)
+	if err != nil {
+		return nil, err
+	}
+	return adal.NewServicePrincipalTokenWithSecret(*oauthConfig, c.app, resource, &federatedTokenSecret{path: c.tokenFile})
+}
+
+// federatedTokenSecret authenticates token requests with a client assertion read from a file.
+// The file is read on every refresh, since the kubelet rotates projected service account tokens.
+type federatedTokenSecret struct {
+	path string
+}
+
+// SetAuthenticationValues implements adal.ServicePrincipalSecret.
+func (s *federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, values *url.Values) error {
+	assertion, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read federated token: %v", err)
+	}
+	values.Set("client_assertion_type", clientAssertionType)
+	values.Set("client_assertion", strings.TrimSpace(string(assertion)))
+	return nil
+}
+
+// authFileSettings holds the fields of an SDK auth file used for authentication.
+type authFileSettings struct {
+	ClientID                  string `json:"clientId"`
+	ClientSecret              string `json:"clientSecret"`
+	ClientCertificate         string `json:"clientCertificate"`
+	ClientCertificatePassword string `json:"clientCertificatePassword"`
+	TenantID                  string `json:"tenantId"`
+	ActiveDirectoryEndpoint   string `json:"activeDirectoryEndpointUrl"`
+}
+
+// readAuthFile parses the SDK auth file of the configuration.
+func (c *Config) readAuthFile() (authFileSettings, error) {
+	var settings authFileSettings
+	contents, err := ioutil.ReadFile(c.authFile)
+	if err != nil {
+		return settings, fmt.Errorf("failed to read auth file: %v", err)
+	}
+	// The Azure CLI may write the file with a byte order mark.
+	contents = bytes.TrimPrefix(contents, []byte("\xef\xbb\xbf"))
+	if err := json.Unmarshal(contents, &settings); err != nil {
+		return settings, fmt.Errorf("failed to parse auth file: %v", err)
+	}
+	return settings, nil
+}
+
+func (c *Config) authFileToken(resource string) (*adal.ServicePrincipalToken, error) {
+	settings, err := c.readAuthFile()
+	if err != nil {
+		return nil, err
+	}
+	endpoint := settings.ActiveDirectoryEndpoint
+	if endpoint == "" {
+		endpoint = c.Cloud().ActiveDirectoryEndpoint
+	}
+	credentials := &Config{
+		app:                 settings.ClientID,
+		key:                 settings.ClientSecret,
+		tenant:              settings.TenantID,
+		certificatePath:     settings.ClientCertificate,
+		certificatePassword: settings.ClientCertificatePassword,
+	}
+	if credentials.key != "" {
+		return credentials.secretToken(endpoint, resource)
+	}
+	if credentials.certificatePath != "" {
+		return credentials.certificateToken(endpoint, resource)
+	}
+	return nil, errors.New("auth file must contain a client secret or client certificate")
+}
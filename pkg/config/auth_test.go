@@ -0,0 +1,114 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+var _ = Describe("authentication methods", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "config")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	write := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		return path
+	}
+
+	newConfig := func(opts ...config.Option) *config.Config {
+		configuration, err := config.New(opts...)
+		Expect(err).ToNot(HaveOccurred())
+		return configuration
+	}
+
+	It("should parse known methods", func() {
+		for _, method := range config.AuthMethods {
+			parsed, err := config.ParseAuthMethod(string(method))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(method))
+		}
+		parsed, err := config.ParseAuthMethod("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(BeEmpty())
+		_, err = config.ParseAuthMethod("password")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should infer the method from the credentials", func() {
+		Expect(newConfig(config.Key("key")).AuthMethod()).To(Equal(config.AuthClientSecret))
+		Expect(newConfig(config.CertificateFile("cert.pfx", "")).AuthMethod()).To(Equal(config.AuthClientCertificate))
+		Expect(newConfig(config.ManagedIdentity("")).AuthMethod()).To(Equal(config.AuthManagedIdentity))
+		Expect(newConfig(config.FederatedTokenFile("token")).AuthMethod()).To(Equal(config.AuthWorkloadIdentity))
+		Expect(newConfig(config.AuthFileLocation("auth.json")).AuthMethod()).To(Equal(config.AuthFile))
+		Expect(newConfig(config.Key("key"), config.Method(config.AuthManagedIdentity)).AuthMethod()).To(Equal(config.AuthManagedIdentity))
+	})
+
+	It("should require the settings of each method", func() {
+		invalid := [][]config.Option{
+			{config.App("app"), config.Tenant("tenant")},
+			{config.App("app"), config.Method(config.AuthClientCertificate)},
+			{config.App("app"), config.Tenant("tenant"), config.Method(config.AuthWorkloadIdentity)},
+			{config.Method(config.AuthFile)},
+			{config.Method("password")},
+		}
+		for _, opts := range invalid {
+			_, err := newConfig(opts...).GetAuthorizerFromArgs()
+			Expect(err).To(HaveOccurred())
+		}
+	})
+
+	It("should authorize management and Key Vault clients with every method", func() {
+		authFile := write("auth.json", "\xef\xbb\xbf"+`{"clientId": "app", "clientSecret": "key", "tenantId": "tenant"}`)
+		configurations := map[config.AuthMethod]*config.Config{
+			config.AuthClientSecret:     newConfig(config.App("app"), config.Key("key"), config.Tenant("tenant")),
+			config.AuthManagedIdentity:  newConfig(config.ManagedIdentity("identity")),
+			config.AuthWorkloadIdentity: newConfig(config.App("app"), config.Tenant("tenant"), config.FederatedTokenFile(write("token", "federated"))),
+			config.AuthFile:             newConfig(config.AuthFileLocation(authFile)),
+		}
+		for method, configuration := range configurations {
+			authorizer, err := configuration.GetAuthorizerFromArgs()
+			Expect(err).ToNot(HaveOccurred(), string(method))
+			Expect(authorizer).ToNot(BeNil())
+			keyvault, err := configuration.GetKeyvaultAuthorizer()
+			Expect(err).ToNot(HaveOccurred(), string(method))
+			Expect(keyvault).ToNot(BeNil())
+			Expect(keyvault).ToNot(BeIdenticalTo(authorizer))
+		}
+	})
+
+	It("should report unreadable client certificates", func() {
+		configuration := newConfig(config.App("app"), config.Tenant("tenant"), config.CertificateFile(write("cert.pfx", "not a certificate"), ""))
+		_, err := configuration.GetKeyvaultAuthorizer()
+		Expect(err).To(MatchError(ContainSubstring("failed to decode client certificate")))
+
+		configuration = newConfig(config.App("app"), config.Tenant("tenant"), config.CertificateFile(filepath.Join(dir, "missing.pfx"), ""))
+		_, err = configuration.GetAuthorizerFromArgs()
+		Expect(err).To(MatchError(ContainSubstring("failed to read client certificate")))
+	})
+
+	It("should reject auth files without credentials", func() {
+		configuration := newConfig(config.AuthFileLocation(write("auth.json", `{"clientId": "app", "tenantId": "tenant"}`)))
+		_, err := configuration.GetAuthorizerFromArgs()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,72 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// clouds maps the short names accepted by ParseCloud to their environments.
+var clouds = map[string]azure.Environment{
+	"public":       azure.PublicCloud,
+	"usgovernment": azure.USGovernmentCloud,
+	"china":        azure.ChinaCloud,
+	"german":       azure.GermanCloud,
+}
+
+// ParseCloud looks up a cloud by short name, e.g. usgovernment, or by SDK name, e.g. AzureUSGovernmentCloud.
+// The empty string returns nil, which keeps the cloud named by AZURE_ENVIRONMENT.
+func ParseCloud(name string) (*azure.Environment, error) {
+	if name == "" {
+		return nil, nil
+	}
+	if env, ok := clouds[strings.ToLower(name)]; ok {
+		return &env, nil
+	}
+	env, err := azure.EnvironmentFromName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown cloud %q, must be one of public, usgovernment, china, german or an SDK environment name", name)
+	}
+	return &env, nil
+}
+
+// Cloud selects the Azure cloud, e.g. azure.USGovernmentCloud, instead of the cloud named by AZURE_ENVIRONMENT.
+func Cloud(env azure.Environment) Option {
+	return func(c *Config) {
+		c.env = &env
+	}
+}
+
+// VaultURLs replaces the Key Vault URLs derived from the cloud with the URLs returned by url, e.g. to reach an emulator.
+func VaultURLs(url func(vault string) string) Option {
+	return func(c *Config) {
+		c.vaultURL = url
+	}
+}
+
+// Cloud returns the Azure cloud whose endpoints and DNS suffixes every client uses.
+// A configuration built without New uses the public cloud, like the SDKs do without AZURE_ENVIRONMENT.
+func (c *Config) Cloud() azure.Environment {
+	if c.env == nil {
+		return azure.PublicCloud
+	}
+	return *c.env
+}
+
+// ResourceManagerEndpoint returns the base URI of Azure Resource Manager in the configured cloud.
+func (c *Config) ResourceManagerEndpoint() string {
+	return c.Cloud().ResourceManagerEndpoint
+}
+
+// VaultURL returns the URL of the Key Vault named vault in the configured cloud, e.g. https://vault.vault.azure.net.
+func (c *Config) VaultURL(vault string) string {
+	if c.vaultURL != nil {
+		return c.vaultURL(vault)
+	}
+	return fmt.Sprintf("https://%s.%s", vault, c.Cloud().KeyVaultDNSSuffix)
+}
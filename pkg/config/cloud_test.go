@@ -0,0 +1,91 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package config_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+var _ = Describe("clouds", func() {
+
+	DescribeTable("should derive endpoints from the cloud",
+		func(name, sdkName, resourceManager, vault string) {
+			for _, n := range []string{name, sdkName} {
+				env, err := config.ParseCloud(n)
+				Expect(err).ToNot(HaveOccurred())
+				configuration, err := config.New(config.Cloud(*env))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configuration.Cloud().Name).To(Equal(sdkName))
+				Expect(configuration.ResourceManagerEndpoint()).To(Equal(resourceManager))
+				Expect(configuration.VaultURL("vault")).To(Equal(vault))
+			}
+		},
+		Entry("public", "public", "AzurePublicCloud", "https://management.azure.com/", "https://vault.vault.azure.net"),
+		Entry("US government", "usgovernment", "AzureUSGovernmentCloud", "https://management.usgovcloudapi.net/", "https://vault.vault.usgovcloudapi.net"),
+		Entry("China", "china", "AzureChinaCloud", "https://management.chinacloudapi.cn/", "https://vault.vault.azure.cn"),
+		Entry("German", "german", "AzureGermanCloud", "https://management.microsoftazure.de/", "https://vault.vault.microsoftazure.de"),
+	)
+
+	It("should keep the cloud from the environment without a name", func() {
+		env, err := config.ParseCloud("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(env).To(BeNil())
+	})
+
+	It("should reject unknown clouds", func() {
+		_, err := config.ParseCloud("moon")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should use the public cloud without an environment", func() {
+		configuration := &config.Config{}
+		Expect(configuration.Cloud().Name).To(Equal(azure.PublicCloud.Name))
+		Expect(configuration.VaultURL("vault")).To(Equal("https://vault.vault.azure.net"))
+	})
+
+	It("should expose the DNS suffixes of the cloud", func() {
+		configuration, err := config.New(config.Cloud(azure.ChinaCloud))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configuration.Cloud().ActiveDirectoryEndpoint).To(Equal(azure.ChinaCloud.ActiveDirectoryEndpoint))
+		Expect(configuration.Cloud().TrafficManagerDNSSuffix).To(Equal("trafficmanager.cn"))
+		Expect(configuration.Cloud().StorageEndpointSuffix).To(Equal("core.chinacloudapi.cn"))
+	})
+
+	It("should request tokens for the endpoints of the cloud", func() {
+		var resources []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).To(Succeed())
+			resources = append(resources, r.PostForm.Get("resource"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":"3600","expires_on":"4102444800","not_before":"0"}`))
+		}))
+		defer server.Close()
+
+		env := azure.ChinaCloud
+		env.ActiveDirectoryEndpoint = server.URL + "/"
+		configuration, err := config.New(config.Cloud(env), config.App("app"), config.Key("key"), config.Tenant("tenant"))
+		Expect(err).ToNot(HaveOccurred())
+		management, err := configuration.GetAuthorizerFromArgs()
+		Expect(err).ToNot(HaveOccurred())
+		keyvault, err := configuration.GetKeyvaultAuthorizer()
+		Expect(err).ToNot(HaveOccurred())
+		for _, authorizer := range []autorest.Authorizer{management, keyvault} {
+			req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = autorest.Prepare(req, authorizer.WithAuthorization())
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(resources).To(Equal([]string{"https://management.chinacloudapi.cn/", "https://vault.azure.cn"}))
+	})
+})
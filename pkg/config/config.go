@@ -1,12 +1,15 @@
 package config
 
 import (
-	"errors"
 	"strings"
+	"sync"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/ratelimit"
+	"github.com/alexeldeib/incendiary-iguana/pkg/wirelog"
 )
 
 // Config holds environment settings, cached authorizers, and global loggers.
@@ -18,6 +21,32 @@ type Config struct {
 	app       string
 	key       string
 	tenant    string
+	clusterID string
+	limiter   *ratelimit.Limiter
+	wirelog   *wirelog.Logger
+	// vaultURL, when set, replaces the Key Vault URLs derived from the cloud, e.g. to reach an emulator.
+	vaultURL func(vault string) string
+
+	// method selects how authorizers are obtained. When empty it is inferred from the other settings.
+	method AuthMethod
+	// certificate and certificatePassword hold a PKCS#12 client certificate used instead of key.
+	// certificatePath is read instead when certificate is empty.
+	certificate         []byte
+	certificatePath     string
+	certificatePassword string
+	// identityClientID selects a user assigned managed identity.
+	identityClientID string
+	// tokenFile holds a federated token exchanged for an access token with workload identity.
+	tokenFile string
+	// authFile is an SDK auth file as written by az ad sp create-for-rbac --sdk-auth.
+	authFile string
+
+	// staticAuthorizer, when set, authorizes every client instead of tokens obtained with method.
+	staticAuthorizer autorest.Authorizer
+
+	// authorizers caches one authorizer per resource, so all clients share and refresh the same token.
+	authorizersMu sync.Mutex
+	authorizers   map[string]autorest.Authorizer
 }
 
 type Option func(*Config)
@@ -32,8 +61,10 @@ func New(opts ...Option) (*Config, error) {
 	}
 
 	c := &Config{
-		userAgent: "azauth",
-		env:       &settings.Environment,
+		userAgent:   "azauth",
+		env:         &settings.Environment,
+		authorizers: map[string]autorest.Authorizer{},
+		limiter:     ratelimit.New(),
 	}
 
 	for _, opt := range opts {
@@ -71,6 +102,44 @@ func Tenant(tenant string) Option {
 	}
 }
 
+// ClusterID sets the identifier of this cluster, which is stamped on Azure resources to record ownership.
+func ClusterID(clusterID string) Option {
+	return func(c *Config) {
+		c.clusterID = clusterID
+	}
+}
+
+// WireLogger logs the requests and responses of Azure clients built from this configuration, with credentials redacted.
+func WireLogger(logger *wirelog.Logger) Option {
+	return func(c *Config) {
+		c.wirelog = logger
+	}
+}
+
+// RateLimiter replaces the default limiter shared by all Azure clients built from this configuration.
+func RateLimiter(limiter *ratelimit.Limiter) Option {
+	return func(c *Config) {
+		c.limiter = limiter
+	}
+}
+
+// RateLimit routes the requests of client through the shared limiter of subscriptionID.
+func (c *Config) RateLimit(client *autorest.Client, subscriptionID string) {
+	if c.limiter != nil {
+		c.limiter.Apply(client, subscriptionID)
+	}
+}
+
+// WireLog logs the traffic of client at the level configured for kind, if any.
+func (c *Config) WireLog(client *autorest.Client, kind string) {
+	c.wirelog.Apply(client, kind)
+}
+
+// ClusterID returns the identifier of this cluster used in ownership tags.
+func (c *Config) ClusterID() string {
+	return c.clusterID
+}
+
 // AuthorizeClientForResource tries to fetch an authorizer using GetAuthorizerForResource and inject it into a client.
 func (c *Config) AuthorizeClientForResource(client *autorest.Client, resource string) (err error) {
 	if authorizer, err := auth.NewAuthorizerFromEnvironmentWithResource(resource); err == nil {
@@ -91,7 +160,7 @@ func (c *Config) AuthorizeClient(client *autorest.Client) (err error) {
 
 // AuthorizeClientFromFile tries to fetch an authorizer using GetFileAuthorizer and inject it into a client.
 func (c *Config) AuthorizeClientFromFile(client *autorest.Client) (err error) {
-	if authorizer, err := auth.NewAuthorizerFromFile(c.env.ResourceManagerEndpoint); err == nil {
+	if authorizer, err := auth.NewAuthorizerFromFile(c.Cloud().ResourceManagerEndpoint); err == nil {
 		client.Authorizer = authorizer
 		return client.AddToUserAgent(c.userAgent)
 	}
@@ -107,58 +176,52 @@ func (c *Config) AuthorizeClientFromFileForResource(client *autorest.Client, res
 	return
 }
 
+// GetAuthorizerFromArgs returns an authorizer for Azure Resource Manager using the configured authentication method.
 func (c *Config) GetAuthorizerFromArgs() (autorest.Authorizer, error) {
-	if err := c.validateArgs(); err != nil {
-		return nil, err
-	}
-	authConfig := auth.ClientCredentialsConfig{
-		ClientID:     c.app,
-		ClientSecret: c.key,
-		TenantID:     c.tenant,
-		Resource:     c.env.ResourceManagerEndpoint,
-		AADEndpoint:  c.env.ActiveDirectoryEndpoint,
-	}
-
-	return authConfig.Authorizer()
+	return c.GetAuthorizerFromArgsForResource(c.Cloud().ResourceManagerEndpoint)
 }
 
+// GetAuthorizerFromArgsForResource returns an authorizer for resource using the configured authentication method.
+// Authorizers are cached per resource, so clients share and refresh the same token.
 func (c *Config) GetAuthorizerFromArgsForResource(resource string) (autorest.Authorizer, error) {
+	if c.staticAuthorizer != nil {
+		return c.staticAuthorizer, nil
+	}
 	if err := c.validateArgs(); err != nil {
 		return nil, err
 	}
-	authConfig := auth.ClientCredentialsConfig{
-		ClientID:     c.app,
-		ClientSecret: c.key,
-		TenantID:     c.tenant,
-		Resource:     resource,
-		AADEndpoint:  c.env.ActiveDirectoryEndpoint,
+	c.authorizersMu.Lock()
+	defer c.authorizersMu.Unlock()
+	if authorizer, ok := c.authorizers[resource]; ok {
+		return authorizer, nil
 	}
-
-	return authConfig.Authorizer()
+	authorizer, err := c.authorizer(resource)
+	if err != nil {
+		return nil, err
+	}
+	if c.authorizers == nil {
+		c.authorizers = map[string]autorest.Authorizer{}
+	}
+	c.authorizers[resource] = authorizer
+	return authorizer, nil
 }
 
-// AuthorizeClientFromArgs tries to fetch an authorizer using GetArgsAuthorizer and inject it into a client.
+// AuthorizeClientFromArgs fetches an authorizer using GetAuthorizerFromArgs and injects it into a client.
 func (c *Config) AuthorizeClientFromArgs(client *autorest.Client) (err error) {
-	return c.AuthorizeClientFromArgsForResource(client, c.env.ResourceManagerEndpoint)
-}
-
-// AuthorizeClientFromArgs tries to fetch an authorizer using GetArgsAuthorizer and inject it into a client.
-func (c *Config) AuthorizeClientFromArgsForResource(client *autorest.Client, resource string) (err error) {
-	if authorizer, err := c.GetAuthorizerFromArgs(); err == nil {
-		client.Authorizer = authorizer
-		return client.AddToUserAgent(c.userAgent)
-	}
-	return
+	return c.AuthorizeClientFromArgsForResource(client, c.Cloud().ResourceManagerEndpoint)
 }
 
-func (c *Config) validateArgs() error {
-	if c.app == "" || c.tenant == "" || c.key == "" {
-		return errors.New("app, tenant, and key must all be provided as options for authenticating with args")
+// AuthorizeClientFromArgsForResource fetches an authorizer for resource using GetAuthorizerFromArgsForResource and injects it into a client.
+func (c *Config) AuthorizeClientFromArgsForResource(client *autorest.Client, resource string) error {
+	authorizer, err := c.GetAuthorizerFromArgsForResource(resource)
+	if err != nil {
+		return err
 	}
-	return nil
+	client.Authorizer = authorizer
+	return client.AddToUserAgent(c.userAgent)
 }
 
 // GetKeyvaultAuthorizer creates a new Keyvault authorizer.
 func (c *Config) GetKeyvaultAuthorizer() (autorest.Authorizer, error) {
-	return c.GetAuthorizerFromArgsForResource(strings.TrimSuffix(c.env.KeyVaultEndpoint, "/"))
+	return c.GetAuthorizerFromArgsForResource(strings.TrimSuffix(c.Cloud().KeyVaultEndpoint, "/"))
 }
@@ -0,0 +1,311 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+// Package conformance checks that Azure clients reconcile their kinds consistently.
+//
+// Every client reconciled by the controllers is expected to behave the same way:
+// Ensure creates a missing resource, updates mutable fields of an existing one, stops writing to Azure once it is done,
+// and populates the ID and provisioning state in status; Observe reads the resource without writing and reports when it is gone;
+// Delete removes the resource and succeeds when it is already gone.
+// Kinds copying data out of Azure, e.g. keys and Key Vault secrets, are held to the same rules for the Kubernetes secret they write.
+// RunAll registers ginkgo specs checking those rules for every kind registered with the controllers against the azfake emulator.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/controllers"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azfake"
+	"github.com/alexeldeib/incendiary-iguana/pkg/config"
+)
+
+// Attempts bounds how many calls to Ensure or Delete a client may take to finish.
+const Attempts = 10
+
+// Namespace is the namespace of the objects reconciled by every check, and of the Kubernetes secrets they write.
+const Namespace = "default"
+
+// Case describes the object reconciled for one kind.
+type Case struct {
+	// Seed creates the Azure resources the object depends on, e.g. its resource group.
+	// +optional
+	Seed func(server *azfake.Server) error
+	// New returns the object reconciled by every check. It must be named and in Namespace.
+	New func() runtime.Object
+	// ID returns the Azure resource ID of the resource obj manages.
+	// Kinds which only copy data out of Azure leave it nil and set Secret instead.
+	// +optional
+	ID func(obj runtime.Object) string
+	// Secret returns the name of the Kubernetes secret written for obj, for kinds which only copy data out of Azure.
+	// +optional
+	Secret func(obj runtime.Object) string
+	// Update changes a mutable field in the spec of obj. Nil skips the update check, for kinds without mutable fields.
+	// +optional
+	Update func(obj runtime.Object)
+	// Updated reports whether the resource stored by the emulator reflects the change made by Update.
+	// +optional
+	Updated func(server *azfake.Server, obj runtime.Object) (bool, error)
+}
+
+// RunAll registers the conformance specs of every kind registered with the controllers, using the case of the same name.
+// It also registers a spec failing for kinds without a case, so a new kind cannot skip the checks unnoticed.
+// Call it while the spec tree is built, e.g. from a var declaration in a test file.
+func RunAll(cases map[string]Case) bool {
+	Describe("conformance cases", func() {
+		It("should cover every registered kind", func() {
+			for _, kind := range controllers.Kinds() {
+				Expect(cases).To(HaveKey(kind.Kind), "no conformance case for %s", kind.Kind)
+			}
+		})
+	})
+	for _, kind := range controllers.Kinds() {
+		if c, ok := cases[kind.Kind]; ok {
+			Run(kind, c)
+		}
+	}
+	return true
+}
+
+// Run registers the conformance specs of one kind. Call it while the spec tree is built, e.g. from a var declaration in a test file.
+func Run(kind controllers.Kind, c Case) bool {
+	return Describe(kind.Kind+" client", func() {
+		var (
+			ctx        context.Context
+			server     *azfake.Server
+			kubeclient client.Client
+			az         *adapter
+			obj        runtime.Object
+		)
+
+		// exists reports whether the resource managed by obj exists, in Azure or as a Kubernetes secret.
+		exists := func() bool {
+			if c.ID == nil {
+				err := kubeclient.Get(ctx, types.NamespacedName{Namespace: Namespace, Name: c.Secret(obj)}, &corev1.Secret{})
+				ExpectWithOffset(1, client.IgnoreNotFound(err)).NotTo(HaveOccurred())
+				return err == nil
+			}
+			found, err := server.Resource(c.ID(obj), &map[string]interface{}{})
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return found
+		}
+
+		// describe names the resource managed by obj in failure messages.
+		describe := func() string {
+			if c.ID == nil {
+				return fmt.Sprintf("secret %s/%s", Namespace, c.Secret(obj))
+			}
+			return c.ID(obj)
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			server = azfake.NewServer()
+			if c.Seed != nil {
+				Expect(c.Seed(server)).To(Succeed())
+			}
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(azurev1alpha1.AddToScheme(scheme)).To(Succeed())
+			kubeclient = fake.NewFakeClientWithScheme(scheme)
+			configuration, err := server.Config()
+			Expect(err).NotTo(HaveOccurred())
+			az, err = newAdapter(kind, configuration, kubeclient, scheme)
+			Expect(err).NotTo(HaveOccurred())
+			obj = c.New()
+			Expect(az.ForSubscription(ctx, obj)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should create a missing resource", func() {
+			ensure(ctx, az, obj)
+			Expect(exists()).To(BeTrue(), "Ensure finished without creating %s", describe())
+		})
+
+		It("should populate the ID and provisioning state in status", func() {
+			if c.ID == nil {
+				Skip(kind.Kind + " creates no Azure resource")
+			}
+			ensure(ctx, az, obj)
+			id, ok := status(obj, "ID")
+			Expect(ok).To(BeTrue(), "status of %s has no ID", kind.Kind)
+			Expect(strings.ToLower(id)).To(Equal(strings.ToLower(c.ID(obj))))
+			if state, ok := status(obj, "ProvisioningState"); ok {
+				Expect(state).To(Equal("Succeeded"))
+			}
+		})
+
+		It("should not write to Azure once done", func() {
+			ensure(ctx, az, obj)
+			before := len(server.Requests())
+			done, err := az.Ensure(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(writes(server.Requests()[before:])).To(BeEmpty())
+		})
+
+		It("should observe a resource without writing to Azure", func() {
+			ensure(ctx, az, obj)
+			before := len(server.Requests())
+			drifted, err := az.Observe(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifted).To(BeFalse(), "Observe reported drift right after Ensure finished")
+			Expect(writes(server.Requests()[before:])).To(BeEmpty())
+
+			remove(ctx, az, obj)
+			drifted, err = az.Observe(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifted).To(BeTrue(), "Observe did not report a deleted resource")
+		})
+
+		It("should update mutable fields", func() {
+			if c.Update == nil {
+				Skip(kind.Kind + " has no mutable fields")
+			}
+			ensure(ctx, az, obj)
+			before := len(server.Requests())
+			c.Update(obj)
+			ensure(ctx, az, obj)
+			Expect(writes(server.Requests()[before:])).NotTo(BeEmpty(), "Ensure finished without updating %s", describe())
+			if c.Updated != nil {
+				Expect(c.Updated(server, obj)).To(BeTrue())
+			}
+		})
+
+		It("should delete the resource", func() {
+			ensure(ctx, az, obj)
+			remove(ctx, az, obj)
+			Expect(exists()).To(BeFalse(), "Delete finished without deleting %s", describe())
+		})
+
+		It("should succeed deleting an absent resource", func() {
+			found, err := az.Delete(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+}
+
+// adapter runs sync and async clients through the same checks, reporting sync clients as done after every successful call.
+type adapter struct {
+	sync  controllers.SyncClient
+	async controllers.AsyncClient
+}
+
+// newAdapter builds the client of kind the same way the controllers do.
+func newAdapter(kind controllers.Kind, configuration *config.Config, kubeclient client.Client, scheme *runtime.Scheme) (*adapter, error) {
+	if kind.Mode() == controllers.ModeAsync {
+		client, err := kind.NewAsync(configuration, &kubeclient, scheme)
+		return &adapter{async: client}, err
+	}
+	client, err := kind.NewSync(configuration, &kubeclient, scheme)
+	return &adapter{sync: client}, err
+}
+
+func (a *adapter) ForSubscription(ctx context.Context, obj runtime.Object) error {
+	if a.async != nil {
+		return a.async.ForSubscription(ctx, obj)
+	}
+	return a.sync.ForSubscription(ctx, obj)
+}
+
+func (a *adapter) Ensure(ctx context.Context, obj runtime.Object) (bool, error) {
+	if a.async != nil {
+		return a.async.Ensure(ctx, obj)
+	}
+	return true, a.sync.Ensure(ctx, obj)
+}
+
+func (a *adapter) Observe(ctx context.Context, obj runtime.Object) (bool, error) {
+	var client interface{} = a.sync
+	if a.async != nil {
+		client = a.async
+	}
+	observer, ok := client.(controllers.Observer)
+	if !ok {
+		return false, fmt.Errorf("client of %T does not implement Observe", obj)
+	}
+	return observer.Observe(ctx, obj)
+}
+
+func (a *adapter) Delete(ctx context.Context, obj runtime.Object) (bool, error) {
+	if a.async != nil {
+		return a.async.Delete(ctx, obj)
+	}
+	return false, a.sync.Delete(ctx, obj)
+}
+
+// ensure calls Ensure until it reports done.
+func ensure(ctx context.Context, client *adapter, obj runtime.Object) {
+	for i := 0; i < Attempts; i++ {
+		done, err := client.Ensure(ctx, obj)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		if done {
+			return
+		}
+	}
+	Fail(fmt.Sprintf("Ensure not done after %d attempts", Attempts), 1)
+}
+
+// remove calls Delete until it reports the resource gone.
+func remove(ctx context.Context, client *adapter, obj runtime.Object) {
+	for i := 0; i < Attempts; i++ {
+		found, err := client.Delete(ctx, obj)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		if !found {
+			return
+		}
+	}
+	Fail(fmt.Sprintf("Delete not done after %d attempts", Attempts), 1)
+}
+
+// writes returns the requests among requests which change resources in Azure.
+func writes(requests []string) []string {
+	var out []string
+	for _, request := range requests {
+		method := strings.SplitN(request, " ", 2)[0]
+		if method == "PUT" || method == "PATCH" || method == "DELETE" {
+			out = append(out, request)
+		}
+	}
+	return out
+}
+
+// status returns the string field named name in the status of obj, which may be a string or a *string.
+// It returns false when the status has no such field, or it is unset.
+func status(obj runtime.Object, name string) (string, bool) {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+	s := v.FieldByName("Status")
+	if !s.IsValid() || s.Kind() != reflect.Struct {
+		return "", false
+	}
+	f := s.FieldByName(name)
+	if f.IsValid() && f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return "", false
+		}
+		f = f.Elem()
+	}
+	if !f.IsValid() || f.Kind() != reflect.String {
+		return "", false
+	}
+	return f.String(), true
+}
@@ -0,0 +1,573 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package conformance_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/azfake"
+	"github.com/alexeldeib/incendiary-iguana/pkg/conformance"
+)
+
+const (
+	subscription = "00000000-0000-0000-0000-000000000000"
+	tenant       = "11111111-1111-1111-1111-111111111111"
+	location     = "westus2"
+	groupID      = "/subscriptions/" + subscription + "/resourceGroups/group"
+	networkID    = groupID + "/providers/Microsoft.Network"
+	redisID      = groupID + "/providers/Microsoft.Cache/Redis/redis"
+	busID        = groupID + "/providers/Microsoft.ServiceBus/namespaces/servicebus"
+	sqlID        = groupID + "/providers/Microsoft.Sql/servers/sql"
+	storageID    = groupID + "/providers/Microsoft.Storage/storageAccounts/storage"
+)
+
+// meta names an object reconciled by the checks.
+func meta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: conformance.Namespace}
+}
+
+// named returns the name of obj, which is also the name of the Kubernetes secret written by kinds copying Key Vault secrets.
+func named(obj runtime.Object) string {
+	return obj.(metav1.Object).GetName()
+}
+
+// seedGroup creates the resource group containing every resource below.
+func seedGroup(server *azfake.Server) error {
+	return server.Seed(groupID, resources.Group{Location: to.StringPtr(location)})
+}
+
+// seedWith returns a Seed function creating the resource group, then the resource with the given ID.
+func seedWith(id string, resource interface{}) func(*azfake.Server) error {
+	return func(server *azfake.Server) error {
+		if err := seedGroup(server); err != nil {
+			return err
+		}
+		return server.Seed(id, resource)
+	}
+}
+
+// seedVaultSecrets returns a Seed function storing the key value pairs as secrets of the Key Vault named vault.
+func seedVaultSecrets(secrets map[string]string) func(*azfake.Server) error {
+	return func(server *azfake.Server) error {
+		for name, value := range secrets {
+			server.SetSecret("vault", name, value)
+		}
+		return nil
+	}
+}
+
+// located is a resource the emulator can seed, with nothing but a location.
+type located struct {
+	Location *string `json:"location"`
+}
+
+var _ = conformance.RunAll(map[string]conformance.Case{
+	"ResourceGroup": {
+		New: func() runtime.Object {
+			return &azurev1alpha1.ResourceGroup{
+				ObjectMeta: meta("group"),
+				Spec: azurev1alpha1.ResourceGroupSpec{
+					Name:           "group",
+					Location:       location,
+					SubscriptionID: subscription,
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return groupID },
+	},
+	"VirtualNetwork": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.VirtualNetwork{
+				ObjectMeta: meta("vnet"),
+				Spec: azurev1alpha1.VirtualNetworkSpec{
+					Name:           "vnet",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					Addresses:      []string{"10.0.0.0/16"},
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return networkID + "/virtualNetworks/vnet" },
+		Update: func(obj runtime.Object) {
+			vnet := obj.(*azurev1alpha1.VirtualNetwork)
+			vnet.Spec.Addresses = append(vnet.Spec.Addresses, "10.1.0.0/16")
+		},
+		Updated: func(server *azfake.Server, obj runtime.Object) (bool, error) {
+			var remote network.VirtualNetwork
+			if _, err := server.Resource(networkID+"/virtualNetworks/vnet", &remote); err != nil {
+				return false, err
+			}
+			return len(*remote.AddressSpace.AddressPrefixes) == 2, nil
+		},
+	},
+	"Subnet": {
+		Seed: seedWith(networkID+"/virtualNetworks/vnet", network.VirtualNetwork{
+			Location: to.StringPtr(location),
+			VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+				AddressSpace: &network.AddressSpace{AddressPrefixes: &[]string{"10.0.0.0/16"}},
+			},
+		}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.Subnet{
+				ObjectMeta: meta("subnet"),
+				Spec: azurev1alpha1.SubnetSpec{
+					Name:           "subnet",
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					Network:        "vnet",
+					Subnet:         "10.0.0.0/24",
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return networkID + "/virtualNetworks/vnet/subnets/subnet" },
+		Update: func(obj runtime.Object) {
+			obj.(*azurev1alpha1.Subnet).Spec.Subnet = "10.0.1.0/24"
+		},
+		Updated: func(server *azfake.Server, obj runtime.Object) (bool, error) {
+			var remote network.Subnet
+			if _, err := server.Resource(networkID+"/virtualNetworks/vnet/subnets/subnet", &remote); err != nil {
+				return false, err
+			}
+			return remote.AddressPrefix != nil && *remote.AddressPrefix == "10.0.1.0/24", nil
+		},
+	},
+	"NetworkInterface": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.NetworkInterface{
+				ObjectMeta: meta("nic"),
+				Spec: azurev1alpha1.NetworkInterfaceSpec{
+					Name:           "nic",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					Network:        "vnet",
+					Subnet:         "subnet",
+					IPConfigurations: &[]azurev1alpha1.InterfaceIPConfig{
+						{},
+					},
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return networkID + "/networkInterfaces/nic" },
+		Update: func(obj runtime.Object) {
+			nic := obj.(*azurev1alpha1.NetworkInterface)
+			(*nic.Spec.IPConfigurations)[0].PrivateIP = to.StringPtr("10.0.0.4")
+		},
+		Updated: func(server *azfake.Server, obj runtime.Object) (bool, error) {
+			var remote network.Interface
+			if _, err := server.Resource(networkID+"/networkInterfaces/nic", &remote); err != nil {
+				return false, err
+			}
+			ip := (*remote.IPConfigurations)[0].PrivateIPAddress
+			return ip != nil && *ip == "10.0.0.4", nil
+		},
+	},
+	"PublicIP": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.PublicIP{
+				ObjectMeta: meta("ip"),
+				Spec: azurev1alpha1.PublicIPSpec{
+					Name:           "ip",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return networkID + "/publicIPAddresses/ip" },
+	},
+	"SecurityGroup": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.SecurityGroup{
+				ObjectMeta: meta("nsg"),
+				Spec: azurev1alpha1.SecurityGroupSpec{
+					Name:           "nsg",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return networkID + "/networkSecurityGroups/nsg" },
+	},
+	"LoadBalancer": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.LoadBalancer{
+				ObjectMeta: meta("lb"),
+				Spec: azurev1alpha1.LoadBalancerSpec{
+					Name:           "lb",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					Frontends:      []string{networkID + "/publicIPAddresses/ip"},
+					BackendPools:   []string{"pool"},
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return networkID + "/loadBalancers/lb" },
+		Update: func(obj runtime.Object) {
+			lb := obj.(*azurev1alpha1.LoadBalancer)
+			lb.Spec.BackendPools = append(lb.Spec.BackendPools, "other")
+		},
+		Updated: func(server *azfake.Server, obj runtime.Object) (bool, error) {
+			var remote network.LoadBalancer
+			if _, err := server.Resource(networkID+"/loadBalancers/lb", &remote); err != nil {
+				return false, err
+			}
+			return len(*remote.BackendAddressPools) == 2, nil
+		},
+	},
+	"TrafficManager": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.TrafficManager{
+				ObjectMeta: meta("tm"),
+				Spec: azurev1alpha1.TrafficManagerSpec{
+					Name:                 "tm",
+					ResourceGroup:        "group",
+					SubscriptionID:       subscription,
+					ProfileStatus:        "Enabled",
+					TrafficRoutingMethod: "Weighted",
+					DNSConfig: azurev1alpha1.DNSConfig{
+						RelativeName: to.StringPtr("conformance"),
+						TTL:          to.Int64Ptr(30),
+					},
+					MonitorConfig: azurev1alpha1.MonitorConfig{
+						Protocol: "HTTPS",
+						Port:     to.Int64Ptr(443),
+						Path:     to.StringPtr("/healthz"),
+					},
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return networkID + "/trafficManagerProfiles/tm" },
+	},
+	"VM": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.VM{
+				ObjectMeta: meta("vm"),
+				Spec: azurev1alpha1.VMSpec{
+					Name:           "vm",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					SKU:            "Standard_D2s_v3",
+					SSHPublicKey:   "ssh-rsa AAAA conformance",
+					PrimaryNIC:     "nic",
+					DiskSize:       30,
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return groupID + "/providers/Microsoft.Compute/virtualMachines/vm" },
+	},
+	"Identity": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.Identity{
+				ObjectMeta: meta("identity"),
+				Spec: azurev1alpha1.IdentitySpec{
+					Name:           "identity",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+				},
+			}
+		},
+		ID: func(runtime.Object) string {
+			return groupID + "/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity"
+		},
+	},
+	"Keyvault": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.Keyvault{
+				ObjectMeta: meta("vault"),
+				Spec: azurev1alpha1.KeyvaultSpec{
+					Name:           "vault",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					TenantID:       tenant,
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return groupID + "/providers/Microsoft.KeyVault/vaults/vault" },
+		Update: func(obj runtime.Object) {
+			obj.(*azurev1alpha1.Keyvault).Spec.TenantID = "22222222-2222-2222-2222-222222222222"
+		},
+		Updated: func(server *azfake.Server, obj runtime.Object) (bool, error) {
+			var remote keyvault.Vault
+			if _, err := server.Resource(groupID+"/providers/Microsoft.KeyVault/vaults/vault", &remote); err != nil {
+				return false, err
+			}
+			return remote.Properties.TenantID.String() == "22222222-2222-2222-2222-222222222222", nil
+		},
+	},
+	"Redis": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.Redis{
+				ObjectMeta: meta("redis"),
+				Spec: azurev1alpha1.RedisSpec{
+					Name:           "redis",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					SKU: azurev1alpha1.RedisSku{
+						Name:     azurev1alpha1.Basic,
+						Family:   azurev1alpha1.C,
+						Capacity: 1,
+					},
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return redisID },
+		Update: func(obj runtime.Object) {
+			obj.(*azurev1alpha1.Redis).Spec.EnableNonSslPort = true
+		},
+		Updated: func(server *azfake.Server, obj runtime.Object) (bool, error) {
+			var remote redis.ResourceType
+			if _, err := server.Resource(redisID, &remote); err != nil {
+				return false, err
+			}
+			return remote.EnableNonSslPort != nil && *remote.EnableNonSslPort, nil
+		},
+	},
+	"ServiceBusNamespace": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.ServiceBusNamespace{
+				ObjectMeta: meta("servicebus"),
+				Spec: azurev1alpha1.ServiceBusNamespaceSpec{
+					Name:           "servicebus",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					SKU: azurev1alpha1.ServiceBusNamespaceSku{
+						Name:     azurev1alpha1.Standard,
+						Tier:     azurev1alpha1.Standard,
+						Capacity: 1,
+					},
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return busID },
+	},
+	"SQLServer": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.SQLServer{
+				ObjectMeta: meta("sql"),
+				Spec: azurev1alpha1.SQLServerSpec{
+					Name:           "sql",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return sqlID },
+	},
+	"SQLFirewallRule": {
+		Seed: seedWith(sqlID, located{Location: to.StringPtr(location)}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.SQLFirewallRule{
+				ObjectMeta: meta("rule"),
+				Spec: azurev1alpha1.SQLFirewallRuleSpec{
+					Name:           "rule",
+					Server:         "sql",
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					Start:          "10.0.0.1",
+					End:            "10.0.0.1",
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return sqlID + "/firewallRules/rule" },
+		Update: func(obj runtime.Object) {
+			obj.(*azurev1alpha1.SQLFirewallRule).Spec.End = "10.0.0.9"
+		},
+		Updated: func(server *azfake.Server, obj runtime.Object) (bool, error) {
+			var remote struct {
+				Properties struct {
+					EndIPAddress string `json:"endIpAddress"`
+				} `json:"properties"`
+			}
+			if _, err := server.Resource(sqlID+"/firewallRules/rule", &remote); err != nil {
+				return false, err
+			}
+			return remote.Properties.EndIPAddress == "10.0.0.9", nil
+		},
+	},
+	"StorageAccount": {
+		Seed: seedGroup,
+		New: func() runtime.Object {
+			return &azurev1alpha1.StorageAccount{
+				ObjectMeta: meta("storage"),
+				Spec: azurev1alpha1.StorageAccountSpec{
+					Name:           "storage",
+					Location:       location,
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+				},
+			}
+		},
+		ID: func(runtime.Object) string { return storageID },
+	},
+	"RedisKey": {
+		Seed: seedWith(redisID, located{Location: to.StringPtr(location)}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.RedisKey{
+				ObjectMeta: meta("rediskey"),
+				Spec: azurev1alpha1.RedisKeySpec{
+					Name:           "redis",
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					TargetSecret:   "redis-keys",
+					PrimaryKey:     to.StringPtr("primary"),
+				},
+			}
+		},
+		Secret: func(runtime.Object) string { return "redis-keys" },
+	},
+	"ServiceBusKey": {
+		Seed: seedWith(busID, located{Location: to.StringPtr(location)}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.ServiceBusKey{
+				ObjectMeta: meta("buskey"),
+				Spec: azurev1alpha1.ServiceBusKeySpec{
+					Name:           "servicebus",
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					TargetSecret:   "bus-keys",
+				},
+			}
+		},
+		Secret: func(runtime.Object) string { return "bus-keys" },
+	},
+	"StorageKey": {
+		Seed: seedWith(storageID, located{Location: to.StringPtr(location)}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.StorageKey{
+				ObjectMeta: meta("storagekey"),
+				Spec: azurev1alpha1.StorageKeySpec{
+					Name:           "storage",
+					ResourceGroup:  "group",
+					SubscriptionID: subscription,
+					TargetSecret:   to.StringPtr("storage-keys"),
+				},
+			}
+		},
+		Secret: func(runtime.Object) string { return "storage-keys" },
+	},
+	"Secret": {
+		Seed: seedVaultSecrets(map[string]string{"password": "hunter2"}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.Secret{
+				ObjectMeta: meta("password"),
+				Spec: azurev1alpha1.SecretSpec{
+					SecretIdentifier: azurev1alpha1.SecretIdentifier{Name: "password", Vault: "vault"},
+				},
+			}
+		},
+		Secret: named,
+	},
+	"SecretBundle": {
+		Seed: seedVaultSecrets(map[string]string{"user": "admin", "password": "hunter2"}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.SecretBundle{
+				ObjectMeta: meta("bundle"),
+				Spec: azurev1alpha1.SecretBundleSpec{
+					Name: "bundle",
+					Secrets: map[string]azurev1alpha1.SecretIdentifier{
+						"user":     {Name: "user", Vault: "vault"},
+						"password": {Name: "password", Vault: "vault"},
+					},
+				},
+			}
+		},
+		Secret: named,
+	},
+	"DockerConfig": {
+		Seed: seedVaultSecrets(map[string]string{"registry-password": "hunter2"}),
+		New: func() runtime.Object {
+			return &azurev1alpha1.DockerConfig{
+				ObjectMeta: meta("registry"),
+				Spec: azurev1alpha1.DockerConfigSpec{
+					Username: "admin",
+					Password: "registry-password",
+					Vault:    "vault",
+					Email:    "admin@example.com",
+					Server:   "registry.example.com",
+				},
+			}
+		},
+		Secret: named,
+	},
+	"TLSSecret": {
+		Seed: func(server *azfake.Server) error {
+			pfx, err := newPFX()
+			if err != nil {
+				return err
+			}
+			return server.ImportCertificate("vault", "tls", pfx, "")
+		},
+		New: func() runtime.Object {
+			return &azurev1alpha1.TLSSecret{
+				ObjectMeta: meta("tls"),
+				Spec: azurev1alpha1.TLSSecretSpec{
+					Name:  "tls",
+					Vault: "vault",
+				},
+			}
+		},
+		Secret: named,
+	},
+})
+
+// newPFX returns a PFX without password, holding a new self signed certificate and its key.
+func newPFX() ([]byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "conformance"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return pkcs12.Encode(rand.Reader, key, cert, nil, "")
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "incendiary_iguana"
+
+// Readiness states reported by the objects gauge.
+const (
+	StateReady       = "ready"
+	StateReconciling = "reconciling"
+	StateFailed      = "failed"
+	StatePaused      = "paused"
+	StateWaiting     = "waiting"
+	StateUnknown     = "unknown"
+)
+
+var (
+	// AzureRequests counts every HTTP request sent to Azure, including retries and LRO polls.
+	AzureRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "azure_requests_total",
+		Help:      "Total number of HTTP requests sent to Azure by kind, operation and status code.",
+	}, []string{"kind", "operation", "code"})
+
+	// AzureRequestDuration observes the latency of every HTTP request sent to Azure.
+	AzureRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "azure_request_duration_seconds",
+		Help:      "Latency of HTTP requests sent to Azure by kind, operation and status code.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"kind", "operation", "code"})
+
+	// AzureThrottled counts requests rejected by Azure with 429 Too Many Requests.
+	AzureThrottled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "azure_throttled_requests_total",
+		Help:      "Total number of HTTP requests throttled by Azure by kind and operation.",
+	}, []string{"kind", "operation"})
+
+	// LRODuration observes how long long running operations take from start until the resource is ready.
+	LRODuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "azure_lro_duration_seconds",
+		Help:      "Time from starting a long running operation until the resource is ready by kind.",
+		Buckets:   prometheus.ExponentialBuckets(5, 2, 10),
+	}, []string{"kind"})
+
+	// Objects tracks the number of objects per kind in each readiness state.
+	Objects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "objects",
+		Help:      "Number of objects by kind and readiness state.",
+	}, []string{"kind", "state"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		AzureRequests,
+		AzureRequestDuration,
+		AzureThrottled,
+		LRODuration,
+		Objects,
+	)
+}
+
+// Instrument wraps the sender of an Azure client to record request counts and latencies for kind.
+func Instrument(client *autorest.Client, kind string) {
+	sender := client.Sender
+	if sender == nil {
+		sender = autorest.CreateSender()
+	}
+	client.Sender = autorest.DecorateSender(sender, WithMetrics(kind))
+}
+
+// WithMetrics returns a SendDecorator recording request counts and latencies for kind.
+func WithMetrics(kind string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := s.Do(r)
+			ObserveRequest(kind, Operation(r), resp, time.Since(start))
+			return resp, err
+		})
+	}
+}
+
+// ObserveRequest records a single request to Azure. A nil response is recorded with code "error".
+func ObserveRequest(kind, operation string, resp *http.Response, elapsed time.Duration) {
+	code := "error"
+	if resp != nil {
+		code = strconv.Itoa(resp.StatusCode)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			AzureThrottled.WithLabelValues(kind, operation).Inc()
+		}
+	}
+	AzureRequests.WithLabelValues(kind, operation, code).Inc()
+	AzureRequestDuration.WithLabelValues(kind, operation, code).Observe(elapsed.Seconds())
+}
+
+// Operation derives a low cardinality operation name from an Azure request.
+// Polls of long running operations are reported as Poll, POST actions by their action name.
+func Operation(r *http.Request) string {
+	lower := strings.ToLower(r.URL.Path)
+	if strings.Contains(lower, "/operationresults/") || strings.Contains(lower, "/asyncoperations/") || strings.Contains(lower, "/operations/") {
+		return "Poll"
+	}
+	switch r.Method {
+	case http.MethodGet:
+		return "Get"
+	case http.MethodPut:
+		return "CreateOrUpdate"
+	case http.MethodPatch:
+		return "Update"
+	case http.MethodDelete:
+		return "Delete"
+	case http.MethodPost:
+		return path.Base(r.URL.Path)
+	default:
+		return r.Method
+	}
+}
+
+var readiness = &tracker{states: map[string]map[string]string{}}
+
+// SetState records the readiness state of the object identified by key and updates the objects gauge.
+func SetState(kind, key, state string) {
+	readiness.set(kind, key, state)
+}
+
+// Forget removes the object identified by key from the objects gauge.
+func Forget(kind, key string) {
+	readiness.set(kind, key, "")
+}
+
+type tracker struct {
+	sync.Mutex
+	states map[string]map[string]string
+}
+
+func (t *tracker) set(kind, key, state string) {
+	t.Lock()
+	defer t.Unlock()
+	objects, ok := t.states[kind]
+	if !ok {
+		objects = map[string]string{}
+		t.states[kind] = objects
+	}
+	previous, ok := objects[key]
+	if ok && previous == state {
+		return
+	}
+	if ok {
+		Objects.WithLabelValues(kind, previous).Dec()
+	}
+	if state == "" {
+		delete(objects, key)
+		return
+	}
+	objects[key] = state
+	Objects.WithLabelValues(kind, state).Inc()
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/Azure/go-autorest/autorest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+)
+
+var _ = Describe("metrics", func() {
+
+	It("should derive operations from requests", func() {
+		base := "https://management.azure.com/subscriptions/sub/resourceGroups/group/providers/Microsoft.Cache/redis/name"
+		cases := map[string]string{
+			http.MethodGet:    "Get",
+			http.MethodPut:    "CreateOrUpdate",
+			http.MethodPatch:  "Update",
+			http.MethodDelete: "Delete",
+		}
+		for method, operation := range cases {
+			Expect(metrics.Operation(httptest.NewRequest(method, base, nil))).To(Equal(operation))
+		}
+		Expect(metrics.Operation(httptest.NewRequest(http.MethodPost, base+"/listKeys", nil))).To(Equal("listKeys"))
+		poll := "https://management.azure.com/subscriptions/sub/providers/Microsoft.Cache/locations/westus2/asyncOperations/id"
+		Expect(metrics.Operation(httptest.NewRequest(http.MethodGet, poll, nil))).To(Equal("Poll"))
+	})
+
+	It("should count requests and throttling by status code", func() {
+		sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusTooManyRequests, Request: r}, nil
+		}), metrics.WithMetrics("TestKind"))
+
+		_, err := sender.Do(httptest.NewRequest(http.MethodGet, "https://management.azure.com/foo", nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testutil.ToFloat64(metrics.AzureRequests.WithLabelValues("TestKind", "Get", "429"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(metrics.AzureThrottled.WithLabelValues("TestKind", "Get"))).To(Equal(1.0))
+	})
+
+	It("should move objects between readiness states", func() {
+		metrics.SetState("TestKind", "default/a", metrics.StateReconciling)
+		metrics.SetState("TestKind", "default/b", metrics.StateReconciling)
+		metrics.SetState("TestKind", "default/a", metrics.StateReady)
+		metrics.SetState("TestKind", "default/a", metrics.StateReady)
+		Expect(testutil.ToFloat64(metrics.Objects.WithLabelValues("TestKind", metrics.StateReady))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(metrics.Objects.WithLabelValues("TestKind", metrics.StateReconciling))).To(Equal(1.0))
+
+		metrics.Forget("TestKind", "default/a")
+		metrics.Forget("TestKind", "default/missing")
+		Expect(testutil.ToFloat64(metrics.Objects.WithLabelValues("TestKind", metrics.StateReady))).To(Equal(0.0))
+	})
+})
@@ -0,0 +1,243 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultQPS is the steady state request rate allowed per subscription.
+	DefaultQPS = 10
+	// DefaultBurst is the number of requests which may be sent at once per subscription.
+	DefaultBurst = 20
+	// DefaultLowWatermark is the remaining ARM quota below which requests are slowed down.
+	DefaultLowWatermark = 100
+	// DefaultMaxRetries is how many times a throttled request is retried before the 429 is returned.
+	DefaultMaxRetries = 3
+
+	// defaultRetryAfter is the delay used when Azure throttles without a usable Retry-After header.
+	defaultRetryAfter = 10 * time.Second
+)
+
+// Headers reporting the remaining ARM request quota of a subscription.
+var remainingHeaders = []string{
+	"x-ms-ratelimit-remaining-subscription-reads",
+	"x-ms-ratelimit-remaining-subscription-writes",
+	"x-ms-ratelimit-remaining-subscription-deletes",
+}
+
+// Limiter is a client-side rate limiter for Azure Resource Manager shared by all clients and keyed by subscription.
+// It delays requests to stay under a configured rate, pauses a subscription for the duration of Retry-After when
+// Azure throttles it, and lowers the rate as x-ms-ratelimit-remaining headers approach zero.
+type Limiter struct {
+	qps          float64
+	burst        int
+	lowWatermark int
+	maxRetries   int
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+type subscription struct {
+	limiter *rate.Limiter
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+}
+
+// Option configures a Limiter.
+type Option func(*Limiter)
+
+// QPS sets the steady state request rate per subscription.
+func QPS(qps float64) Option {
+	return func(l *Limiter) {
+		l.qps = qps
+	}
+}
+
+// Burst sets the number of requests which may be sent at once per subscription.
+func Burst(burst int) Option {
+	return func(l *Limiter) {
+		l.burst = burst
+	}
+}
+
+// LowWatermark sets the remaining quota below which the request rate is reduced proportionally.
+func LowWatermark(remaining int) Option {
+	return func(l *Limiter) {
+		l.lowWatermark = remaining
+	}
+}
+
+// MaxRetries sets how many times a throttled request is retried after waiting for Retry-After.
+func MaxRetries(retries int) Option {
+	return func(l *Limiter) {
+		l.maxRetries = retries
+	}
+}
+
+// New returns a Limiter with default settings overridden by opts.
+// The limiter takes over retrying throttled requests, see disableAutorestThrottleRetries.
+func New(opts ...Option) *Limiter {
+	disableThrottleRetries.Do(disableAutorestThrottleRetries)
+	l := &Limiter{
+		qps:           DefaultQPS,
+		burst:         DefaultBurst,
+		lowWatermark:  DefaultLowWatermark,
+		maxRetries:    DefaultMaxRetries,
+		subscriptions: map[string]*subscription{},
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Apply decorates the sender of an Azure client so all of its requests go through the limiter of subscriptionID.
+func (l *Limiter) Apply(client *autorest.Client, subscriptionID string) {
+	sender := client.Sender
+	if sender == nil {
+		sender = autorest.CreateSender()
+	}
+	client.Sender = autorest.DecorateSender(sender, l.WithRateLimit(subscriptionID))
+}
+
+// WithRateLimit returns a SendDecorator which waits for the limiter of subscriptionID before each request
+// and retries requests throttled by Azure after the delay requested in Retry-After.
+func (l *Limiter) WithRateLimit(subscriptionID string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			sub := l.forSubscription(subscriptionID)
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				if err := sub.wait(r); err != nil {
+					return nil, err
+				}
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if err != nil || resp == nil {
+					return resp, err
+				}
+				l.observe(sub, resp)
+				if resp.StatusCode != http.StatusTooManyRequests || attempt >= l.maxRetries {
+					return resp, err
+				}
+				autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+			}
+		})
+	}
+}
+
+var disableThrottleRetries sync.Once
+
+// disableAutorestThrottleRetries removes 429 from the status codes retried by the Azure SDK clients, which would
+// otherwise retry every throttled request the limiter gave up on, without counting them against their attempts.
+// The list is global to autorest, so this is done once, before clients send requests.
+func disableAutorestThrottleRetries() {
+	codes := []int{}
+	for _, code := range autorest.StatusCodesForRetry {
+		if code != http.StatusTooManyRequests {
+			codes = append(codes, code)
+		}
+	}
+	autorest.StatusCodesForRetry = codes
+}
+
+func (l *Limiter) forSubscription(subscriptionID string) *subscription {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sub, ok := l.subscriptions[subscriptionID]
+	if !ok {
+		sub = &subscription{limiter: rate.NewLimiter(rate.Limit(l.qps), l.burst)}
+		l.subscriptions[subscriptionID] = sub
+	}
+	return sub
+}
+
+// observe adjusts the limiter of a subscription to the throttling signals in an Azure response.
+func (l *Limiter) observe(sub *subscription, resp *http.Response) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		sub.block(RetryAfter(resp, defaultRetryAfter))
+	}
+	remaining, ok := Remaining(resp)
+	if !ok || l.lowWatermark <= 0 {
+		return
+	}
+	if remaining >= l.lowWatermark {
+		sub.limiter.SetLimit(rate.Limit(l.qps))
+		return
+	}
+	if remaining < 1 {
+		remaining = 1
+	}
+	sub.limiter.SetLimit(rate.Limit(l.qps * float64(remaining) / float64(l.lowWatermark)))
+}
+
+// wait blocks until the subscription is no longer throttled and the limiter allows another request.
+func (s *subscription) wait(r *http.Request) error {
+	ctx := r.Context()
+	s.mu.Lock()
+	delay := time.Until(s.blockedUntil)
+	s.mu.Unlock()
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+	return s.limiter.Wait(ctx)
+}
+
+func (s *subscription) block(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if until := time.Now().Add(d); until.After(s.blockedUntil) {
+		s.blockedUntil = until
+	}
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of resp, given in seconds or as an HTTP date.
+// It returns fallback if the header is absent or malformed.
+func RetryAfter(resp *http.Response, fallback time.Duration) time.Duration {
+	value := resp.Header.Get(autorest.HeaderRetryAfter)
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+		return 0
+	}
+	return fallback
+}
+
+// Remaining returns the lowest remaining ARM quota reported by the x-ms-ratelimit-remaining headers of resp.
+func Remaining(resp *http.Response) (int, bool) {
+	lowest, found := 0, false
+	for _, header := range remainingHeaders {
+		remaining, err := strconv.Atoi(resp.Header.Get(header))
+		if err != nil {
+			continue
+		}
+		if !found || remaining < lowest {
+			lowest, found = remaining, true
+		}
+	}
+	return lowest, found
+}
@@ -0,0 +1,17 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package ratelimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRatelimit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ratelimit")
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package ratelimit_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/ratelimit"
+)
+
+func response(code int, headers map[string]string) *http.Response {
+	resp := &http.Response{StatusCode: code, Header: http.Header{}, Body: http.NoBody}
+	for key, value := range headers {
+		resp.Header.Set(key, value)
+	}
+	return resp
+}
+
+var _ = Describe("rate limiter", func() {
+
+	It("should parse Retry-After as seconds or an HTTP date", func() {
+		Expect(ratelimit.RetryAfter(response(429, map[string]string{"Retry-After": "7"}), time.Minute)).To(Equal(7 * time.Second))
+		date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+		Expect(ratelimit.RetryAfter(response(429, map[string]string{"Retry-After": date}), time.Minute)).To(BeNumerically("~", 30*time.Second, 2*time.Second))
+		Expect(ratelimit.RetryAfter(response(429, nil), time.Minute)).To(Equal(time.Minute))
+	})
+
+	It("should report the lowest remaining quota", func() {
+		remaining, ok := ratelimit.Remaining(response(200, map[string]string{
+			"x-ms-ratelimit-remaining-subscription-reads":  "11000",
+			"x-ms-ratelimit-remaining-subscription-writes": "42",
+		}))
+		Expect(ok).To(BeTrue())
+		Expect(remaining).To(Equal(42))
+		_, ok = ratelimit.Remaining(response(200, nil))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should retry throttled requests after Retry-After with the original body", func() {
+		var bodies []string
+		base := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				return response(http.StatusTooManyRequests, map[string]string{"Retry-After": "1"}), nil
+			}
+			return response(http.StatusOK, nil), nil
+		})
+		limiter := ratelimit.New(ratelimit.QPS(100))
+		sender := autorest.DecorateSender(base, limiter.WithRateLimit("sub"))
+
+		start := time.Now()
+		resp, err := sender.Do(httptest.NewRequest(http.MethodPut, "https://management.azure.com/foo", strings.NewReader("payload")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		Expect(bodies).To(Equal([]string{"payload", "payload"}))
+	})
+
+	It("should return the throttled response once retries are exhausted", func() {
+		calls := 0
+		base := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			return response(http.StatusTooManyRequests, map[string]string{"Retry-After": "0"}), nil
+		})
+		limiter := ratelimit.New(ratelimit.QPS(100), ratelimit.MaxRetries(2))
+		sender := autorest.DecorateSender(base, limiter.WithRateLimit("sub"))
+
+		resp, err := sender.Do(httptest.NewRequest(http.MethodGet, "https://management.azure.com/foo", nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+		Expect(calls).To(Equal(3))
+	})
+
+	It("should be the only layer retrying throttled requests of SDK clients", func() {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		limiter := ratelimit.New(ratelimit.QPS(100), ratelimit.MaxRetries(2))
+		client := resources.NewGroupsClientWithBaseURI(server.URL, "sub")
+		client.Authorizer = autorest.NullAuthorizer{}
+		client.RetryDuration = time.Millisecond
+		limiter.Apply(&client.Client, "sub")
+
+		// autorest does not count 429s against its attempts, so without the limiter owning retries this never ends.
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, err := client.Get(ctx, "group")
+		Expect(err).To(HaveOccurred())
+		Expect(ctx.Err()).NotTo(HaveOccurred())
+		Expect(atomic.LoadInt32(&requests)).To(BeEquivalentTo(3))
+	})
+
+	It("should slow down as the remaining quota runs low", func() {
+		base := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return response(http.StatusOK, map[string]string{"x-ms-ratelimit-remaining-subscription-reads": "1"}), nil
+		})
+		limiter := ratelimit.New(ratelimit.QPS(100), ratelimit.Burst(1), ratelimit.LowWatermark(50))
+		sender := autorest.DecorateSender(base, limiter.WithRateLimit("sub"))
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			_, err := sender.Do(httptest.NewRequest(http.MethodGet, "https://management.azure.com/foo", nil))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		// 100 qps scaled by 1/50 leaves 2 qps, so the third request waits roughly a second in total.
+		Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+	})
+})
@@ -0,0 +1,70 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/metrics"
+)
+
+// Instrument wraps the sender of an Azure client to record a span for every request made for kind.
+func Instrument(client *autorest.Client, kind string) {
+	sender := client.Sender
+	if sender == nil {
+		sender = autorest.CreateSender()
+	}
+	client.Sender = autorest.DecorateSender(sender, WithTracing(kind))
+}
+
+// WithTracing returns a SendDecorator recording each request as a child of the span in its context.
+// The W3C traceparent header is added so Azure can correlate the request with the trace.
+func WithTracing(kind string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			operation := metrics.Operation(r)
+			ctx, span := Start(r.Context(), kind+" "+operation, SpanKindClient,
+				String("k8s.kind", kind),
+				String("azure.operation", operation),
+				String("http.method", r.Method),
+				String("http.url", redact(r)),
+			)
+			if span == nil {
+				return s.Do(r)
+			}
+			defer span.End()
+			r = r.WithContext(ctx)
+			traceID, spanID := span.SpanContext()
+			r.Header.Set("traceparent", fmt.Sprintf("00-%s-%s-01", traceID, spanID))
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				span.SetAttributes(Int("http.status_code", resp.StatusCode))
+				if id := resp.Header.Get("x-ms-request-id"); id != "" {
+					span.SetAttributes(String("azure.request_id", id))
+				}
+				if id := resp.Header.Get("x-ms-correlation-request-id"); id != "" {
+					span.SetAttributes(String("azure.correlation_id", id))
+				}
+				if resp.StatusCode >= http.StatusBadRequest {
+					span.RecordError(fmt.Errorf("%s", resp.Status))
+				}
+			}
+			span.RecordError(err)
+			return resp, err
+		})
+	}
+}
+
+// redact returns the URL of r without its query, which may carry credentials such as SAS tokens.
+func redact(r *http.Request) string {
+	u := *r.URL
+	u.RawQuery = ""
+	u.User = nil
+	return u.String()
+}
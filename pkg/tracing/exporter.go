@@ -0,0 +1,270 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Exporters selectable by name.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Exporters lists the names accepted by NewExporter.
+var Exporters = []string{ExporterNone, ExporterStdout, ExporterOTLP}
+
+// DefaultEndpoint is the OTLP/HTTP endpoint of a collector running next to the controller.
+const DefaultEndpoint = "http://localhost:4318"
+
+// instrumentationScope names the code which produced the spans.
+const instrumentationScope = "github.com/alexeldeib/incendiary-iguana"
+
+// NewExporter returns the exporter named name, or nil for none.
+// The OTLP exporter sends spans to endpoint, which defaults to the standard OTEL_EXPORTER_OTLP_* environment variables or DefaultEndpoint.
+func NewExporter(name, service, endpoint string) (Exporter, error) {
+	switch strings.ToLower(name) {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return NewWriterExporter(os.Stdout, service), nil
+	case ExporterOTLP:
+		exporter, err := NewOTLPExporter(endpoint, service)
+		if err != nil {
+			return nil, err
+		}
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, must be one of %v", name, Exporters)
+	}
+}
+
+// Setup sets the global provider to export spans of service with the exporter named name, and returns it.
+// It returns a nil provider, which is safe to shut down, when tracing is disabled.
+func Setup(name, service, endpoint string, onError func(error)) (*Provider, error) {
+	exporter, err := NewExporter(name, service, endpoint)
+	if err != nil || exporter == nil {
+		return nil, err
+	}
+	provider := NewProvider(exporter, onError)
+	SetProvider(provider)
+	return provider, nil
+}
+
+// OTLPExporter sends spans to an OpenTelemetry collector using OTLP over HTTP with JSON encoding.
+type OTLPExporter struct {
+	url     string
+	service string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewOTLPExporter returns an exporter sending spans to the OTLP/HTTP endpoint, e.g. http://localhost:4318.
+// The path /v1/traces is added to endpoints without a path.
+func NewOTLPExporter(endpoint, service string) (*OTLPExporter, error) {
+	target := endpoint
+	if target == "" {
+		target = os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	}
+	if target == "" {
+		target = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	}
+	if target == "" {
+		target = DefaultEndpoint
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: %v", target, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: scheme must be http or https", target)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/v1/traces"
+	}
+	return &OTLPExporter{
+		url:     u.String(),
+		service: service,
+		headers: parseHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS")),
+		client:  &http.Client{},
+	}, nil
+}
+
+// URL returns the URL spans are sent to.
+func (e *OTLPExporter) URL() string {
+	return e.url
+}
+
+// Export sends spans to the collector.
+func (e *OTLPExporter) Export(ctx context.Context, spans []SpanData) error {
+	body, err := json.Marshal(encode(e.service, spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to export %d spans to %s: %s: %s", len(spans), e.url, resp.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// WriterExporter writes each batch of spans as one line of OTLP JSON, e.g. to stdout for debugging.
+type WriterExporter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	service string
+}
+
+// NewWriterExporter returns an exporter writing spans to w.
+func NewWriterExporter(w io.Writer, service string) *WriterExporter {
+	return &WriterExporter{w: w, service: service}
+}
+
+// Export writes spans to the writer.
+func (e *WriterExporter) Export(ctx context.Context, spans []SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return json.NewEncoder(e.w).Encode(encode(e.service, spans))
+}
+
+func parseHeaders(raw string) map[string]string {
+	headers := map[string]string{}
+	for _, pair := range strings.Split(raw, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			continue
+		}
+		value, err := url.QueryUnescape(strings.TrimSpace(parts[1]))
+		if err != nil {
+			value = strings.TrimSpace(parts[1])
+		}
+		headers[strings.TrimSpace(parts[0])] = value
+	}
+	return headers
+}
+
+// The types below are the OTLP JSON encoding of an ExportTraceServiceRequest.
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type span struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              SpanKind   `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	Status            status     `json:"status"`
+}
+
+type status struct {
+	Code    StatusCode `json:"code,omitempty"`
+	Message string     `json:"message,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func encode(service string, spans []SpanData) exportRequest {
+	encoded := make([]span, 0, len(spans))
+	for _, s := range spans {
+		e := span{
+			TraceID:           s.TraceID.String(),
+			SpanID:            s.SpanID.String(),
+			Name:              s.Name,
+			Kind:              s.Kind,
+			StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.EndTime.UnixNano(), 10),
+			Attributes:        encodeAttributes(s.Attributes),
+			Status:            status{Code: s.StatusCode, Message: s.StatusMessage},
+		}
+		if s.ParentSpanID.IsValid() {
+			e.ParentSpanID = s.ParentSpanID.String()
+		}
+		encoded = append(encoded, e)
+	}
+	return exportRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource:   resource{Attributes: encodeAttributes([]Attribute{String("service.name", service)})},
+			ScopeSpans: []scopeSpans{{Scope: scope{Name: instrumentationScope}, Spans: encoded}},
+		}},
+	}
+}
+
+func encodeAttributes(attributes []Attribute) []keyValue {
+	encoded := make([]keyValue, 0, len(attributes))
+	for _, attribute := range attributes {
+		var value anyValue
+		switch v := attribute.Value.(type) {
+		case int:
+			i := strconv.Itoa(v)
+			value.IntValue = &i
+		case bool:
+			value.BoolValue = &v
+		default:
+			s := fmt.Sprint(v)
+			value.StringValue = &s
+		}
+		encoded = append(encoded, keyValue{Key: attribute.Key, Value: value})
+	}
+	return encoded
+}
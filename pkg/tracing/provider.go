@@ -0,0 +1,149 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBatchSize is the number of spans exported in one request.
+	DefaultBatchSize = 512
+	// DefaultQueueSize is the number of finished spans buffered for export. Spans are dropped when it is full.
+	DefaultQueueSize = 2048
+	// DefaultExportInterval is the longest a finished span waits before it is exported.
+	DefaultExportInterval = 5 * time.Second
+)
+
+// Exporter sends finished spans to a tracing backend.
+type Exporter interface {
+	Export(ctx context.Context, spans []SpanData) error
+}
+
+// Provider starts spans and exports them in batches in the background.
+type Provider struct {
+	exporter Exporter
+	interval time.Duration
+	size     int
+	queue    chan SpanData
+	flush    chan chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+	once     sync.Once
+	onError  func(error)
+}
+
+// NewProvider returns a provider exporting spans with exporter until it is shut down.
+// Errors returned by the exporter are passed to onError, e.g. to log them, or dropped when it is nil.
+func NewProvider(exporter Exporter, onError func(error)) *Provider {
+	p := &Provider{
+		exporter: exporter,
+		onError:  onError,
+		interval: DefaultExportInterval,
+		size:     DefaultBatchSize,
+		queue:    make(chan SpanData, DefaultQueueSize),
+		flush:    make(chan chan struct{}),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+// ForceFlush exports all spans finished so far.
+func (p *Provider) ForceFlush(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+	flushed := make(chan struct{})
+	select {
+	case p.flush <- flushed:
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Shutdown exports the remaining spans and stops the provider. Spans ended afterwards are dropped.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+	p.once.Do(func() { close(p.stop) })
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *Provider) enqueue(span SpanData) {
+	select {
+	case <-p.stop:
+	case p.queue <- span:
+	default:
+		// Dropping spans is preferable to blocking reconciles on a slow collector.
+	}
+}
+
+func (p *Provider) run() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	batch := make([]SpanData, 0, p.size)
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+		defer cancel()
+		if err := p.exporter.Export(ctx, batch); err != nil && p.onError != nil {
+			p.onError(err)
+		}
+		batch = make([]SpanData, 0, p.size)
+	}
+	drain := func() {
+		for {
+			select {
+			case span := <-p.queue:
+				batch = append(batch, span)
+				if len(batch) >= p.size {
+					export()
+				}
+			default:
+				return
+			}
+		}
+	}
+	for {
+		select {
+		case span := <-p.queue:
+			batch = append(batch, span)
+			if len(batch) >= p.size {
+				export()
+			}
+		case <-ticker.C:
+			export()
+		case flushed := <-p.flush:
+			drain()
+			export()
+			close(flushed)
+		case <-p.stop:
+			drain()
+			export()
+			return
+		}
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+// Package tracing records OpenTelemetry spans for reconciles and the Azure requests they make.
+// Spans are exported with the OTLP/HTTP JSON encoding, so any OpenTelemetry collector can receive them.
+// It implements the small subset of the OpenTelemetry SDK this project needs, because the SDK requires
+// a version of logr which is incompatible with the one controller-runtime depends on.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SpanKind describes the relationship of a span to its parent, with the values of the OTLP enum.
+type SpanKind int
+
+const (
+	// SpanKindInternal is an operation within the controller, e.g. a reconcile.
+	SpanKindInternal SpanKind = 1
+	// SpanKindClient is a request to a remote service, e.g. Azure Resource Manager.
+	SpanKindClient SpanKind = 3
+)
+
+// StatusCode is the outcome of a span, with the values of the OTLP enum.
+type StatusCode int
+
+const (
+	StatusUnset StatusCode = 0
+	StatusOK    StatusCode = 1
+	StatusError StatusCode = 2
+)
+
+// inheritedPrefix marks attributes which are copied from a span to its children,
+// so every span identifies the object it was made for.
+const inheritedPrefix = "k8s."
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+func (t TraceID) String() string { return hex.EncodeToString(t[:]) }
+
+// IsValid returns false for the zero trace ID.
+func (t TraceID) IsValid() bool { return t != TraceID{} }
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+func (s SpanID) String() string { return hex.EncodeToString(s[:]) }
+
+// IsValid returns false for the zero span ID.
+func (s SpanID) IsValid() bool { return s != SpanID{} }
+
+// Attribute is a key value pair describing a span. Values are strings, ints or bools.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String returns a string attribute.
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int returns an integer attribute.
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Bool returns a boolean attribute.
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// SpanData is a finished span handed to exporters.
+type SpanData struct {
+	Name          string
+	Kind          SpanKind
+	TraceID       TraceID
+	SpanID        SpanID
+	ParentSpanID  SpanID
+	StartTime     time.Time
+	EndTime       time.Time
+	Attributes    []Attribute
+	StatusCode    StatusCode
+	StatusMessage string
+}
+
+// Span is an operation being traced. A nil span is valid and records nothing, which is what Start returns when tracing is disabled.
+type Span struct {
+	mu       sync.Mutex
+	provider *Provider
+	data     SpanData
+	ended    bool
+}
+
+// SpanContext returns the trace and span ID of the span.
+func (s *Span) SpanContext() (TraceID, SpanID) {
+	if s == nil {
+		return TraceID{}, SpanID{}
+	}
+	return s.data.TraceID, s.data.SpanID
+}
+
+// SetAttributes adds attributes to the span, replacing earlier values of the same keys.
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, attribute := range attributes {
+		s.data.Attributes = setAttribute(s.data.Attributes, attribute)
+	}
+}
+
+// RecordError marks the span as failed with the message of err. It does nothing for a nil error.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.StatusCode = StatusError
+	s.data.StatusMessage = err.Error()
+}
+
+// End finishes the span and queues it for export. Only the first call has an effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = time.Now()
+	data := s.data
+	s.mu.Unlock()
+	s.provider.enqueue(data)
+}
+
+// inherited returns the attributes of the span its children copy.
+func (s *Span) inherited() []Attribute {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var attributes []Attribute
+	for _, attribute := range s.data.Attributes {
+		if strings.HasPrefix(attribute.Key, inheritedPrefix) {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+type spanKey struct{}
+
+// ContextWithSpan returns a copy of ctx carrying span, which becomes the parent of spans started from it.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns the span carried by ctx, or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+var (
+	globalMu sync.RWMutex
+	global   *Provider
+)
+
+// SetProvider sets the provider used by Start. A nil provider disables tracing.
+func SetProvider(provider *Provider) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	global = provider
+}
+
+// Start starts a span with the global provider as a child of the span in ctx, if any.
+// It returns a nil span when tracing is disabled.
+func Start(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	globalMu.RLock()
+	provider := global
+	globalMu.RUnlock()
+	return provider.Start(ctx, name, kind, attributes...)
+}
+
+// Start starts a span as a child of the span in ctx, if any.
+func (p *Provider) Start(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	if p == nil {
+		return ctx, nil
+	}
+	span := &Span{
+		provider: p,
+		data: SpanData{
+			Name:      name,
+			Kind:      kind,
+			StartTime: time.Now(),
+		},
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.data.TraceID = parent.data.TraceID
+		span.data.ParentSpanID = parent.data.SpanID
+		span.data.Attributes = parent.inherited()
+	} else {
+		_, _ = rand.Read(span.data.TraceID[:])
+	}
+	_, _ = rand.Read(span.data.SpanID[:])
+	span.SetAttributes(attributes...)
+	return ContextWithSpan(ctx, span), span
+}
+
+func setAttribute(attributes []Attribute, attribute Attribute) []Attribute {
+	for i := range attributes {
+		if attributes[i].Key == attribute.Key {
+			attributes[i] = attribute
+			return attributes
+		}
+	}
+	return append(attributes, attribute)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/alexeldeib/incendiary-iguana/pkg/tracing"
+)
+
+// recorder is an exporter keeping every span in memory.
+type recorder struct {
+	mu    sync.Mutex
+	spans []tracing.SpanData
+}
+
+func (r *recorder) Export(ctx context.Context, spans []tracing.SpanData) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func (r *recorder) byName(name string) tracing.SpanData {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, span := range r.spans {
+		if span.Name == name {
+			return span
+		}
+	}
+	return tracing.SpanData{}
+}
+
+func attribute(span tracing.SpanData, key string) interface{} {
+	for _, attribute := range span.Attributes {
+		if attribute.Key == key {
+			return attribute.Value
+		}
+	}
+	return nil
+}
+
+var _ = Describe("tracing", func() {
+
+	var (
+		exporter *recorder
+		provider *tracing.Provider
+	)
+
+	BeforeEach(func() {
+		exporter = &recorder{}
+		provider = tracing.NewProvider(exporter, nil)
+		tracing.SetProvider(provider)
+	})
+
+	AfterEach(func() {
+		tracing.SetProvider(nil)
+		Expect(provider.Shutdown(context.Background())).To(Succeed())
+	})
+
+	It("should record children in the trace of their parent", func() {
+		ctx, parent := tracing.Start(context.Background(), "Reconcile ResourceGroup", tracing.SpanKindInternal,
+			tracing.String("k8s.kind", "ResourceGroup"),
+			tracing.String("k8s.name", "group"),
+			tracing.String("other", "value"),
+		)
+		_, child := tracing.Start(ctx, "ResourceGroup Get", tracing.SpanKindClient)
+		child.RecordError(errors.New("boom"))
+		child.End()
+		parent.End()
+		Expect(provider.ForceFlush(context.Background())).To(Succeed())
+
+		p := exporter.byName("Reconcile ResourceGroup")
+		c := exporter.byName("ResourceGroup Get")
+		Expect(p.TraceID.IsValid()).To(BeTrue())
+		Expect(p.ParentSpanID.IsValid()).To(BeFalse())
+		Expect(c.TraceID).To(Equal(p.TraceID))
+		Expect(c.ParentSpanID).To(Equal(p.SpanID))
+		Expect(attribute(c, "k8s.name")).To(Equal("group"))
+		Expect(attribute(c, "other")).To(BeNil())
+		Expect(c.StatusCode).To(Equal(tracing.StatusError))
+		Expect(c.StatusMessage).To(Equal("boom"))
+		Expect(c.EndTime).ToNot(BeZero())
+	})
+
+	It("should do nothing when tracing is disabled", func() {
+		tracing.SetProvider(nil)
+		ctx, span := tracing.Start(context.Background(), "Reconcile", tracing.SpanKindInternal)
+		Expect(span).To(BeNil())
+		Expect(tracing.SpanFromContext(ctx)).To(BeNil())
+		span.SetAttributes(tracing.String("key", "value"))
+		span.RecordError(errors.New("boom"))
+		span.End()
+	})
+
+	It("should trace Azure requests with their request ID", func() {
+		var traceparent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceparent = r.Header.Get("traceparent")
+			w.Header().Set("x-ms-request-id", "request-id")
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := autorest.NewClientWithUserAgent("")
+		tracing.Instrument(&client, "ResourceGroup")
+		ctx, parent := tracing.Start(context.Background(), "Reconcile ResourceGroup", tracing.SpanKindInternal, tracing.String("k8s.namespace", "default"))
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/sub/resourceGroups/group?api-version=2019-05-01", nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := client.Do(req.WithContext(ctx))
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		parent.End()
+		Expect(provider.ForceFlush(context.Background())).To(Succeed())
+
+		span := exporter.byName("ResourceGroup Get")
+		traceID, spanID := span.TraceID, span.SpanID
+		Expect(traceparent).To(Equal("00-" + traceID.String() + "-" + spanID.String() + "-01"))
+		Expect(span.Kind).To(Equal(tracing.SpanKindClient))
+		Expect(attribute(span, "azure.request_id")).To(Equal("request-id"))
+		Expect(attribute(span, "http.status_code")).To(Equal(http.StatusNotFound))
+		Expect(attribute(span, "http.url")).To(Equal(server.URL + "/subscriptions/sub/resourceGroups/group"))
+		Expect(attribute(span, "k8s.namespace")).To(Equal("default"))
+		Expect(span.StatusCode).To(Equal(tracing.StatusError))
+	})
+
+	It("should export spans to an OTLP collector", func() {
+		received := make(chan map[string]interface{}, 1)
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/v1/traces"))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			var request map[string]interface{}
+			Expect(json.Unmarshal(body, &request)).To(Succeed())
+			received <- request
+		}))
+		defer collector.Close()
+
+		otlp, err := tracing.NewOTLPExporter(collector.URL, "incendiary-iguana")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(otlp.URL()).To(Equal(collector.URL + "/v1/traces"))
+
+		otlpProvider := tracing.NewProvider(otlp, nil)
+		_, span := otlpProvider.Start(context.Background(), "Reconcile ResourceGroup", tracing.SpanKindInternal, tracing.Int("attempt", 1))
+		span.End()
+		Expect(otlpProvider.Shutdown(context.Background())).To(Succeed())
+
+		var request map[string]interface{}
+		Eventually(received).Should(Receive(&request))
+		resourceSpans := request["resourceSpans"].([]interface{})[0].(map[string]interface{})
+		service := resourceSpans["resource"].(map[string]interface{})["attributes"].([]interface{})[0].(map[string]interface{})
+		Expect(service["key"]).To(Equal("service.name"))
+		Expect(service["value"]).To(Equal(map[string]interface{}{"stringValue": "incendiary-iguana"}))
+		spans := resourceSpans["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+		Expect(spans).To(HaveLen(1))
+		exported := spans[0].(map[string]interface{})
+		Expect(exported["name"]).To(Equal("Reconcile ResourceGroup"))
+		Expect(exported["traceId"]).To(HaveLen(32))
+		Expect(exported["spanId"]).To(HaveLen(16))
+		Expect(exported).ToNot(HaveKey("parentSpanId"))
+	})
+
+	It("should report spans the collector rejects", func() {
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "overloaded", http.StatusServiceUnavailable)
+		}))
+		defer collector.Close()
+
+		otlp, err := tracing.NewExporter(tracing.ExporterOTLP, "tinker", collector.URL+"/custom/path")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(otlp.(*tracing.OTLPExporter).URL()).To(Equal(collector.URL + "/custom/path"))
+		err = otlp.Export(context.Background(), []tracing.SpanData{{Name: "tinker ensure ResourceGroup"}})
+		Expect(err).To(MatchError(ContainSubstring("overloaded")))
+	})
+
+	It("should reject unknown exporters", func() {
+		_, err := tracing.NewExporter("jaeger", "tinker", "")
+		Expect(err).To(HaveOccurred())
+		exporter, err := tracing.NewExporter(tracing.ExporterNone, "tinker", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exporter).To(BeNil())
+		_, err = tracing.NewOTLPExporter("localhost:4318", "tinker")
+		Expect(err).To(HaveOccurred())
+	})
+})
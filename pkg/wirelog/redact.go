@@ -0,0 +1,159 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package wirelog
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces every secret value.
+const Redacted = "REDACTED"
+
+// sensitiveHeaders are headers carrying credentials whose names do not give them away.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":                true,
+	"Proxy-Authorization":          true,
+	"Cookie":                       true,
+	"Set-Cookie":                   true,
+	"X-Ms-Authorization-Auxiliary": true,
+}
+
+// sensitiveNames are fragments of field, parameter and header names holding secrets, compared after normalizing.
+var sensitiveNames = []string{
+	"password",
+	"secret",
+	"token",
+	"connectionstring",
+	"assertion",
+	"signature",
+	"customdata",
+}
+
+// referenceSuffixes mark names which refer to a secret rather than hold it, e.g. secretName or tokenFile.
+var referenceSuffixes = []string{"name", "names", "id", "ids", "ref", "file", "path", "url", "uri", "version", "type", "expiry"}
+
+// plainSecrets matches secrets in connection strings and other bodies which are neither JSON nor forms.
+var plainSecrets = regexp.MustCompile(`(?i)((?:password|pwd|accountkey|sharedaccesskey|secret|sig)\s*=\s*)[^;&\s"]+|(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+
+// Sensitive returns true if a field, parameter or header named name holds a secret.
+// Keys of every kind, e.g. primaryKey or accessKey, are secrets, while names referring to secrets, e.g. secretName, are not.
+func Sensitive(name string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", "_", "", " ", "").Replace(name))
+	for _, suffix := range referenceSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return false
+		}
+	}
+	for _, fragment := range sensitiveNames {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return normalized == "sig" || strings.HasSuffix(normalized, "key") || strings.HasSuffix(normalized, "keys")
+}
+
+// RedactHeaders returns a copy of h with the values of headers carrying credentials replaced.
+func RedactHeaders(h http.Header) http.Header {
+	redacted := make(http.Header, len(h))
+	for name, values := range h {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] || Sensitive(name) {
+			redacted[name] = []string{Redacted}
+			continue
+		}
+		redacted[name] = values
+	}
+	return redacted
+}
+
+// RedactURL returns u with the values of sensitive query parameters, e.g. SAS signatures, and user info replaced.
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	if redacted.User != nil {
+		redacted.User = url.User(Redacted)
+	}
+	query := redacted.Query()
+	for name := range query {
+		if Sensitive(name) {
+			query.Set(name, Redacted)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// RedactBody returns body as a string with secrets replaced, using contentType to decide how to parse it.
+func RedactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for name := range form {
+				if Sensitive(name) {
+					form.Set(name, Redacted)
+				}
+			}
+			return form.Encode()
+		}
+	default:
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err == nil {
+			if data, err := json.Marshal(redactValue(value)); err == nil {
+				return string(data)
+			}
+		}
+	}
+	return plainSecrets.ReplaceAllString(string(body), "${1}${2}"+Redacted)
+}
+
+// RedactObject returns the JSON encoding of obj with secrets replaced, e.g. to log a manifest.
+func RedactObject(obj interface{}) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", err
+	}
+	data, err = json.MarshalIndent(redactValue(value), "", "  ")
+	return string(data), err
+}
+
+// redactValue replaces the values of sensitive fields in decoded JSON.
+// String fields named value hold the secret of Key Vault secrets, while lists named value are ARM list results.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			_, isString := field.(string)
+			if Sensitive(name) || (name == "value" && isString) {
+				v[name] = Redacted
+				continue
+			}
+			v[name] = redactValue(field)
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+		return v
+	case string:
+		return plainSecrets.ReplaceAllString(v, "${1}${2}"+Redacted)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+// Package wirelog logs the HTTP requests Azure clients send and the responses they receive, with credentials redacted.
+package wirelog
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/go-logr/logr"
+)
+
+// Level selects how much of each request and response is logged.
+type Level int
+
+const (
+	// LevelOff logs nothing.
+	LevelOff Level = iota
+	// LevelBasic logs the method, URL, status code, duration and request ID.
+	LevelBasic
+	// LevelHeaders additionally logs request and response headers.
+	LevelHeaders
+	// LevelBody additionally logs request and response bodies.
+	LevelBody
+)
+
+// Levels lists the names accepted by ParseLevel, from least to most verbose.
+var Levels = []string{"off", "basic", "headers", "body"}
+
+// maxBodyBytes bounds the part of each body which is logged.
+const maxBodyBytes = 16 * 1024
+
+func (l Level) String() string {
+	if l < LevelOff || int(l) >= len(Levels) {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return Levels[l]
+}
+
+// ParseLevel returns the level named name.
+func ParseLevel(name string) (Level, error) {
+	for i, level := range Levels {
+		if strings.EqualFold(strings.TrimSpace(name), level) {
+			return Level(i), nil
+		}
+	}
+	return LevelOff, fmt.Errorf("unknown wire log level %q, must be one of %v", name, Levels)
+}
+
+// Logger logs the traffic of Azure clients at a level chosen per kind of client.
+// A nil Logger logs nothing.
+type Logger struct {
+	log       logr.Logger
+	level     Level
+	overrides map[string]Level
+}
+
+// New returns a logger writing to log at the default level, with levels overridden per kind of client.
+func New(log logr.Logger, level Level, overrides map[string]Level) *Logger {
+	return &Logger{log: log, level: level, overrides: overrides}
+}
+
+// Parse returns a logger writing to log configured by spec, a comma separated list of a default level
+// and Kind=level overrides, e.g. basic,VM=body,SQLServer=off. An empty spec logs nothing.
+func Parse(log logr.Logger, spec string) (*Logger, error) {
+	level := LevelOff
+	overrides := map[string]Level{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 1 {
+			parsed, err := ParseLevel(parts[0])
+			if err != nil {
+				return nil, err
+			}
+			level = parsed
+			continue
+		}
+		kind := strings.TrimSpace(parts[0])
+		if kind == "" {
+			return nil, fmt.Errorf("invalid wire log override %q, must be Kind=level", entry)
+		}
+		parsed, err := ParseLevel(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		overrides[kind] = parsed
+	}
+	return New(log, level, overrides), nil
+}
+
+// For returns the level of the clients of kind.
+func (l *Logger) For(kind string) Level {
+	if l == nil {
+		return LevelOff
+	}
+	if level, ok := l.overrides[kind]; ok {
+		return level
+	}
+	return l.level
+}
+
+// Apply wraps the sender of an Azure client to log its traffic for kind, unless logging is off for kind.
+func (l *Logger) Apply(client *autorest.Client, kind string) {
+	level := l.For(kind)
+	if level == LevelOff {
+		return
+	}
+	sender := client.Sender
+	if sender == nil {
+		sender = autorest.CreateSender()
+	}
+	client.Sender = autorest.DecorateSender(sender, WithLogging(l.log.WithValues("kind", kind), level))
+}
+
+// WithLogging returns a SendDecorator logging each request and response to log at level, with credentials redacted.
+func WithLogging(log logr.Logger, level Level) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			values := []interface{}{"method", r.Method, "url", RedactURL(r.URL)}
+			if level >= LevelHeaders {
+				values = append(values, "requestHeaders", headers(r.Header))
+			}
+			if level >= LevelBody && r.Body != nil {
+				body, err := ioutil.ReadAll(r.Body)
+				r.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				values = append(values, "requestBody", truncate(RedactBody(r.Header.Get("Content-Type"), body)))
+			}
+
+			start := time.Now()
+			resp, err := s.Do(r)
+			values = append(values, "duration", time.Since(start).String())
+			if err != nil {
+				log.Error(err, "azure request failed", values...)
+				return resp, err
+			}
+
+			values = append(values, "status", resp.StatusCode, "requestID", resp.Header.Get("x-ms-request-id"))
+			if level >= LevelHeaders {
+				values = append(values, "responseHeaders", headers(resp.Header))
+			}
+			if level >= LevelBody && resp.Body != nil {
+				body, readErr := ioutil.ReadAll(resp.Body)
+				resp.Body.Close()
+				resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+				if readErr != nil {
+					return resp, readErr
+				}
+				values = append(values, "responseBody", truncate(RedactBody(resp.Header.Get("Content-Type"), body)))
+			}
+			log.Info("azure request", values...)
+			return resp, err
+		})
+	}
+}
+
+// headers flattens redacted headers into one sorted string per header, which log sinks render compactly.
+func headers(h http.Header) []string {
+	redacted := RedactHeaders(h)
+	lines := make([]string, 0, len(redacted))
+	for name, values := range redacted {
+		lines = append(lines, name+": "+strings.Join(values, ", "))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+// truncate bounds a redacted body. Bodies are redacted before they are truncated,
+// since a truncated JSON body no longer parses and its fields would escape redaction.
+func truncate(body string) string {
+	if len(body) > maxBodyBytes {
+		return body[:maxBodyBytes]
+	}
+	return body
+}
@@ -0,0 +1,17 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package wirelog_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestWirelog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "wirelog")
+}
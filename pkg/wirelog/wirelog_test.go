@@ -0,0 +1,188 @@
+/*
+Copyright 2019 Alexander Eldeib.
+*/
+
+package wirelog_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	azurev1alpha1 "github.com/alexeldeib/incendiary-iguana/api/v1alpha1"
+	"github.com/alexeldeib/incendiary-iguana/pkg/wirelog"
+)
+
+// capture is a logger keeping every line in memory.
+type capture struct {
+	mu     *sync.Mutex
+	lines  *[]string
+	values []interface{}
+}
+
+func newCapture() capture {
+	return capture{mu: &sync.Mutex{}, lines: &[]string{}}
+}
+
+func (c capture) Info(msg string, keysAndValues ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*c.lines = append(*c.lines, fmt.Sprint(msg, append(c.values, keysAndValues...)))
+}
+func (c capture) Enabled() bool { return true }
+func (c capture) Error(err error, msg string, keysAndValues ...interface{}) {
+	c.Info(msg, append(keysAndValues, "error", err)...)
+}
+func (c capture) V(level int) logr.InfoLogger { return c }
+func (c capture) WithValues(keysAndValues ...interface{}) logr.Logger {
+	c.values = append(append([]interface{}{}, c.values...), keysAndValues...)
+	return c
+}
+func (c capture) WithName(name string) logr.Logger { return c }
+
+func (c capture) output() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return strings.Join(*c.lines, "\n")
+}
+
+var _ = Describe("wirelog", func() {
+
+	It("should parse a default level and overrides per kind", func() {
+		logger, err := wirelog.Parse(newCapture(), "basic, VM=body,SQLServer=off")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(logger.For("ResourceGroup")).To(Equal(wirelog.LevelBasic))
+		Expect(logger.For("VM")).To(Equal(wirelog.LevelBody))
+		Expect(logger.For("SQLServer")).To(Equal(wirelog.LevelOff))
+
+		logger, err = wirelog.Parse(newCapture(), "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(logger.For("VM")).To(Equal(wirelog.LevelOff))
+
+		_, err = wirelog.Parse(newCapture(), "verbose")
+		Expect(err).To(HaveOccurred())
+		_, err = wirelog.Parse(newCapture(), "=body")
+		Expect(err).To(HaveOccurred())
+
+		var disabled *wirelog.Logger
+		Expect(disabled.For("VM")).To(Equal(wirelog.LevelOff))
+	})
+
+	It("should log traffic without credentials", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			Expect(string(body)).To(ContainSubstring("hunter2"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("x-ms-request-id", "request-id")
+			fmt.Fprint(w, `{"properties":{"primaryKey":"key-1","secondaryKey":"key-2","connectionString":"Endpoint=sb://ns;SharedAccessKey=key-3","provisioningState":"Succeeded"}}`)
+		}))
+		defer server.Close()
+
+		log := newCapture()
+		logger, err := wirelog.Parse(log, "basic,SQLServer=body")
+		Expect(err).ToNot(HaveOccurred())
+		client := autorest.NewClientWithUserAgent("")
+		logger.Apply(&client, "SQLServer")
+
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/servers/db?api-version=2015-05-01&sig=signature-1", strings.NewReader(`{"properties":{"administratorLogin":"admin","administratorLoginPassword":"hunter2"}}`))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer token-1")
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("key-1"))
+
+		output := log.output()
+		Expect(output).To(ContainSubstring("SQLServer"))
+		Expect(output).To(ContainSubstring("request-id"))
+		Expect(output).To(ContainSubstring("administratorLogin"))
+		Expect(output).To(ContainSubstring("Succeeded"))
+		for _, secret := range []string{"hunter2", "token-1", "signature-1", "key-1", "key-2", "key-3"} {
+			Expect(output).ToNot(ContainSubstring(secret))
+		}
+	})
+
+	It("should redact bodies larger than the logged part", func() {
+		padding := strings.Repeat("x", 20*1024)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"keys":[{"keyName":"key1","value":"key-1"}],"description":"%s"}`, padding)
+		}))
+		defer server.Close()
+
+		log := newCapture()
+		logger, err := wirelog.Parse(log, "body")
+		Expect(err).ToNot(HaveOccurred())
+		client := autorest.NewClientWithUserAgent("")
+		logger.Apply(&client, "VM")
+
+		body := fmt.Sprintf(`{"properties":{"osProfile":{"adminPassword":"hunter2","customData":"script-1"},"description":"%s"}}`, padding)
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/virtualMachines/vm", strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		_, err = client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+
+		output := log.output()
+		Expect(output).To(ContainSubstring("requestBody"))
+		for _, secret := range []string{"hunter2", "script-1", "key-1"} {
+			Expect(output).ToNot(ContainSubstring(secret))
+		}
+	})
+
+	It("should not wrap clients whose logging is off", func() {
+		logger, err := wirelog.Parse(newCapture(), "off,VM=basic")
+		Expect(err).ToNot(HaveOccurred())
+		client := autorest.NewClientWithUserAgent("")
+		sender := client.Sender
+		logger.Apply(&client, "ResourceGroup")
+		Expect(client.Sender).To(BeIdenticalTo(sender))
+		logger.Apply(&client, "VM")
+		Expect(client.Sender).ToNot(BeIdenticalTo(sender))
+	})
+
+	It("should redact forms, plain text and query parameters", func() {
+		form := wirelog.RedactBody("application/x-www-form-urlencoded", []byte("grant_type=client_credentials&client_id=app&client_secret=secret-1&client_assertion=jwt-1"))
+		Expect(form).To(ContainSubstring("client_id=app"))
+		Expect(form).To(ContainSubstring("grant_type=client_credentials"))
+		Expect(form).ToNot(ContainSubstring("secret-1"))
+		Expect(form).ToNot(ContainSubstring("jwt-1"))
+
+		plain := wirelog.RedactBody("text/plain", []byte("DefaultEndpointsProtocol=https;AccountName=storage;AccountKey=key-1;EndpointSuffix=core.windows.net"))
+		Expect(plain).To(ContainSubstring("AccountName=storage"))
+		Expect(plain).ToNot(ContainSubstring("key-1"))
+
+		vault := wirelog.RedactBody("application/json", []byte(`{"value":"secret-2","id":"https://vault/secrets/name"}`))
+		Expect(vault).ToNot(ContainSubstring("secret-2"))
+		Expect(vault).To(ContainSubstring("https://vault/secrets/name"))
+
+		list := wirelog.RedactBody("application/json", []byte(`{"value":[{"name":"group"}]}`))
+		Expect(list).To(ContainSubstring("group"))
+
+		u, err := url.Parse("https://storage.blob.core.windows.net/container?sv=2019-02-02&sig=signature-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(wirelog.RedactURL(u)).ToNot(ContainSubstring("signature-1"))
+		Expect(wirelog.RedactURL(u)).To(ContainSubstring("sv=2019-02-02"))
+	})
+
+	It("should redact manifests", func() {
+		dump, err := wirelog.RedactObject(&azurev1alpha1.DockerConfig{
+			Spec: azurev1alpha1.DockerConfigSpec{Username: "user", Password: "hunter2", Server: "registry.azurecr.io"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dump).To(ContainSubstring("registry.azurecr.io"))
+		Expect(dump).To(ContainSubstring("user"))
+		Expect(dump).ToNot(ContainSubstring("hunter2"))
+	})
+})